@@ -1,33 +1,338 @@
 package conf
 
-import "github.com/zixyos/goloader/config"
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/zixyos/goloader/config"
+)
+
+// DefaultPollInterval is the indexer polling interval used when none is configured.
+const DefaultPollInterval = 30 * time.Second
 
 type DelegatorConfig struct {
 	Service struct {
-		Name    string `toml:"name"`
-		Version string `toml:"version"`
-	} `toml:"service"`
+		Name    string `toml:"name" koanf:"name"`
+		Version string `toml:"version" koanf:"version"`
+	} `toml:"service" koanf:"service"`
 
 	HTTP struct {
-		Port         int `toml:"port"`
-		ReadTimeout  int `toml:"read_timeout"`
-		WriteTimeout int `toml:"write_timeout"`
-	} `toml:"http"`
+		Port         int `toml:"port" koanf:"port"`
+		ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+		WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
+	} `toml:"http" koanf:"http"`
+
+	GRPC struct {
+		// Port is the port of the gRPC server, which is disabled when it is zero.
+		Port int `toml:"port" koanf:"port"`
+		// Reflection serves the reflection service, which lists the services to
+		// tools like grpcurl.
+		Reflection bool `toml:"reflection" koanf:"reflection"`
+	} `toml:"grpc" koanf:"grpc"`
 
 	Storage struct {
 		Database struct {
-			Host     string `toml:"host"`
-			Port     int    `toml:"port"`
-			Username string `toml:"username"`
-			Password string `toml:"password"`
-			Database string `toml:"database"`
-		} `toml:"database"`
-	} `toml:"storage"`
+			Host     string `toml:"host" koanf:"host"`
+			Port     int    `toml:"port" koanf:"port"`
+			Username string `toml:"username" koanf:"username"`
+			Password string `toml:"password" koanf:"password"`
+			Database string `toml:"database" koanf:"database"`
+		} `toml:"database" koanf:"database"`
+	} `toml:"storage" koanf:"storage"`
 
 	Logging struct {
-		Level  string `toml:"level"`
-		Format string `toml:"format"`
-	} `toml:"logging"`
+		Level  string `toml:"level" koanf:"level"`
+		Format string `toml:"format" koanf:"format"`
+	} `toml:"logging" koanf:"logging"`
+
+	Indexer struct {
+		PollInterval int `toml:"poll_interval" koanf:"poll_interval"`
+		// IndexFailed also stores failed, backtracked and skipped delegations.
+		IndexFailed bool `toml:"index_failed" koanf:"index_failed"`
+	} `toml:"indexer" koanf:"indexer"`
+
+	Balance struct {
+		RefreshInterval int `toml:"refresh_interval" koanf:"refresh_interval"`
+		BatchSize       int `toml:"batch_size" koanf:"batch_size"`
+	} `toml:"balance" koanf:"balance"`
+
+	Reports struct {
+		WhaleInterval int `toml:"whale_interval" koanf:"whale_interval"`
+		// WhaleMinAmount is the smallest whale movement reported, in mutez.
+		WhaleMinAmount int64 `toml:"whale_min_amount" koanf:"whale_min_amount"`
+	} `toml:"reports" koanf:"reports"`
+
+	Webhooks struct {
+		DeliveryInterval int `toml:"delivery_interval" koanf:"delivery_interval"`
+		MaxAttempts      int `toml:"max_attempts" koanf:"max_attempts"`
+		Timeout          int `toml:"timeout" koanf:"timeout"`
+		// AllowPrivateEndpoints accepts endpoints on loopback and private addresses,
+		// for local development only.
+		AllowPrivateEndpoints bool `toml:"allow_private_endpoints" koanf:"allow_private_endpoints"`
+	} `toml:"webhooks" koanf:"webhooks"`
+
+	Outbox struct {
+		// Sink is the bus the outbox is relayed to: nats, kafka, redis, file or
+		// memory. The outbox is disabled when it is empty.
+		Sink string `toml:"sink" koanf:"sink"`
+		// URL is the NATS or Redis URL, the comma separated Kafka brokers or the file path.
+		URL string `toml:"url" koanf:"url"`
+		// Topic is the NATS subject prefix, the Kafka topic or the Redis stream.
+		Topic     string `toml:"topic" koanf:"topic"`
+		Interval  int    `toml:"interval" koanf:"interval"`
+		BatchSize int    `toml:"batch_size" koanf:"batch_size"`
+	} `toml:"outbox" koanf:"outbox"`
+
+	Tzkt struct {
+		BaseURL   string  `toml:"base_url" koanf:"base_url"`
+		RateLimit float64 `toml:"rate_limit" koanf:"rate_limit"`
+		RateBurst int     `toml:"rate_burst" koanf:"rate_burst"`
+	} `toml:"tzkt" koanf:"tzkt"`
+
+	API struct {
+		RateLimit float64 `toml:"rate_limit" koanf:"rate_limit"`
+		RateBurst int     `toml:"rate_burst" koanf:"rate_burst"`
+
+		// Legacy holds the dates, as YYYY-MM-DD, sent in the Deprecation and Sunset
+		// headers of the /xtz alias of /v1, none when empty.
+		Legacy struct {
+			Deprecation string `toml:"deprecation" koanf:"deprecation"`
+			Sunset      string `toml:"sunset" koanf:"sunset"`
+		} `toml:"legacy" koanf:"legacy"`
+	} `toml:"api" koanf:"api"`
+
+	Middleware struct {
+		// AccessLog logs every request with its status, duration and request id.
+		AccessLog bool `toml:"access_log" koanf:"access_log"`
+		// MaxBodySize is the largest request body accepted, in bytes, zero is unlimited.
+		MaxBodySize int64 `toml:"max_body_size" koanf:"max_body_size"`
+		// IPRateLimit is the requests per second of each client IP, zero is unlimited.
+		IPRateLimit float64 `toml:"ip_rate_limit" koanf:"ip_rate_limit"`
+		IPRateBurst int     `toml:"ip_rate_burst" koanf:"ip_rate_burst"`
+		// HSTSMaxAge sends Strict-Transport-Security, in seconds, when positive.
+		HSTSMaxAge int `toml:"hsts_max_age" koanf:"hsts_max_age"`
+		// TrustedProxies are the addresses or CIDRs whose X-Forwarded-For header
+		// gives the client IP, none are trusted by default.
+		TrustedProxies []string `toml:"trusted_proxies" koanf:"trusted_proxies"`
+
+		CORS struct {
+			// AllowedOrigins enables CORS for the origins, "*" allows every origin.
+			AllowedOrigins []string `toml:"allowed_origins" koanf:"allowed_origins"`
+			AllowedMethods []string `toml:"allowed_methods" koanf:"allowed_methods"`
+			AllowedHeaders []string `toml:"allowed_headers" koanf:"allowed_headers"`
+			ExposedHeaders []string `toml:"exposed_headers" koanf:"exposed_headers"`
+			MaxAge         int      `toml:"max_age" koanf:"max_age"`
+		} `toml:"cors" koanf:"cors"`
+	} `toml:"middleware" koanf:"middleware"`
+
+	Auth struct {
+		// Required rejects the requests without an API key, which are anonymous otherwise.
+		Required bool `toml:"required" koanf:"required"`
+		// AdminToken is the bearer token of the API key admin routes, which are
+		// disabled when it is empty.
+		AdminToken string `toml:"admin_token" koanf:"admin_token"`
+		// RateLimit, RateBurst and DailyQuota are the limits of the keys issued
+		// without limits of their own, zero is unlimited.
+		RateLimit  float64 `toml:"rate_limit" koanf:"rate_limit"`
+		RateBurst  int     `toml:"rate_burst" koanf:"rate_burst"`
+		DailyQuota int64   `toml:"daily_quota" koanf:"daily_quota"`
+	} `toml:"auth" koanf:"auth"`
+
+	Cache struct {
+		// Enabled versions the delegation responses by the indexed head, with ETag,
+		// Last-Modified and Cache-Control, and answers the conditional requests.
+		Enabled bool `toml:"enabled" koanf:"enabled"`
+		// Store keeps the rendered responses: memory, redis, or empty to only
+		// answer the conditional requests.
+		Store    string `toml:"store" koanf:"store"`
+		RedisURL string `toml:"redis_url" koanf:"redis_url"`
+		// Size is the number of responses kept by the memory store.
+		Size int `toml:"size" koanf:"size"`
+		// MaxEntrySize is the largest response stored, in bytes.
+		MaxEntrySize int `toml:"max_entry_size" koanf:"max_entry_size"`
+		// TTL is how long the redis store keeps a response, in seconds.
+		TTL int `toml:"ttl" koanf:"ttl"`
+		// MaxAge is the max-age of the responses that can still change, zero makes
+		// the clients revalidate them, and FinalMaxAge the one of the final
+		// responses, in seconds.
+		MaxAge      int `toml:"max_age" koanf:"max_age"`
+		FinalMaxAge int `toml:"final_max_age" koanf:"final_max_age"`
+		// RefreshInterval is how often the indexed head is read from the database,
+		// in seconds, for the commits of the other instances.
+		RefreshInterval int `toml:"refresh_interval" koanf:"refresh_interval"`
+	} `toml:"cache" koanf:"cache"`
+}
+
+// PollInterval returns the indexer polling interval, falling back to DefaultPollInterval.
+func (c *DelegatorConfig) PollInterval() time.Duration {
+	if c.Indexer.PollInterval <= 0 {
+		return DefaultPollInterval
+	}
+	return time.Duration(c.Indexer.PollInterval) * time.Second
+}
+
+// BalanceRefreshInterval returns the delay between two balance refreshes, zero
+// when none is configured.
+func (c *DelegatorConfig) BalanceRefreshInterval() time.Duration {
+	if c.Balance.RefreshInterval <= 0 {
+		return 0
+	}
+	return time.Duration(c.Balance.RefreshInterval) * time.Second
+}
+
+// WhaleReportInterval returns the delay between two whale reports, zero when none
+// is configured.
+func (c *DelegatorConfig) WhaleReportInterval() time.Duration {
+	if c.Reports.WhaleInterval <= 0 {
+		return 0
+	}
+	return time.Duration(c.Reports.WhaleInterval) * time.Second
+}
+
+// WebhookDeliveryInterval returns the delay between two webhook delivery rounds,
+// zero when none is configured.
+func (c *DelegatorConfig) WebhookDeliveryInterval() time.Duration {
+	if c.Webhooks.DeliveryInterval <= 0 {
+		return 0
+	}
+	return time.Duration(c.Webhooks.DeliveryInterval) * time.Second
+}
+
+// WebhookTimeout returns the timeout of a webhook delivery, zero when none is configured.
+func (c *DelegatorConfig) WebhookTimeout() time.Duration {
+	if c.Webhooks.Timeout <= 0 {
+		return 0
+	}
+	return time.Duration(c.Webhooks.Timeout) * time.Second
+}
+
+// OutboxInterval returns the delay between two outbox relay rounds, zero when
+// none is configured.
+func (c *DelegatorConfig) OutboxInterval() time.Duration {
+	if c.Outbox.Interval <= 0 {
+		return 0
+	}
+	return time.Duration(c.Outbox.Interval) * time.Second
+}
+
+// CacheTTL returns how long the redis store keeps a response, zero when none is configured.
+func (c *DelegatorConfig) CacheTTL() time.Duration {
+	if c.Cache.TTL <= 0 {
+		return 0
+	}
+	return time.Duration(c.Cache.TTL) * time.Second
+}
+
+// CacheMaxAge returns the max-age of the responses that can still change.
+func (c *DelegatorConfig) CacheMaxAge() time.Duration {
+	return time.Duration(max(c.Cache.MaxAge, 0)) * time.Second
+}
+
+// CacheFinalMaxAge returns the max-age of the final responses, zero when none is configured.
+func (c *DelegatorConfig) CacheFinalMaxAge() time.Duration {
+	return time.Duration(max(c.Cache.FinalMaxAge, 0)) * time.Second
+}
+
+// CacheRefreshInterval returns how often the indexed head is read from the database,
+// zero when none is configured.
+func (c *DelegatorConfig) CacheRefreshInterval() time.Duration {
+	if c.Cache.RefreshInterval <= 0 {
+		return 0
+	}
+	return time.Duration(c.Cache.RefreshInterval) * time.Second
+}
+
+// LegacyDates returns the deprecation and sunset dates of the /xtz alias, zero
+// when they are not configured.
+func (c *DelegatorConfig) LegacyDates() (deprecation, sunset time.Time, err error) {
+	deprecation, err = parseDate(c.API.Legacy.Deprecation)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid api.legacy.deprecation: %w", err)
+	}
+	sunset, err = parseDate(c.API.Legacy.Sunset)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid api.legacy.sunset: %w", err)
+	}
+	return deprecation, sunset, nil
+}
+
+func parseDate(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
+
+// Merge returns a copy of next in which every setting that needs a restart to
+// take effect is kept from c. The names of those settings that differ between
+// c and next are returned as ignored.
+func (c *DelegatorConfig) Merge(next *DelegatorConfig) (*DelegatorConfig, []string) {
+	merged := *next
+	var ignored []string
+
+	if c.Service != next.Service {
+		ignored = append(ignored, "service")
+		merged.Service = c.Service
+	}
+	if c.HTTP != next.HTTP {
+		ignored = append(ignored, "http")
+		merged.HTTP = c.HTTP
+	}
+	if c.GRPC != next.GRPC {
+		ignored = append(ignored, "grpc")
+		merged.GRPC = c.GRPC
+	}
+	if c.Storage != next.Storage {
+		ignored = append(ignored, "storage")
+		merged.Storage = c.Storage
+	}
+	if c.Logging.Format != next.Logging.Format {
+		ignored = append(ignored, "logging.format")
+		merged.Logging.Format = c.Logging.Format
+	}
+	if c.Indexer.IndexFailed != next.Indexer.IndexFailed {
+		ignored = append(ignored, "indexer.index_failed")
+		merged.Indexer.IndexFailed = c.Indexer.IndexFailed
+	}
+	if c.Balance != next.Balance {
+		ignored = append(ignored, "balance")
+		merged.Balance = c.Balance
+	}
+	if c.Reports != next.Reports {
+		ignored = append(ignored, "reports")
+		merged.Reports = c.Reports
+	}
+	if c.Webhooks != next.Webhooks {
+		ignored = append(ignored, "webhooks")
+		merged.Webhooks = c.Webhooks
+	}
+	if c.Outbox != next.Outbox {
+		ignored = append(ignored, "outbox")
+		merged.Outbox = c.Outbox
+	}
+	if c.Tzkt.BaseURL != next.Tzkt.BaseURL {
+		ignored = append(ignored, "tzkt.base_url")
+		merged.Tzkt.BaseURL = c.Tzkt.BaseURL
+	}
+	if c.API.Legacy != next.API.Legacy {
+		ignored = append(ignored, "api.legacy")
+		merged.API.Legacy = c.API.Legacy
+	}
+	if !reflect.DeepEqual(c.Middleware, next.Middleware) {
+		ignored = append(ignored, "middleware")
+		merged.Middleware = c.Middleware
+	}
+	if c.Auth != next.Auth {
+		ignored = append(ignored, "auth")
+		merged.Auth = c.Auth
+	}
+	if c.Cache != next.Cache {
+		ignored = append(ignored, "cache")
+		merged.Cache = c.Cache
+	}
+
+	return &merged, ignored
 }
 
 func LoadConfig() (*DelegatorConfig, error) {
@@ -40,3 +345,16 @@ func LoadConfig() (*DelegatorConfig, error) {
 
 	return &dConfig, nil
 }
+
+// LoadConfigFromFile loads the configuration from a file on disk rather than
+// from the embedded FileFS, so that edits can be picked up at runtime.
+func LoadConfigFromFile(path string) (*DelegatorConfig, error) {
+	var dConfig DelegatorConfig
+
+	err := config.Load(&dConfig, config.WithFName(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dConfig, nil
+}
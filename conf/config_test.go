@@ -1,7 +1,10 @@
 package conf
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -61,6 +64,44 @@ func TestLoadConfig(t *testing.T) {
 	}
 }
 
+// TestLoadConfigFromFile_LocalValues loads config.local.toml and checks the
+// multi-word keys, which are only decoded through their koanf tags.
+func TestLoadConfigFromFile_LocalValues(t *testing.T) {
+	t.Parallel()
+
+	config, err := LoadConfigFromFile("config.local.toml")
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Equal(t, 3600, config.HTTP.ReadTimeout)
+	assert.Equal(t, 30*time.Second, config.PollInterval())
+	assert.False(t, config.Indexer.IndexFailed)
+	assert.Equal(t, time.Hour, config.BalanceRefreshInterval())
+	assert.Equal(t, 100, config.Balance.BatchSize)
+	assert.Equal(t, 24*time.Hour, config.WhaleReportInterval())
+	assert.Equal(t, int64(100000000000), config.Reports.WhaleMinAmount)
+	assert.Equal(t, 5*time.Second, config.WebhookDeliveryInterval())
+	assert.Equal(t, 8, config.Webhooks.MaxAttempts)
+	assert.Equal(t, 100, config.Outbox.BatchSize)
+	assert.Equal(t, "https://api.tzkt.io/v1/", config.Tzkt.BaseURL)
+	assert.Equal(t, float64(10), config.Tzkt.RateLimit)
+	assert.Equal(t, float64(50), config.API.RateLimit)
+	assert.Equal(t, 100, config.API.RateBurst)
+	assert.Equal(t, "2027-04-30", config.API.Legacy.Sunset)
+	assert.True(t, config.Middleware.AccessLog)
+	assert.Equal(t, int64(1048576), config.Middleware.MaxBodySize)
+	assert.Equal(t, float64(20), config.Middleware.IPRateLimit)
+	assert.Equal(t, []string{"*"}, config.Middleware.CORS.AllowedOrigins)
+	assert.Equal(t, 600, config.Middleware.CORS.MaxAge)
+	assert.Equal(t, float64(10), config.Auth.RateLimit)
+	assert.Equal(t, int64(100000), config.Auth.DailyQuota)
+	assert.Equal(t, 1048576, config.Cache.MaxEntrySize)
+	assert.Equal(t, 24*time.Hour, config.CacheFinalMaxAge())
+	assert.Equal(t, 5*time.Second, config.CacheRefreshInterval())
+}
+
 func TestDelegatorConfig_Structure(t *testing.T) {
 	t.Parallel()
 
@@ -76,16 +117,16 @@ func TestDelegatorConfig_Structure(t *testing.T) {
 			name: "Initialize_Config_With_Values",
 			config: &DelegatorConfig{
 				Service: struct {
-					Name    string `toml:"name"`
-					Version string `toml:"version"`
+					Name    string `toml:"name" koanf:"name"`
+					Version string `toml:"version" koanf:"version"`
 				}{
 					Name:    "test-service",
 					Version: "2.0.0",
 				},
 				HTTP: struct {
-					Port         int `toml:"port"`
-					ReadTimeout  int `toml:"read_timeout"`
-					WriteTimeout int `toml:"write_timeout"`
+					Port         int `toml:"port" koanf:"port"`
+					ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+					WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 				}{
 					Port:         9999,
 					ReadTimeout:  30,
@@ -93,19 +134,19 @@ func TestDelegatorConfig_Structure(t *testing.T) {
 				},
 				Storage: struct {
 					Database struct {
-						Host     string `toml:"host"`
-						Port     int    `toml:"port"`
-						Username string `toml:"username"`
-						Password string `toml:"password"`
-						Database string `toml:"database"`
-					} `toml:"database"`
+						Host     string `toml:"host" koanf:"host"`
+						Port     int    `toml:"port" koanf:"port"`
+						Username string `toml:"username" koanf:"username"`
+						Password string `toml:"password" koanf:"password"`
+						Database string `toml:"database" koanf:"database"`
+					} `toml:"database" koanf:"database"`
 				}{
 					Database: struct {
-						Host     string `toml:"host"`
-						Port     int    `toml:"port"`
-						Username string `toml:"username"`
-						Password string `toml:"password"`
-						Database string `toml:"database"`
+						Host     string `toml:"host" koanf:"host"`
+						Port     int    `toml:"port" koanf:"port"`
+						Username string `toml:"username" koanf:"username"`
+						Password string `toml:"password" koanf:"password"`
+						Database string `toml:"database" koanf:"database"`
 					}{
 						Host:     "localhost",
 						Port:     5433,
@@ -115,8 +156,8 @@ func TestDelegatorConfig_Structure(t *testing.T) {
 					},
 				},
 				Logging: struct {
-					Level  string `toml:"level"`
-					Format string `toml:"format"`
+					Level  string `toml:"level" koanf:"level"`
+					Format string `toml:"format" koanf:"format"`
 				}{
 					Level:  "debug",
 					Format: "text",
@@ -180,4 +221,301 @@ func TestFileFS_Embedded(t *testing.T) {
 			assert.True(t, foundFile, "Expected to find %s in embedded filesystem", tt.expectedFile)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestDelegatorConfig_PollInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		pollInterval int
+		expected     time.Duration
+	}{
+		{
+			name:         "Default_When_Unset",
+			pollInterval: 0,
+			expected:     DefaultPollInterval,
+		},
+		{
+			name:         "Default_When_Negative",
+			pollInterval: -5,
+			expected:     DefaultPollInterval,
+		},
+		{
+			name:         "Configured_Value",
+			pollInterval: 10,
+			expected:     10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &DelegatorConfig{}
+			config.Indexer.PollInterval = tt.pollInterval
+
+			assert.Equal(t, tt.expected, config.PollInterval())
+		})
+	}
+}
+
+func TestDelegatorConfig_BalanceRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		refreshInterval int
+		expected        time.Duration
+	}{
+		{
+			name:            "Zero_When_Unset",
+			refreshInterval: 0,
+			expected:        0,
+		},
+		{
+			name:            "Configured_Value",
+			refreshInterval: 600,
+			expected:        10 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &DelegatorConfig{}
+			config.Balance.RefreshInterval = tt.refreshInterval
+
+			assert.Equal(t, tt.expected, config.BalanceRefreshInterval())
+		})
+	}
+}
+
+// The values of config.local.toml are also the defaults of the balance tracker,
+// so the loading of the balance keys is checked with other ones.
+func TestLoadConfigFromFile_Balance(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	err := os.WriteFile(path, []byte("[balance]\nrefresh_interval = 600\nbatch_size = 50\n"), 0o600)
+	assert.NoError(t, err)
+
+	config, err := LoadConfigFromFile(path)
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+
+	assert.Equal(t, 10*time.Minute, config.BalanceRefreshInterval())
+	assert.Equal(t, 50, config.Balance.BatchSize)
+}
+
+func TestDelegatorConfig_WhaleReportInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		whaleInterval int
+		expected      time.Duration
+	}{
+		{
+			name:          "Zero_When_Unset",
+			whaleInterval: 0,
+			expected:      0,
+		},
+		{
+			name:          "Configured_Value",
+			whaleInterval: 86400,
+			expected:      24 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &DelegatorConfig{}
+			config.Reports.WhaleInterval = tt.whaleInterval
+
+			assert.Equal(t, tt.expected, config.WhaleReportInterval())
+		})
+	}
+}
+
+func TestDelegatorConfig_WebhookDurations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		deliveryInterval int
+		timeout          int
+		expectedInterval time.Duration
+		expectedTimeout  time.Duration
+	}{
+		{
+			name:             "Zero_When_Unset",
+			expectedInterval: 0,
+			expectedTimeout:  0,
+		},
+		{
+			name:             "Configured_Values",
+			deliveryInterval: 5,
+			timeout:          10,
+			expectedInterval: 5 * time.Second,
+			expectedTimeout:  10 * time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := &DelegatorConfig{}
+			config.Webhooks.DeliveryInterval = tt.deliveryInterval
+			config.Webhooks.Timeout = tt.timeout
+
+			assert.Equal(t, tt.expectedInterval, config.WebhookDeliveryInterval())
+			assert.Equal(t, tt.expectedTimeout, config.WebhookTimeout())
+		})
+	}
+}
+
+func TestDelegatorConfig_OutboxInterval(t *testing.T) {
+	t.Parallel()
+
+	config := &DelegatorConfig{}
+	assert.Zero(t, config.OutboxInterval())
+
+	config.Outbox.Interval = 2
+	assert.Equal(t, 2*time.Second, config.OutboxInterval())
+}
+
+func TestDelegatorConfig_Cache(t *testing.T) {
+	t.Parallel()
+
+	config := &DelegatorConfig{}
+	assert.Zero(t, config.CacheTTL())
+	assert.Zero(t, config.CacheMaxAge())
+	assert.Zero(t, config.CacheFinalMaxAge())
+	assert.Zero(t, config.CacheRefreshInterval())
+
+	config.Cache.TTL = 60
+	config.Cache.MaxAge = 5
+	config.Cache.FinalMaxAge = 86400
+	config.Cache.RefreshInterval = 2
+	assert.Equal(t, time.Minute, config.CacheTTL())
+	assert.Equal(t, 5*time.Second, config.CacheMaxAge())
+	assert.Equal(t, 24*time.Hour, config.CacheFinalMaxAge())
+	assert.Equal(t, 2*time.Second, config.CacheRefreshInterval())
+}
+
+func TestDelegatorConfig_LegacyDates(t *testing.T) {
+	t.Parallel()
+
+	config := &DelegatorConfig{}
+	deprecation, sunset, err := config.LegacyDates()
+	assert.NoError(t, err)
+	assert.Zero(t, deprecation)
+	assert.Zero(t, sunset)
+
+	config.API.Legacy.Deprecation = "2026-10-01"
+	config.API.Legacy.Sunset = "2027-06-30"
+	deprecation, sunset, err = config.LegacyDates()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), deprecation)
+	assert.Equal(t, time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC), sunset)
+
+	config.API.Legacy.Sunset = "next year"
+	_, _, err = config.LegacyDates()
+	assert.ErrorContains(t, err, "invalid api.legacy.sunset")
+}
+
+func TestDelegatorConfig_Merge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		update          func(next *DelegatorConfig)
+		expectedIgnored []string
+		check           func(t *testing.T, merged *DelegatorConfig)
+	}{
+		{
+			name:            "No_Changes",
+			update:          func(next *DelegatorConfig) {},
+			expectedIgnored: nil,
+			check:           func(t *testing.T, merged *DelegatorConfig) {},
+		},
+		{
+			name: "Reloadable_Changes_Applied",
+			update: func(next *DelegatorConfig) {
+				next.Logging.Level = "debug"
+				next.Indexer.PollInterval = 5
+				next.Tzkt.RateLimit = 2
+				next.API.RateBurst = 7
+			},
+			expectedIgnored: nil,
+			check: func(t *testing.T, merged *DelegatorConfig) {
+				assert.Equal(t, "debug", merged.Logging.Level)
+				assert.Equal(t, 5, merged.Indexer.PollInterval)
+				assert.Equal(t, float64(2), merged.Tzkt.RateLimit)
+				assert.Equal(t, 7, merged.API.RateBurst)
+			},
+		},
+		{
+			name: "Structural_Changes_Ignored",
+			update: func(next *DelegatorConfig) {
+				next.HTTP.Port = 9999
+				next.GRPC.Port = 9091
+				next.Storage.Database.Host = "elsewhere"
+				next.Tzkt.BaseURL = "https://example.com/"
+				next.Indexer.PollInterval = 5
+				next.Indexer.IndexFailed = true
+				next.Balance.BatchSize = 10
+				next.Reports.WhaleInterval = 60
+				next.Webhooks.MaxAttempts = 3
+				next.Outbox.Sink = "nats"
+				next.API.Legacy.Sunset = "2027-06-30"
+				next.Middleware.CORS.AllowedOrigins = []string{"*"}
+				next.Auth.Required = true
+				next.Cache.Store = "redis"
+			},
+			expectedIgnored: []string{"http", "grpc", "storage", "indexer.index_failed", "balance", "reports", "webhooks", "outbox", "tzkt.base_url", "api.legacy", "middleware", "auth", "cache"},
+			check: func(t *testing.T, merged *DelegatorConfig) {
+				assert.Equal(t, 8888, merged.HTTP.Port)
+				assert.Zero(t, merged.GRPC.Port)
+				assert.Equal(t, "postgres", merged.Storage.Database.Host)
+				assert.Equal(t, "https://api.tzkt.io/v1/", merged.Tzkt.BaseURL)
+				assert.False(t, merged.Indexer.IndexFailed)
+				assert.Zero(t, merged.Balance.BatchSize)
+				assert.Zero(t, merged.Reports.WhaleInterval)
+				assert.Zero(t, merged.Webhooks.MaxAttempts)
+				assert.Empty(t, merged.Outbox.Sink)
+				assert.Empty(t, merged.API.Legacy.Sunset)
+				assert.Empty(t, merged.Middleware.CORS.AllowedOrigins)
+				assert.False(t, merged.Auth.Required)
+				assert.Empty(t, merged.Cache.Store)
+				assert.Equal(t, 5, merged.Indexer.PollInterval)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := &DelegatorConfig{}
+			current.HTTP.Port = 8888
+			current.Storage.Database.Host = "postgres"
+			current.Tzkt.BaseURL = "https://api.tzkt.io/v1/"
+			current.Indexer.PollInterval = 30
+
+			next := *current
+			tt.update(&next)
+
+			merged, ignored := current.Merge(&next)
+			assert.Equal(t, tt.expectedIgnored, ignored)
+			tt.check(t, merged)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package conf
+
+import "golang.org/x/time/rate"
+
+// RateLimit converts a configured rate_limit, in requests per second, to the
+// limit of a rate.Limiter. Zero or less is unlimited.
+func RateLimit(limit float64) rate.Limit {
+	if limit <= 0 {
+		return rate.Inf
+	}
+	return rate.Limit(limit)
+}
+
+// RateBurst converts a configured rate_burst to the burst of a rate.Limiter,
+// which is at least one.
+func RateBurst(burst int) int {
+	if burst <= 0 {
+		return 1
+	}
+	return burst
+}
@@ -0,0 +1,33 @@
+package conf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		limit         float64
+		burst         int
+		expectedLimit rate.Limit
+		expectedBurst int
+	}{
+		{name: "Configured", limit: 10, burst: 20, expectedLimit: 10, expectedBurst: 20},
+		{name: "Zero", expectedLimit: rate.Inf, expectedBurst: 1},
+		{name: "Negative", limit: -1, burst: -1, expectedLimit: rate.Inf, expectedBurst: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expectedLimit, RateLimit(tt.limit))
+			assert.Equal(t, tt.expectedBurst, RateBurst(tt.burst))
+		})
+	}
+}
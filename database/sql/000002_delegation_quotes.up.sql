@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS delegation_quotes (
+    delegation_id uuid PRIMARY KEY REFERENCES delegations(id) ON DELETE CASCADE,
+
+    btc DOUBLE PRECISION,
+    eur DOUBLE PRECISION,
+    usd DOUBLE PRECISION,
+    cny DOUBLE PRECISION,
+    jpy DOUBLE PRECISION,
+    krw DOUBLE PRECISION,
+    eth DOUBLE PRECISION,
+    gbp DOUBLE PRECISION
+);
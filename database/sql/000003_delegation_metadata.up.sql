@@ -0,0 +1,18 @@
+ALTER TABLE delegations
+    ADD COLUMN IF NOT EXISTS operation_id BIGINT,
+    ADD COLUMN IF NOT EXISTS block VARCHAR(60),
+    ADD COLUMN IF NOT EXISTS counter BIGINT,
+    ADD COLUMN IF NOT EXISTS gas_used INT,
+    ADD COLUMN IF NOT EXISTS baker_fee BIGINT,
+    ADD COLUMN IF NOT EXISTS initiator VARCHAR(50),
+    ADD COLUMN IF NOT EXISTS nonce INT,
+    ADD COLUMN IF NOT EXISTS staking_updates_count INT,
+    ADD COLUMN IF NOT EXISTS delegator_alias VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS baker_alias VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS previous_baker_alias VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS initiator_alias VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_delegations_operation_id ON delegations(operation_id);
+CREATE INDEX IF NOT EXISTS idx_delegations_block ON delegations(block);
+
+ALTER TABLE bakers ADD COLUMN IF NOT EXISTS alias VARCHAR(100);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS failed_delegations (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    operation_id BIGINT NOT NULL UNIQUE,
+    operation_hash VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    errors JSONB,
+
+    delegator VARCHAR(50) NOT NULL,
+    baker VARCHAR(50),
+    previous_baker VARCHAR(50),
+
+    amount BIGINT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+    level BIGINT NOT NULL,
+
+    created_at TIMESTAMP DEFAULT NOW(),
+    indexed_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_failed_delegations_delegator ON failed_delegations(delegator);
+CREATE INDEX IF NOT EXISTS idx_failed_delegations_baker ON failed_delegations(baker);
+CREATE INDEX IF NOT EXISTS idx_failed_delegations_status ON failed_delegations(status);
+CREATE INDEX IF NOT EXISTS idx_failed_delegations_level ON failed_delegations(level);
@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS staking_operations (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+
+    operation_id BIGINT NOT NULL UNIQUE,
+    operation_hash VARCHAR(100) NOT NULL,
+    block VARCHAR(60) NOT NULL,
+    level BIGINT NOT NULL,
+    timestamp TIMESTAMP NOT NULL,
+
+    action VARCHAR(20) NOT NULL,
+    staker VARCHAR(50) NOT NULL,
+    staker_alias VARCHAR(100),
+    baker VARCHAR(50) NOT NULL,
+    baker_alias VARCHAR(100),
+
+    requested_amount BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    baker_fee BIGINT NOT NULL,
+    gas_used INT,
+    staking_updates_count INT,
+
+    created_at TIMESTAMP DEFAULT NOW(),
+    indexed_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_staking_operations_level ON staking_operations(level);
+CREATE INDEX IF NOT EXISTS idx_staking_operations_staker ON staking_operations(staker);
+CREATE INDEX IF NOT EXISTS idx_staking_operations_baker ON staking_operations(baker);
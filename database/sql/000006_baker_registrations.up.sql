@@ -0,0 +1,36 @@
+ALTER TABLE delegations ADD COLUMN IF NOT EXISTS is_self_delegation BOOLEAN DEFAULT FALSE;
+
+UPDATE delegations SET is_self_delegation = TRUE WHERE delegator = baker_id;
+
+ALTER TABLE bakers
+    ADD COLUMN IF NOT EXISTS active BOOLEAN DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS registration_level BIGINT,
+    ADD COLUMN IF NOT EXISTS registration_timestamp TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS deactivation_level BIGINT,
+    ADD COLUMN IF NOT EXISTS deactivation_timestamp TIMESTAMP;
+
+-- A baker is registered by its latest self-delegation, and deactivated when it
+-- delegates away from itself afterwards.
+UPDATE bakers b
+SET active = TRUE,
+    registration_level = r.level,
+    registration_timestamp = r.timestamp
+FROM (
+    SELECT DISTINCT ON (delegator) delegator, level, timestamp
+    FROM delegations
+    WHERE is_self_delegation
+    ORDER BY delegator, level DESC
+) r
+WHERE b.address = r.delegator;
+
+UPDATE bakers b
+SET active = FALSE,
+    deactivation_level = d.level,
+    deactivation_timestamp = d.timestamp
+FROM (
+    SELECT DISTINCT ON (delegator) delegator, level, timestamp
+    FROM delegations
+    WHERE previous_baker = delegator AND NOT is_self_delegation
+    ORDER BY delegator, level DESC
+) d
+WHERE b.address = d.delegator AND d.level > COALESCE(b.registration_level, 0);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS delegator_balances (
+    address VARCHAR(50) PRIMARY KEY,
+    balance BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS baker_balance_snapshots (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    baker VARCHAR(50) NOT NULL,
+    delegated_balance BIGINT NOT NULL,
+    delegators BIGINT NOT NULL,
+    taken_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_baker_balance_snapshots_baker_taken_at ON baker_balance_snapshots(baker, taken_at DESC);
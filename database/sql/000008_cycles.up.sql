@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS protocols (
+    code INTEGER PRIMARY KEY,
+    hash VARCHAR(60) NOT NULL,
+    first_level BIGINT NOT NULL,
+    last_level BIGINT,
+    first_cycle BIGINT NOT NULL,
+    first_cycle_level BIGINT NOT NULL,
+    blocks_per_cycle BIGINT NOT NULL,
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+-- The cycle is assigned once the protocols are synced, existing delegations are
+-- backfilled on the first sync.
+ALTER TABLE delegations ADD COLUMN IF NOT EXISTS cycle BIGINT;
+
+CREATE INDEX IF NOT EXISTS idx_delegations_cycle ON delegations(cycle);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS whale_reports (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    period_from TIMESTAMP NOT NULL,
+    period_to TIMESTAMP NOT NULL,
+    generated_at TIMESTAMP NOT NULL,
+    report JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_whale_reports_generated_at ON whale_reports(generated_at DESC);
@@ -0,0 +1,3 @@
+ALTER TABLE webhooks ADD COLUMN IF NOT EXISTS api_key_id uuid REFERENCES api_keys(id);
+
+CREATE INDEX IF NOT EXISTS idx_webhooks_api_key_id ON webhooks(api_key_id);
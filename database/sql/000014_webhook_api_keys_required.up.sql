@@ -0,0 +1,3 @@
+-- The webhooks are only managed with the API key that created them, the
+-- anonymous ones can no longer be listed or deleted, so their deliveries stop.
+UPDATE webhooks SET deleted_at = now() WHERE api_key_id IS NULL AND deleted_at IS NULL;
@@ -0,0 +1,79 @@
+package apikey
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultFlushInterval is the delay between two usage flushes when none is configured.
+const DefaultFlushInterval = 10 * time.Second
+
+// Flusher periodically stores the usage counted in memory by the API keys use
+// case, and the rest of it on shutdown.
+type Flusher struct {
+	logger *slog.Logger
+
+	useCase  domain.APIKeyUseCase
+	interval time.Duration
+}
+
+type FlusherOptions func(*Flusher)
+
+func FlusherWithLogger(logger *slog.Logger) FlusherOptions {
+	return func(f *Flusher) {
+		f.logger = logger
+	}
+}
+
+func FlusherWithUseCase(useCase domain.APIKeyUseCase) FlusherOptions {
+	return func(f *Flusher) {
+		f.useCase = useCase
+	}
+}
+
+// FlusherWithInterval sets the delay between two usage flushes.
+func FlusherWithInterval(interval time.Duration) FlusherOptions {
+	return func(f *Flusher) {
+		if interval > 0 {
+			f.interval = interval
+		}
+	}
+}
+
+func (f *Flusher) Run(ctx context.Context) error {
+	f.logger.Info("starting api key usage flusher", "interval", f.interval)
+
+	ticker := time.NewTicker(f.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.logger.Info("api key usage flusher stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := f.useCase.FlushUsage(ctx); err != nil {
+				f.logger.Warn("failed to flush api key usage", "error", err)
+			}
+		}
+	}
+}
+
+// Shutdown stores the usage counted since the last flush.
+func (f *Flusher) Shutdown(ctx context.Context) error {
+	f.logger.Info("shutting down api key usage flusher")
+	return f.useCase.FlushUsage(ctx)
+}
+
+func NewFlusher(opts ...FlusherOptions) *Flusher {
+	f := &Flusher{
+		interval: DefaultFlushInterval,
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
@@ -0,0 +1,128 @@
+package apikey
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// incrementUsageQuery adds requests to the usage of a key on a day and returns
+// the requests of that day, concurrent increments are serialized on the row.
+const incrementUsageQuery = `
+INSERT INTO api_key_usages (api_key_id, day, requests)
+VALUES (?, ?, ?)
+ON CONFLICT (api_key_id, day) DO UPDATE SET requests = api_key_usages.requests + EXCLUDED.requests
+RETURNING requests`
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+func (r *Repository) CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error) {
+	if err := r.dbClient.WithContext(ctx).Create(&key).Error; err != nil {
+		r.logger.Warn("error creating api key", "error", err)
+		return models.APIKey{}, err
+	}
+	return key, nil
+}
+
+// FindAPIKeys returns every key that was not revoked, oldest first.
+func (r *Repository) FindAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	var res []models.APIKey
+	if err := r.dbClient.WithContext(ctx).Order("created_at").Find(&res).Error; err != nil {
+		r.logger.Warn("error finding api keys", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindAPIKey returns a key that was not revoked, or domain.ErrNotFound.
+func (r *Repository) FindAPIKey(ctx context.Context, id uuid.UUID) (models.APIKey, error) {
+	return r.findAPIKey(ctx, "id = ?", id)
+}
+
+// FindAPIKeyByHash returns the key that was not revoked with the SHA-256 hash,
+// or domain.ErrNotFound.
+func (r *Repository) FindAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	return r.findAPIKey(ctx, "key_hash = ?", hash)
+}
+
+func (r *Repository) findAPIKey(ctx context.Context, query string, arg any) (models.APIKey, error) {
+	var res []models.APIKey
+	if err := r.dbClient.WithContext(ctx).Where(query, arg).Limit(1).Find(&res).Error; err != nil {
+		r.logger.Warn("error finding api key", "error", err)
+		return models.APIKey{}, err
+	}
+
+	if len(res) == 0 {
+		return models.APIKey{}, domain.ErrNotFound
+	}
+	return res[0], nil
+}
+
+// RevokeAPIKey soft deletes a key so that its usage is kept, or returns
+// domain.ErrNotFound.
+func (r *Repository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	res := r.dbClient.WithContext(ctx).Where("id = ?", id).Delete(&models.APIKey{})
+	if res.Error != nil {
+		r.logger.Warn("error revoking api key", "error", res.Error, "id", id)
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (r *Repository) IncrementUsage(ctx context.Context, id uuid.UUID, day time.Time, requests int64) (int64, error) {
+	var total int64
+	if err := r.dbClient.WithContext(ctx).Raw(incrementUsageQuery, id, day, requests).Scan(&total).Error; err != nil {
+		r.logger.Warn("error incrementing api key usage", "error", err, "id", id)
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *Repository) FindUsage(ctx context.Context, id uuid.UUID, from time.Time) ([]models.APIKeyUsage, error) {
+	var res []models.APIKeyUsage
+	err := r.dbClient.WithContext(ctx).
+		Where("api_key_id = ? AND day >= ?", id, from).
+		Order("day DESC").
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding api key usage", "error", err, "id", id)
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
@@ -0,0 +1,370 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultUsageDays is the number of days of usage returned when none is requested.
+	DefaultUsageDays = 30
+	// cacheTTL bounds how long an authenticated key is trusted without a lookup,
+	// a key revoked on another instance is accepted for that long at most.
+	cacheTTL = time.Minute
+	// prefixLength is the number of characters of a key kept to recognize it.
+	prefixLength = len(domain.APIKeyPrefix) + 8
+)
+
+// UseCaseImpl represent the use case implementation of the API keys.
+type UseCaseImpl struct {
+	logger     *slog.Logger
+	repository domain.APIKeyRepository
+	defaults   domain.APIKey
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cachedKey
+
+	// flushMu serializes the flushes, so that the usage is not dropped while
+	// another flush stores it.
+	flushMu sync.Mutex
+	usageMu sync.Mutex
+	usage   map[usageKey]*usageCount
+}
+
+// cachedKey is an authenticated key, keyed by its hash.
+type cachedKey struct {
+	key     domain.APIKey
+	expires time.Time
+}
+
+// usageKey is the usage of a key on a UTC day.
+type usageKey struct {
+	id  uuid.UUID
+	day time.Time
+}
+
+// usageCount is the stored requests of a usage as of the last write, and the
+// requests counted since then.
+type usageCount struct {
+	stored  int64
+	pending int64
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.APIKeyRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// UseCaseWithDefaults sets the limits of the keys issued without limits of their own.
+func UseCaseWithDefaults(rateLimit float64, rateBurst int, dailyQuota int64) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.defaults = domain.APIKey{RateLimit: rateLimit, RateBurst: rateBurst, DailyQuota: dailyQuota}
+	}
+}
+
+// IssueAPIKey stores the hash of a new key, which is only returned here.
+func (uc *UseCaseImpl) IssueAPIKey(ctx context.Context, dto domain.IssueAPIKeyDTO) (domain.APIKeyResponseType, error) {
+	raw, err := newKey()
+	if err != nil {
+		return domain.APIKeyResponseType{}, err
+	}
+
+	key := models.APIKey{
+		Name:       dto.Name,
+		Prefix:     raw[:prefixLength],
+		KeyHash:    hash(raw),
+		RateLimit:  uc.defaults.RateLimit,
+		RateBurst:  uc.defaults.RateBurst,
+		DailyQuota: uc.defaults.DailyQuota,
+		CreatedAt:  uc.now(),
+	}
+	if dto.RateLimit != nil {
+		key.RateLimit = *dto.RateLimit
+	}
+	if dto.RateBurst != nil {
+		key.RateBurst = *dto.RateBurst
+	}
+	if dto.DailyQuota != nil {
+		key.DailyQuota = *dto.DailyQuota
+	}
+
+	key, err = uc.repository.CreateAPIKey(ctx, key)
+	if err != nil {
+		return domain.APIKeyResponseType{}, err
+	}
+
+	uc.logger.Info("issued api key", "id", key.ID, "name", key.Name)
+	res := toAPIKeyResponse(key)
+	res.Key = raw
+	return res, nil
+}
+
+func (uc *UseCaseImpl) GetAPIKeys(ctx context.Context) (domain.ApiResponse[domain.APIKeyResponseType], error) {
+	keys, err := uc.repository.FindAPIKeys(ctx)
+	if err != nil {
+		return domain.ApiResponse[domain.APIKeyResponseType]{}, err
+	}
+
+	res := make([]domain.APIKeyResponseType, len(keys))
+	for i, key := range keys {
+		res[i] = toAPIKeyResponse(key)
+	}
+
+	return domain.ApiResponse[domain.APIKeyResponseType]{Data: res}, nil
+}
+
+// GetAPIKey return a key, or domain.ErrNotFound.
+func (uc *UseCaseImpl) GetAPIKey(ctx context.Context, id uuid.UUID) (domain.APIKeyResponseType, error) {
+	key, err := uc.repository.FindAPIKey(ctx, id)
+	if err != nil {
+		return domain.APIKeyResponseType{}, err
+	}
+	return toAPIKeyResponse(key), nil
+}
+
+// RevokeAPIKey revokes a key, or returns domain.ErrNotFound. The key is rejected
+// right away by this instance.
+func (uc *UseCaseImpl) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	if err := uc.repository.RevokeAPIKey(ctx, id); err != nil {
+		return err
+	}
+
+	uc.mu.Lock()
+	for hash, cached := range uc.cache {
+		if cached.key.ID == id {
+			delete(uc.cache, hash)
+		}
+	}
+	uc.mu.Unlock()
+
+	uc.logger.Info("revoked api key", "id", id)
+	return nil
+}
+
+// GetUsage returns the daily usage of a key during the last days, DefaultUsageDays
+// when days is zero, or domain.ErrNotFound. Days without requests are left out.
+func (uc *UseCaseImpl) GetUsage(ctx context.Context, id uuid.UUID, days int) (domain.ApiResponse[domain.APIKeyUsageResponseType], error) {
+	if days <= 0 {
+		days = DefaultUsageDays
+	}
+
+	if _, err := uc.repository.FindAPIKey(ctx, id); err != nil {
+		return domain.ApiResponse[domain.APIKeyUsageResponseType]{}, err
+	}
+
+	from := day(uc.now()).AddDate(0, 0, 1-days)
+	usage, err := uc.repository.FindUsage(ctx, id, from)
+	if err != nil {
+		return domain.ApiResponse[domain.APIKeyUsageResponseType]{}, err
+	}
+
+	res := make([]domain.APIKeyUsageResponseType, len(usage))
+	for i, u := range usage {
+		res[i] = domain.APIKeyUsageResponseType{
+			Day:      u.Day.Format(time.DateOnly),
+			Requests: u.Requests,
+		}
+	}
+
+	return domain.ApiResponse[domain.APIKeyUsageResponseType]{Data: res}, nil
+}
+
+// Authenticate looks a raw key up by its hash, successful lookups are cached
+// for cacheTTL.
+func (uc *UseCaseImpl) Authenticate(ctx context.Context, raw string) (domain.APIKey, error) {
+	if !strings.HasPrefix(raw, domain.APIKeyPrefix) {
+		return domain.APIKey{}, domain.ErrInvalidAPIKey
+	}
+
+	h := hash(raw)
+	now := uc.now()
+
+	uc.mu.Lock()
+	cached, ok := uc.cache[h]
+	uc.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		return cached.key, nil
+	}
+
+	key, err := uc.repository.FindAPIKeyByHash(ctx, h)
+	if errors.Is(err, domain.ErrNotFound) {
+		return domain.APIKey{}, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return domain.APIKey{}, err
+	}
+
+	res := domain.APIKey{
+		ID:         key.ID,
+		Name:       key.Name,
+		RateLimit:  key.RateLimit,
+		RateBurst:  key.RateBurst,
+		DailyQuota: key.DailyQuota,
+	}
+
+	uc.mu.Lock()
+	uc.cache[h] = cachedKey{key: res, expires: now.Add(cacheTTL)}
+	uc.mu.Unlock()
+
+	return res, nil
+}
+
+// RecordUsage counts the request in the usage of the current UTC day. Rejected
+// requests are counted as well, so that a client ignoring the quota shows up.
+// The first request of a key on a day is stored right away to load the requests
+// of the other instances, the next ones are counted in memory until FlushUsage,
+// so the requests made on other instances in the meantime are not seen yet.
+func (uc *UseCaseImpl) RecordUsage(ctx context.Context, key domain.APIKey) (domain.APIKeyQuota, error) {
+	today := day(uc.now())
+	k := usageKey{id: key.ID, day: today}
+
+	var used int64
+	uc.usageMu.Lock()
+	count, ok := uc.usage[k]
+	if ok {
+		count.pending++
+		used = count.stored + count.pending
+	}
+	uc.usageMu.Unlock()
+
+	if !ok {
+		stored, err := uc.repository.IncrementUsage(ctx, key.ID, today, 1)
+		if err != nil {
+			return domain.APIKeyQuota{}, err
+		}
+
+		uc.usageMu.Lock()
+		count, ok = uc.usage[k]
+		if !ok {
+			count = &usageCount{}
+			uc.usage[k] = count
+		}
+		count.stored = max(count.stored, stored)
+		used = count.stored + count.pending
+		uc.usageMu.Unlock()
+	}
+
+	quota := domain.APIKeyQuota{
+		Limit: key.DailyQuota,
+		Used:  used,
+		Reset: today.AddDate(0, 0, 1),
+	}
+	if quota.Limit > 0 && used > quota.Limit {
+		return quota, domain.ErrQuotaExceeded
+	}
+	return quota, nil
+}
+
+// FlushUsage adds the requests counted in memory to the stored usage, and keeps
+// the requests of the usage that failed to be stored for the next flush. The
+// usage of the past days is dropped once stored.
+func (uc *UseCaseImpl) FlushUsage(ctx context.Context) error {
+	uc.flushMu.Lock()
+	defer uc.flushMu.Unlock()
+
+	uc.usageMu.Lock()
+	pending := make(map[usageKey]int64)
+	for k, count := range uc.usage {
+		if count.pending > 0 {
+			pending[k] = count.pending
+			count.pending = 0
+		}
+	}
+	uc.usageMu.Unlock()
+
+	var errs []error
+	for k, requests := range pending {
+		stored, err := uc.repository.IncrementUsage(ctx, k.id, k.day, requests)
+
+		uc.usageMu.Lock()
+		if err != nil {
+			uc.usage[k].pending += requests
+			errs = append(errs, err)
+		} else {
+			uc.usage[k].stored = max(uc.usage[k].stored, stored)
+		}
+		uc.usageMu.Unlock()
+	}
+
+	today := day(uc.now())
+	uc.usageMu.Lock()
+	for k, count := range uc.usage {
+		if k.day.Before(today) && count.pending == 0 {
+			delete(uc.usage, k)
+		}
+	}
+	uc.usageMu.Unlock()
+
+	return errors.Join(errs...)
+}
+
+func toAPIKeyResponse(key models.APIKey) domain.APIKeyResponseType {
+	return domain.APIKeyResponseType{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		RateLimit:  key.RateLimit,
+		RateBurst:  key.RateBurst,
+		DailyQuota: key.DailyQuota,
+		CreatedAt:  key.CreatedAt,
+	}
+}
+
+// newKey returns a key made of domain.APIKeyPrefix and 32 random bytes, hex encoded.
+func newKey() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return domain.APIKeyPrefix + hex.EncodeToString(secret), nil
+}
+
+// hash returns the hex encoded SHA-256 of a key. The keys are random, a slow
+// password hash would add nothing but latency to every request.
+func hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// day returns the start of the UTC day of t.
+func day(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// NewUseCase create a new use case for the API keys.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{
+		now:   func() time.Time { return time.Now().UTC() },
+		cache: make(map[string]cachedKey),
+		usage: make(map[usageKey]*usageCount),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
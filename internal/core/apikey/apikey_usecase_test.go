@@ -0,0 +1,319 @@
+package apikey
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUseCase(repo domain.APIKeyRepository, now time.Time) *UseCaseImpl {
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(repo),
+		UseCaseWithDefaults(10, 20, 1000),
+	)
+	uc.now = func() time.Time { return now }
+	return uc
+}
+
+func TestUseCaseImpl_IssueAPIKey(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	quota := int64(0)
+
+	tests := []struct {
+		name     string
+		dto      domain.IssueAPIKeyDTO
+		expected models.APIKey
+	}{
+		{
+			name:     "Defaults",
+			dto:      domain.IssueAPIKeyDTO{Name: "dashboard"},
+			expected: models.APIKey{Name: "dashboard", RateLimit: 10, RateBurst: 20, DailyQuota: 1000},
+		},
+		{
+			name:     "Unlimited_Quota",
+			dto:      domain.IssueAPIKeyDTO{Name: "partner", DailyQuota: &quota},
+			expected: models.APIKey{Name: "partner", RateLimit: 10, RateBurst: 20, DailyQuota: 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := uuid.New()
+			var stored models.APIKey
+
+			mockRepo := mocks.NewMockAPIKeyRepository(t)
+			mockRepo.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).
+				RunAndReturn(func(ctx context.Context, key models.APIKey) (models.APIKey, error) {
+					key.ID = id
+					stored = key
+					return key, nil
+				}).Once()
+
+			res, err := newTestUseCase(mockRepo, now).IssueAPIKey(context.Background(), tt.dto)
+
+			assert.NoError(t, err)
+			assert.Equal(t, id, res.ID)
+			assert.True(t, strings.HasPrefix(res.Key, domain.APIKeyPrefix))
+			assert.Len(t, res.Key, len(domain.APIKeyPrefix)+64)
+			assert.Equal(t, res.Key[:prefixLength], res.Prefix)
+
+			assert.Equal(t, hash(res.Key), stored.KeyHash)
+			assert.Equal(t, tt.expected.Name, stored.Name)
+			assert.Equal(t, tt.expected.RateLimit, stored.RateLimit)
+			assert.Equal(t, tt.expected.RateBurst, stored.RateBurst)
+			assert.Equal(t, tt.expected.DailyQuota, stored.DailyQuota)
+			assert.Equal(t, now, stored.CreatedAt)
+		})
+	}
+}
+
+func TestUseCaseImpl_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	raw := domain.APIKeyPrefix + strings.Repeat("ab", 32)
+	stored := models.APIKey{ID: uuid.New(), Name: "partner", KeyHash: hash(raw), RateLimit: 5, RateBurst: 10, DailyQuota: 100}
+	expected := domain.APIKey{ID: stored.ID, Name: "partner", RateLimit: 5, RateBurst: 10, DailyQuota: 100}
+
+	tests := []struct {
+		name        string
+		key         string
+		setupMocks  func(*mocks.MockAPIKeyRepository)
+		expected    domain.APIKey
+		expectedErr error
+	}{
+		{
+			name: "Known_Key",
+			key:  raw,
+			setupMocks: func(m *mocks.MockAPIKeyRepository) {
+				m.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(stored, nil).Once()
+			},
+			expected: expected,
+		},
+		{
+			name: "Unknown_Key",
+			key:  raw,
+			setupMocks: func(m *mocks.MockAPIKeyRepository) {
+				m.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(models.APIKey{}, domain.ErrNotFound).Once()
+			},
+			expectedErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name:        "Foreign_Key_Not_Looked_Up",
+			key:         "sk_live_123",
+			setupMocks:  func(m *mocks.MockAPIKeyRepository) {},
+			expectedErr: domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "Repository_Error",
+			key:  raw,
+			setupMocks: func(m *mocks.MockAPIKeyRepository) {
+				m.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(models.APIKey{}, errors.New("db down")).Once()
+			},
+			expectedErr: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockAPIKeyRepository(t)
+			tt.setupMocks(mockRepo)
+
+			res, err := newTestUseCase(mockRepo, now).Authenticate(context.Background(), tt.key)
+
+			if tt.expectedErr != nil {
+				assert.ErrorContains(t, err, tt.expectedErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func TestUseCaseImpl_Authenticate_Cache(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	raw := domain.APIKeyPrefix + strings.Repeat("cd", 32)
+	stored := models.APIKey{ID: uuid.New(), KeyHash: hash(raw)}
+
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	uc := newTestUseCase(mockRepo, now)
+
+	// The second lookup is served by the cache, the lookup after the revocation misses.
+	mockRepo.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(stored, nil).Once()
+	for range 2 {
+		_, err := uc.Authenticate(context.Background(), raw)
+		assert.NoError(t, err)
+	}
+
+	mockRepo.EXPECT().RevokeAPIKey(mock.Anything, stored.ID).Return(nil).Once()
+	assert.NoError(t, uc.RevokeAPIKey(context.Background(), stored.ID))
+
+	mockRepo.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(models.APIKey{}, domain.ErrNotFound).Once()
+	_, err := uc.Authenticate(context.Background(), raw)
+	assert.ErrorIs(t, err, domain.ErrInvalidAPIKey)
+
+	// Cached keys expire, so that revocations on other instances apply.
+	mockRepo.EXPECT().FindAPIKeyByHash(mock.Anything, hash(raw)).Return(stored, nil).Twice()
+	_, err = uc.Authenticate(context.Background(), raw)
+	assert.NoError(t, err)
+	uc.now = func() time.Time { return now.Add(cacheTTL) }
+	_, err = uc.Authenticate(context.Background(), raw)
+	assert.NoError(t, err)
+}
+
+func TestUseCaseImpl_RecordUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)
+	today := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	id := uuid.New()
+
+	tests := []struct {
+		name              string
+		quota             int64
+		used              int64
+		expected          domain.APIKeyQuota
+		expectedErr       error
+		expectedRemaining int64
+	}{
+		{
+			name:              "Within_Quota",
+			quota:             10,
+			used:              10,
+			expected:          domain.APIKeyQuota{Limit: 10, Used: 10, Reset: tomorrow},
+			expectedRemaining: 0,
+		},
+		{
+			name:              "Quota_Exceeded",
+			quota:             10,
+			used:              11,
+			expected:          domain.APIKeyQuota{Limit: 10, Used: 11, Reset: tomorrow},
+			expectedErr:       domain.ErrQuotaExceeded,
+			expectedRemaining: 0,
+		},
+		{
+			name:     "Unlimited",
+			quota:    0,
+			used:     1_000_000,
+			expected: domain.APIKeyQuota{Limit: 0, Used: 1_000_000, Reset: tomorrow},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockAPIKeyRepository(t)
+			mockRepo.EXPECT().IncrementUsage(mock.Anything, id, today, int64(1)).Return(tt.used, nil).Once()
+
+			res, err := newTestUseCase(mockRepo, now).RecordUsage(context.Background(), domain.APIKey{ID: id, DailyQuota: tt.quota})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Equal(t, tt.expected, res)
+			assert.Equal(t, tt.expectedRemaining, res.Remaining())
+		})
+	}
+}
+
+func TestUseCaseImpl_FlushUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 30, 0, 0, time.UTC)
+	today := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	key := domain.APIKey{ID: uuid.New(), DailyQuota: 100}
+
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	uc := newTestUseCase(mockRepo, now)
+	uc.now = func() time.Time { return now }
+
+	used := func() int64 {
+		t.Helper()
+		quota, err := uc.RecordUsage(context.Background(), key)
+		assert.NoError(t, err)
+		return quota.Used
+	}
+
+	// The first request of the day loads the requests of the other instances.
+	mockRepo.EXPECT().IncrementUsage(mock.Anything, key.ID, today, int64(1)).Return(5, nil).Once()
+	assert.Equal(t, int64(5), used())
+	assert.Equal(t, int64(6), used())
+	assert.Equal(t, int64(7), used())
+
+	mockRepo.EXPECT().IncrementUsage(mock.Anything, key.ID, today, int64(2)).Return(10, nil).Once()
+	assert.NoError(t, uc.FlushUsage(context.Background()))
+	assert.Equal(t, int64(11), used())
+
+	// The requests that failed to be stored are kept for the next flush.
+	mockRepo.EXPECT().IncrementUsage(mock.Anything, key.ID, today, int64(1)).Return(0, errors.New("db down")).Once()
+	assert.Error(t, uc.FlushUsage(context.Background()))
+	mockRepo.EXPECT().IncrementUsage(mock.Anything, key.ID, today, int64(1)).Return(11, nil).Once()
+	assert.NoError(t, uc.FlushUsage(context.Background()))
+
+	// The usage of the past days is dropped, the new day starts from the store.
+	now = now.Add(24 * time.Hour)
+	assert.NoError(t, uc.FlushUsage(context.Background()))
+	assert.Empty(t, uc.usage)
+	mockRepo.EXPECT().IncrementUsage(mock.Anything, key.ID, tomorrow, int64(1)).Return(1, nil).Once()
+	assert.Equal(t, int64(1), used())
+}
+
+func TestUseCaseImpl_GetUsage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	id := uuid.New()
+
+	t.Run("Last_Days", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewMockAPIKeyRepository(t)
+		mockRepo.EXPECT().FindAPIKey(mock.Anything, id).Return(models.APIKey{ID: id}, nil).Once()
+		mockRepo.EXPECT().FindUsage(mock.Anything, id, time.Date(2024, 6, 4, 0, 0, 0, 0, time.UTC)).
+			Return([]models.APIKeyUsage{
+				{APIKeyID: id, Day: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC), Requests: 42},
+				{APIKeyID: id, Day: time.Date(2024, 6, 8, 0, 0, 0, 0, time.UTC), Requests: 7},
+			}, nil).Once()
+
+		res, err := newTestUseCase(mockRepo, now).GetUsage(context.Background(), id, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.APIKeyUsageResponseType{
+			{Day: "2024-06-10", Requests: 42},
+			{Day: "2024-06-08", Requests: 7},
+		}, res.Data)
+	})
+
+	t.Run("Unknown_Key", func(t *testing.T) {
+		t.Parallel()
+
+		mockRepo := mocks.NewMockAPIKeyRepository(t)
+		mockRepo.EXPECT().FindAPIKey(mock.Anything, id).Return(models.APIKey{}, domain.ErrNotFound).Once()
+
+		_, err := newTestUseCase(mockRepo, now).GetUsage(context.Background(), id, 0)
+
+		assert.ErrorIs(t, err, domain.ErrNotFound)
+	})
+}
@@ -0,0 +1,140 @@
+package balance
+
+import (
+	"context"
+	"database/sql"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+// currentDelegationsQuery selects the latest delegation of every delegator that
+// currently delegates to a baker other than itself.
+const currentDelegationsQuery = `
+SELECT delegator, baker_id FROM (
+	SELECT DISTINCT ON (delegator) delegator, baker_id, is_self_delegation
+	FROM delegations
+	ORDER BY delegator, level DESC, id DESC
+) c
+WHERE c.baker_id <> 'UNDELEGATED' AND NOT c.is_self_delegation`
+
+func (r *Repository) FindActiveDelegators(ctx context.Context) ([]string, error) {
+	var res []string
+	err := r.dbClient.WithContext(ctx).
+		Raw("SELECT delegator FROM (" + currentDelegationsQuery + ") a ORDER BY delegator").
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding active delegators", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// SaveBalances upserts the latest balance of every delegator.
+func (r *Repository) SaveBalances(ctx context.Context, balances []models.DelegatorBalance) error {
+	if len(balances) == 0 {
+		return nil
+	}
+
+	err := r.dbClient.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "address"}},
+			DoUpdates: clause.AssignmentColumns([]string{"balance", "updated_at"}),
+		}).
+		Create(&balances).Error
+	if err != nil {
+		r.logger.Warn("error saving delegator balances", "error", err)
+		return err
+	}
+	return nil
+}
+
+// snapshotQuery sums the latest balance of the current delegators of every baker.
+const snapshotQuery = `
+INSERT INTO baker_balance_snapshots (baker, delegated_balance, delegators, taken_at)
+SELECT c.baker_id, COALESCE(SUM(b.balance), 0), COUNT(*), @taken_at
+FROM (` + currentDelegationsQuery + `) c
+JOIN delegator_balances b ON b.address = c.delegator
+GROUP BY c.baker_id`
+
+func (r *Repository) SnapshotBakerBalances(ctx context.Context, takenAt time.Time) error {
+	err := r.dbClient.WithContext(ctx).
+		Exec(snapshotQuery, sql.Named("taken_at", takenAt)).Error
+	if err != nil {
+		r.logger.Warn("error snapshotting baker balances", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FindLatestBakerBalance returns the latest snapshot of a baker, or domain.ErrNotFound.
+func (r *Repository) FindLatestBakerBalance(ctx context.Context, address domain.Address) (models.BakerBalanceSnapshot, error) {
+	var res []models.BakerBalanceSnapshot
+	err := r.dbClient.WithContext(ctx).
+		Where("baker = ?", address.String()).
+		Order("taken_at DESC").
+		Limit(1).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding latest baker balance", "error", err, "address", address)
+		return models.BakerBalanceSnapshot{}, err
+	}
+
+	if len(res) == 0 {
+		return models.BakerBalanceSnapshot{}, domain.ErrNotFound
+	}
+	return res[0], nil
+}
+
+// FindBakerBalances returns the snapshots of a baker, the most recent first.
+func (r *Repository) FindBakerBalances(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter) ([]models.BakerBalanceSnapshot, error) {
+	query := r.dbClient.WithContext(ctx).
+		Model(&models.BakerBalanceSnapshot{}).
+		Where("baker = ?", address.String())
+	if filter.From != nil {
+		query = query.Where("taken_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("taken_at <= ?", *filter.To)
+	}
+
+	var res []models.BakerBalanceSnapshot
+	if err := query.Order("taken_at DESC").Find(&res).Error; err != nil {
+		r.logger.Warn("error finding baker balances", "error", err, "address", address)
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
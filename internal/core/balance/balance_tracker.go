@@ -0,0 +1,81 @@
+package balance
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultRefreshInterval is the delay between two balance refreshes when none is configured.
+const DefaultRefreshInterval = time.Hour
+
+// Tracker periodically refreshes the delegator balances and the baker delegated balances.
+type Tracker struct {
+	logger *slog.Logger
+
+	useCase  domain.BalanceUseCase
+	interval time.Duration
+}
+
+type TrackerOptions func(*Tracker)
+
+func TrackerWithLogger(logger *slog.Logger) TrackerOptions {
+	return func(t *Tracker) {
+		t.logger = logger
+	}
+}
+
+func TrackerWithUseCase(useCase domain.BalanceUseCase) TrackerOptions {
+	return func(t *Tracker) {
+		t.useCase = useCase
+	}
+}
+
+// TrackerWithInterval sets the delay between two refreshes.
+func TrackerWithInterval(interval time.Duration) TrackerOptions {
+	return func(t *Tracker) {
+		if interval > 0 {
+			t.interval = interval
+		}
+	}
+}
+
+func (t *Tracker) Run(ctx context.Context) error {
+	t.logger.Info("starting balance tracker", "interval", t.interval)
+
+	if err := t.useCase.Refresh(ctx); err != nil {
+		t.logger.Warn("initial balance refresh failed", "error", err)
+	}
+
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			t.logger.Info("balance tracker stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := t.useCase.Refresh(ctx); err != nil {
+				t.logger.Warn("balance refresh failed", "error", err)
+			}
+		}
+	}
+}
+
+func (t *Tracker) Shutdown(ctx context.Context) error {
+	t.logger.Info("shutting down balance tracker")
+	return nil
+}
+
+func NewTracker(opts ...TrackerOptions) *Tracker {
+	t := &Tracker{
+		interval: DefaultRefreshInterval,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t
+}
@@ -0,0 +1,75 @@
+package balance
+
+import (
+	"context"
+	"delegator/mocks"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewTracker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		interval         time.Duration
+		expectedInterval time.Duration
+	}{
+		{
+			name:             "Configured_Interval",
+			interval:         time.Minute,
+			expectedInterval: time.Minute,
+		},
+		{
+			name:             "Default_Interval",
+			interval:         0,
+			expectedInterval: DefaultRefreshInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := NewTracker(TrackerWithInterval(tt.interval))
+			assert.Equal(t, tt.expectedInterval, tracker.interval)
+		})
+	}
+}
+
+func TestTracker_Run(t *testing.T) {
+	t.Parallel()
+
+	mockUseCase := mocks.NewMockBalanceUseCase(t)
+	refreshed := make(chan struct{}, 2)
+	mockUseCase.EXPECT().Refresh(mock.Anything).RunAndReturn(func(ctx context.Context) error {
+		refreshed <- struct{}{}
+		return errors.New("API returned status 500")
+	}).Times(2)
+
+	tracker := NewTracker(
+		TrackerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		TrackerWithUseCase(mockUseCase),
+		TrackerWithInterval(10*time.Millisecond),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- tracker.Run(ctx)
+	}()
+
+	// A failed refresh is logged and retried on the next tick.
+	<-refreshed
+	<-refreshed
+	cancel()
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.NoError(t, tracker.Shutdown(context.Background()))
+}
@@ -0,0 +1,137 @@
+package balance
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultBatchSize is the number of accounts fetched per TzKT request when none is configured.
+const DefaultBatchSize = 100
+
+// UseCaseImpl represent the use case implementation of the balance tracking.
+type UseCaseImpl struct {
+	logger         *slog.Logger
+	repository     domain.BalanceRepository
+	accountService domain.AccountService
+	batchSize      int
+	now            func() time.Time
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.BalanceRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// UseCaseWithAccountService inject the service balances are fetched from.
+func UseCaseWithAccountService(accountService domain.AccountService) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.accountService = accountService
+	}
+}
+
+// UseCaseWithBatchSize sets the number of accounts fetched per request.
+func UseCaseWithBatchSize(batchSize int) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		if batchSize > 0 {
+			u.batchSize = batchSize
+		}
+	}
+}
+
+// Refresh fetches the balance of every active delegator in batches, then snapshots
+// the delegated balance of every baker.
+func (uc *UseCaseImpl) Refresh(ctx context.Context) error {
+	delegators, err := uc.repository.FindActiveDelegators(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info("refreshing delegator balances", "delegators", len(delegators), "batch_size", uc.batchSize)
+	for start := 0; start < len(delegators); start += uc.batchSize {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		end := min(start+uc.batchSize, len(delegators))
+		accounts, err := uc.accountService.GetAccounts(ctx, delegators[start:end])
+		if err != nil {
+			return err
+		}
+
+		updatedAt := uc.now()
+		balances := make([]models.DelegatorBalance, len(accounts))
+		for i, account := range accounts {
+			balances[i] = models.DelegatorBalance{
+				Address:   account.Address,
+				Balance:   account.Balance,
+				UpdatedAt: updatedAt,
+			}
+		}
+
+		if err := uc.repository.SaveBalances(ctx, balances); err != nil {
+			return err
+		}
+	}
+
+	return uc.repository.SnapshotBakerBalances(ctx, uc.now())
+}
+
+// GetBakerBalance return the latest delegated balance of a baker with its history,
+// or domain.ErrNotFound when it was never snapshotted.
+func (uc *UseCaseImpl) GetBakerBalance(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter, opts domain.ResponseOptions) (domain.BakerBalanceResponseType, error) {
+	latest, err := uc.repository.FindLatestBakerBalance(ctx, address)
+	if err != nil {
+		return domain.BakerBalanceResponseType{}, err
+	}
+
+	snapshots, err := uc.repository.FindBakerBalances(ctx, address, filter)
+	if err != nil {
+		return domain.BakerBalanceResponseType{}, err
+	}
+
+	unit := opts.Unit()
+	history := make([]domain.BakerBalanceSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		history[i] = domain.BakerBalanceSnapshot{
+			Timestamp:        snapshot.TakenAt,
+			DelegatedBalance: domain.NewAmount(snapshot.DelegatedBalance, unit),
+			Delegators:       snapshot.Delegators,
+		}
+	}
+
+	return domain.BakerBalanceResponseType{
+		Baker:            address,
+		DelegatedBalance: domain.NewAmount(latest.DelegatedBalance, unit),
+		Delegators:       latest.Delegators,
+		UpdatedAt:        latest.TakenAt,
+		History:          history,
+	}, nil
+}
+
+// NewUseCase create a new use case for the balance tracking.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{
+		batchSize: DefaultBatchSize,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
@@ -0,0 +1,211 @@
+package balance
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewUseCase(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		batchSize         int
+		expectedBatchSize int
+	}{
+		{
+			name:              "Configured_Batch_Size",
+			batchSize:         10,
+			expectedBatchSize: 10,
+		},
+		{
+			name:              "Default_Batch_Size",
+			batchSize:         0,
+			expectedBatchSize: DefaultBatchSize,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockBalanceRepository(t)
+			mockAccounts := mocks.NewMockAccountService(t)
+
+			uc := NewUseCase(
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithAccountService(mockAccounts),
+				UseCaseWithBatchSize(tt.batchSize),
+			)
+
+			assert.Equal(t, mockRepo, uc.repository)
+			assert.Equal(t, mockAccounts, uc.accountService)
+			assert.Equal(t, tt.expectedBatchSize, uc.batchSize)
+		})
+	}
+}
+
+func TestUseCaseImpl_Refresh(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	delegators := []string{
+		"tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+		"tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+		"tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+	}
+
+	tests := []struct {
+		name       string
+		setupMocks func(*mocks.MockBalanceRepository, *mocks.MockAccountService)
+		wantErr    bool
+	}{
+		{
+			name: "Batches_And_Snapshots",
+			setupMocks: func(repo *mocks.MockBalanceRepository, accounts *mocks.MockAccountService) {
+				repo.EXPECT().FindActiveDelegators(mock.Anything).Return(delegators, nil).Once()
+				accounts.EXPECT().GetAccounts(mock.Anything, delegators[:2]).Return([]domain.TzktApiAccountResponse{
+					{Address: delegators[0], Balance: 100},
+					{Address: delegators[1], Balance: 200},
+				}, nil).Once()
+				accounts.EXPECT().GetAccounts(mock.Anything, delegators[2:]).Return([]domain.TzktApiAccountResponse{
+					{Address: delegators[2], Balance: 300},
+				}, nil).Once()
+				repo.EXPECT().SaveBalances(mock.Anything, []models.DelegatorBalance{
+					{Address: delegators[0], Balance: 100, UpdatedAt: now},
+					{Address: delegators[1], Balance: 200, UpdatedAt: now},
+				}).Return(nil).Once()
+				repo.EXPECT().SaveBalances(mock.Anything, []models.DelegatorBalance{
+					{Address: delegators[2], Balance: 300, UpdatedAt: now},
+				}).Return(nil).Once()
+				repo.EXPECT().SnapshotBakerBalances(mock.Anything, now).Return(nil).Once()
+			},
+		},
+		{
+			name: "No_Active_Delegators",
+			setupMocks: func(repo *mocks.MockBalanceRepository, accounts *mocks.MockAccountService) {
+				repo.EXPECT().FindActiveDelegators(mock.Anything).Return(nil, nil).Once()
+				repo.EXPECT().SnapshotBakerBalances(mock.Anything, now).Return(nil).Once()
+			},
+		},
+		{
+			name: "Account_Service_Error",
+			setupMocks: func(repo *mocks.MockBalanceRepository, accounts *mocks.MockAccountService) {
+				repo.EXPECT().FindActiveDelegators(mock.Anything).Return(delegators, nil).Once()
+				accounts.EXPECT().GetAccounts(mock.Anything, delegators[:2]).Return(nil, errors.New("API returned status 429")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockBalanceRepository, accounts *mocks.MockAccountService) {
+				repo.EXPECT().FindActiveDelegators(mock.Anything).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockBalanceRepository(t)
+			mockAccounts := mocks.NewMockAccountService(t)
+			tt.setupMocks(mockRepo, mockAccounts)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithAccountService(mockAccounts),
+				UseCaseWithBatchSize(2),
+			)
+			uc.now = func() time.Time { return now }
+
+			err := uc.Refresh(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetBakerBalance(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	latest := time.Date(2024, 6, 2, 12, 0, 0, 0, time.UTC)
+	previous := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	filter := domain.BalanceHistoryFilter{From: &previous}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockBalanceRepository)
+		expectedResult domain.BakerBalanceResponseType
+		expectedErr    error
+	}{
+		{
+			name: "Found",
+			setupMocks: func(repo *mocks.MockBalanceRepository) {
+				repo.EXPECT().FindLatestBakerBalance(mock.Anything, baker).Return(models.BakerBalanceSnapshot{
+					Baker: baker.String(), DelegatedBalance: 3000000, Delegators: 3, TakenAt: latest,
+				}, nil).Once()
+				repo.EXPECT().FindBakerBalances(mock.Anything, baker, filter).Return([]models.BakerBalanceSnapshot{
+					{Baker: baker.String(), DelegatedBalance: 3000000, Delegators: 3, TakenAt: latest},
+					{Baker: baker.String(), DelegatedBalance: 2000000, Delegators: 2, TakenAt: previous},
+				}, nil).Once()
+			},
+			expectedResult: domain.BakerBalanceResponseType{
+				Baker:            baker,
+				DelegatedBalance: domain.NewAmount(3000000, domain.UnitTez),
+				Delegators:       3,
+				UpdatedAt:        latest,
+				History: []domain.BakerBalanceSnapshot{
+					{Timestamp: latest, DelegatedBalance: domain.NewAmount(3000000, domain.UnitTez), Delegators: 3},
+					{Timestamp: previous, DelegatedBalance: domain.NewAmount(2000000, domain.UnitTez), Delegators: 2},
+				},
+			},
+		},
+		{
+			name: "Not_Found",
+			setupMocks: func(repo *mocks.MockBalanceRepository) {
+				repo.EXPECT().FindLatestBakerBalance(mock.Anything, baker).Return(models.BakerBalanceSnapshot{}, domain.ErrNotFound).Once()
+			},
+			expectedErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockBalanceRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			res, err := uc.GetBakerBalance(context.Background(), baker, filter, domain.ResponseOptions{Units: domain.UnitTez})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package cache
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// DefaultRefreshInterval is how often the head is read from the database when
+// none is configured.
+const DefaultRefreshInterval = 5 * time.Second
+
+// Head tracks the indexed head. The commits of this instance advance it right
+// away, the ones of other instances are read from the database at most every
+// refresh interval.
+type Head struct {
+	logger          *slog.Logger
+	repository      domain.Repository
+	store           domain.ResponseStore
+	refreshInterval time.Duration
+	now             func() time.Time
+
+	mu        sync.Mutex
+	head      domain.IndexHead
+	refreshed time.Time
+}
+
+type HeadOptions func(*Head)
+
+func HeadWithLogger(logger *slog.Logger) HeadOptions {
+	return func(h *Head) {
+		h.logger = logger
+	}
+}
+
+func HeadWithRepository(repository domain.Repository) HeadOptions {
+	return func(h *Head) {
+		h.repository = repository
+	}
+}
+
+// HeadWithStore invalidates the responses of store when the head advances.
+func HeadWithStore(store domain.ResponseStore) HeadOptions {
+	return func(h *Head) {
+		h.store = store
+	}
+}
+
+// HeadWithRefreshInterval sets how often the head is read from the database,
+// non-positive intervals keep DefaultRefreshInterval.
+func HeadWithRefreshInterval(interval time.Duration) HeadOptions {
+	return func(h *Head) {
+		if interval > 0 {
+			h.refreshInterval = interval
+		}
+	}
+}
+
+// Current returns the indexed head, read from the database when it is older than
+// the refresh interval. The last known head is returned if that read fails.
+func (h *Head) Current(ctx context.Context) (domain.IndexHead, error) {
+	// The first request to see a stale head refreshes it, the others keep using
+	// the current one meanwhile.
+	h.mu.Lock()
+	head, now := h.head, h.now()
+	stale := now.Sub(h.refreshed) >= h.refreshInterval
+	if stale {
+		h.refreshed = now
+	}
+	h.mu.Unlock()
+	if !stale {
+		return head, nil
+	}
+
+	stored, err := h.repository.GetIndexHead(ctx)
+	if err != nil {
+		if head.Level > 0 {
+			h.logger.Warn("failed to refresh index head, keeping the last one", "error", err, "level", head.Level)
+			return head, nil
+		}
+		h.mu.Lock()
+		h.refreshed = time.Time{}
+		h.mu.Unlock()
+		return domain.IndexHead{}, err
+	}
+
+	h.Advance(ctx, stored)
+	return h.get(), nil
+}
+
+// Advance moves the head forward and invalidates the responses of the store
+// rendered before it. Older heads are ignored.
+func (h *Head) Advance(ctx context.Context, head domain.IndexHead) {
+	h.mu.Lock()
+	if head.Level <= h.head.Level {
+		h.mu.Unlock()
+		return
+	}
+	h.head = head
+	h.mu.Unlock()
+
+	if h.store == nil {
+		return
+	}
+	if err := h.store.Invalidate(ctx, head.Level); err != nil {
+		h.logger.Warn("failed to invalidate cached responses", "error", err, "level", head.Level)
+	}
+}
+
+func (h *Head) get() domain.IndexHead {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.head
+}
+
+func NewHead(opts ...HeadOptions) *Head {
+	h := &Head{
+		logger:          slog.Default(),
+		refreshInterval: DefaultRefreshInterval,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHead_Current(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	stored := domain.IndexHead{Level: 1000, Timestamp: now.Add(-time.Minute)}
+
+	mockRepo := mocks.NewMockRepository(t)
+	mockStore := mocks.NewMockResponseStore(t)
+	head := NewHead(
+		HeadWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		HeadWithRepository(mockRepo),
+		HeadWithStore(mockStore),
+		HeadWithRefreshInterval(5*time.Second),
+	)
+	head.now = func() time.Time { return now }
+
+	// The first request reads the head, the next ones reuse it until it is stale.
+	mockRepo.EXPECT().GetIndexHead(mock.Anything).Return(stored, nil).Once()
+	mockStore.EXPECT().Invalidate(mock.Anything, int64(1000)).Return(nil).Once()
+	for range 2 {
+		res, err := head.Current(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, stored, res)
+	}
+
+	// A commit of this instance advances the head right away.
+	committed := domain.IndexHead{Level: 1002, Timestamp: now}
+	mockStore.EXPECT().Invalidate(mock.Anything, int64(1002)).Return(errors.New("ignored")).Once()
+	head.Advance(context.Background(), committed)
+	res, err := head.Current(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, committed, res)
+
+	// A refresh returning an older head, or failing, keeps the current one.
+	now = now.Add(5 * time.Second)
+	mockRepo.EXPECT().GetIndexHead(mock.Anything).Return(stored, nil).Once()
+	res, err = head.Current(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, committed, res)
+
+	now = now.Add(5 * time.Second)
+	mockRepo.EXPECT().GetIndexHead(mock.Anything).Return(domain.IndexHead{}, errors.New("db down")).Once()
+	res, err = head.Current(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, committed, res)
+}
+
+func TestHead_Current_Error(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := mocks.NewMockRepository(t)
+	head := NewHead(
+		HeadWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		HeadWithRepository(mockRepo),
+	)
+
+	// Without a known head the error is returned, and the next request retries.
+	mockRepo.EXPECT().GetIndexHead(mock.Anything).Return(domain.IndexHead{}, errors.New("db down")).Once()
+	_, err := head.Current(context.Background())
+	assert.Error(t, err)
+
+	mockRepo.EXPECT().GetIndexHead(mock.Anything).Return(domain.IndexHead{Level: 7}, nil).Once()
+	res, err := head.Current(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), res.Level)
+}
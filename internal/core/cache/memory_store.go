@@ -0,0 +1,107 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"delegator/pkg/domain"
+	"sync"
+)
+
+// DefaultSize is the number of responses kept by a MemoryStore when none is configured.
+const DefaultSize = 1000
+
+// MemoryStore is an in-process LRU of the rendered responses, it is safe for
+// concurrent use.
+type MemoryStore struct {
+	size int
+
+	mu      sync.Mutex
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key string
+	res domain.CachedResponse
+}
+
+type MemoryStoreOptions func(*MemoryStore)
+
+// MemoryStoreWithSize sets the number of responses kept, non-positive sizes keep
+// DefaultSize.
+func MemoryStoreWithSize(size int) MemoryStoreOptions {
+	return func(s *MemoryStore) {
+		if size > 0 {
+			s.size = size
+		}
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (domain.CachedResponse, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return domain.CachedResponse{}, false, nil
+	}
+	s.order.MoveToFront(elem)
+	return elem.Value.(*memoryEntry).res, true, nil
+}
+
+// Set stores a response, evicting the least recently used one when the store is full.
+func (s *MemoryStore) Set(ctx context.Context, key string, res domain.CachedResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.entries[key]; ok {
+		elem.Value.(*memoryEntry).res = res
+		s.order.MoveToFront(elem)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&memoryEntry{key: key, res: res})
+	if s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+func (s *MemoryStore) Invalidate(ctx context.Context, level int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for elem := s.order.Front(); elem != nil; {
+		next := elem.Next()
+		if entry := elem.Value.(*memoryEntry); entry.res.Level != 0 && entry.res.Level < level {
+			s.remove(elem)
+		}
+		elem = next
+	}
+	return nil
+}
+
+// Len returns the number of responses stored.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+// remove drops an entry, s.mu must be held.
+func (s *MemoryStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*memoryEntry).key)
+}
+
+func NewMemoryStore(opts ...MemoryStoreOptions) *MemoryStore {
+	s := &MemoryStore{
+		size:    DefaultSize,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewMemoryStore(MemoryStoreWithSize(2))
+
+	a := domain.CachedResponse{Level: 10, ContentType: "application/json", Body: []byte(`{"a":1}`)}
+	b := domain.CachedResponse{Level: 0, ContentType: "application/json", Body: []byte(`{"b":1}`)}
+	c := domain.CachedResponse{Level: 11, ContentType: "application/json", Body: []byte(`{"c":1}`)}
+
+	assert.NoError(t, store.Set(ctx, "a", a))
+	assert.NoError(t, store.Set(ctx, "b", b))
+
+	res, found, err := store.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, a, res)
+
+	// a was used last, b is evicted.
+	assert.NoError(t, store.Set(ctx, "c", c))
+	_, found, _ = store.Get(ctx, "b")
+	assert.False(t, found)
+	assert.Equal(t, 2, store.Len())
+
+	// The responses of the older heads are dropped, the final ones are kept.
+	store = NewMemoryStore()
+	for key, res := range map[string]domain.CachedResponse{"a": a, "b": b, "c": c} {
+		assert.NoError(t, store.Set(ctx, key, res))
+	}
+	assert.NoError(t, store.Invalidate(ctx, 11))
+	assert.Equal(t, 2, store.Len())
+	_, found, _ = store.Get(ctx, "a")
+	assert.False(t, found)
+	_, found, _ = store.Get(ctx, "b")
+	assert.True(t, found)
+	_, found, _ = store.Get(ctx, "c")
+	assert.True(t, found)
+}
@@ -0,0 +1,78 @@
+package cycle
+
+import (
+	"cmp"
+	"delegator/internal/models"
+	"slices"
+	"sync"
+)
+
+// era is a range of levels sharing the same cycle length, from the first level
+// of a cycle until the next era.
+type era struct {
+	firstLevel     int64
+	firstCycle     int64
+	blocksPerCycle int64
+}
+
+// Mapper maps levels to cycles from the protocol constants, it is safe for
+// concurrent use.
+type Mapper struct {
+	mu   sync.RWMutex
+	eras []era
+}
+
+// Load replaces the known protocols. Protocols without a cycle length are ignored,
+// and when several protocols start their first cycle at the same level the one
+// with the highest code wins.
+func (m *Mapper) Load(protocols []models.Protocol) {
+	sorted := slices.Clone(protocols)
+	slices.SortFunc(sorted, func(a, b models.Protocol) int {
+		return cmp.Or(cmp.Compare(a.FirstCycleLevel, b.FirstCycleLevel), cmp.Compare(a.Code, b.Code))
+	})
+
+	eras := make([]era, 0, len(sorted))
+	for _, protocol := range sorted {
+		if protocol.BlocksPerCycle <= 0 {
+			continue
+		}
+
+		current := era{
+			firstLevel:     protocol.FirstCycleLevel,
+			firstCycle:     protocol.FirstCycle,
+			blocksPerCycle: protocol.BlocksPerCycle,
+		}
+		if n := len(eras); n > 0 && eras[n-1].firstLevel == current.firstLevel {
+			eras[n-1] = current
+			continue
+		}
+		eras = append(eras, current)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.eras = eras
+}
+
+// CycleOf returns the cycle of level, it reports false when level is before the
+// first known cycle.
+func (m *Mapper) CycleOf(level int64) (int64, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	// Index of the first era starting after level, level belongs to the one before.
+	i, _ := slices.BinarySearchFunc(m.eras, level+1, func(e era, target int64) int {
+		return cmp.Compare(e.firstLevel, target)
+	})
+	if i == 0 {
+		return 0, false
+	}
+
+	current := m.eras[i-1]
+	return current.firstCycle + (level-current.firstLevel)/current.blocksPerCycle, true
+}
+
+// NewMapper create a mapper that knows no protocol until loaded.
+func NewMapper() *Mapper {
+	return &Mapper{}
+}
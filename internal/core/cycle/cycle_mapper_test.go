@@ -0,0 +1,108 @@
+package cycle
+
+import (
+	"delegator/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapper_CycleOf(t *testing.T) {
+	t.Parallel()
+
+	protocols := []models.Protocol{
+		// Shortened cycles from level 1001, listed out of order on purpose.
+		{Code: 2, FirstCycle: 10, FirstCycleLevel: 1001, BlocksPerCycle: 50},
+		{Code: 1, FirstCycle: 0, FirstCycleLevel: 1, BlocksPerCycle: 100},
+		// Genesis has no cycle length and is ignored.
+		{Code: 0, FirstCycle: 0, FirstCycleLevel: 0, BlocksPerCycle: 0},
+	}
+
+	tests := []struct {
+		name          string
+		level         int64
+		expectedCycle int64
+		expectedOK    bool
+	}{
+		{
+			name:       "Before_First_Cycle",
+			level:      0,
+			expectedOK: false,
+		},
+		{
+			name:          "First_Level",
+			level:         1,
+			expectedCycle: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "Last_Level_Of_Cycle",
+			level:         100,
+			expectedCycle: 0,
+			expectedOK:    true,
+		},
+		{
+			name:          "First_Level_Of_Next_Cycle",
+			level:         101,
+			expectedCycle: 1,
+			expectedOK:    true,
+		},
+		{
+			name:          "Last_Level_Before_Protocol_Change",
+			level:         1000,
+			expectedCycle: 9,
+			expectedOK:    true,
+		},
+		{
+			name:          "First_Level_After_Protocol_Change",
+			level:         1001,
+			expectedCycle: 10,
+			expectedOK:    true,
+		},
+		{
+			name:          "After_Protocol_Change",
+			level:         1151,
+			expectedCycle: 13,
+			expectedOK:    true,
+		},
+	}
+
+	mapper := NewMapper()
+	mapper.Load(protocols)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cycle, ok := mapper.CycleOf(tt.level)
+
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedCycle, cycle)
+		})
+	}
+}
+
+func TestMapper_Load(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Empty_Mapper", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := NewMapper().CycleOf(1000)
+		assert.False(t, ok)
+	})
+
+	t.Run("Highest_Code_Wins_On_Same_Level", func(t *testing.T) {
+		t.Parallel()
+
+		mapper := NewMapper()
+		mapper.Load([]models.Protocol{
+			{Code: 4, FirstCycle: 5, FirstCycleLevel: 1, BlocksPerCycle: 20},
+			{Code: 3, FirstCycle: 0, FirstCycleLevel: 1, BlocksPerCycle: 10},
+		})
+
+		cycle, ok := mapper.CycleOf(21)
+		assert.True(t, ok)
+		assert.Equal(t, int64(6), cycle)
+	})
+}
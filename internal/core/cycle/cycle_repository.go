@@ -0,0 +1,161 @@
+package cycle
+
+import (
+	"context"
+	"database/sql"
+	"delegator/internal/models"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+// SaveProtocols upserts the protocols.
+func (r *Repository) SaveProtocols(ctx context.Context, protocols []models.Protocol) error {
+	if len(protocols) == 0 {
+		return nil
+	}
+
+	err := r.dbClient.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"hash", "first_level", "last_level", "first_cycle", "first_cycle_level", "blocks_per_cycle", "updated_at",
+			}),
+		}).
+		Create(&protocols).Error
+	if err != nil {
+		r.logger.Warn("error saving protocols", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) FindProtocols(ctx context.Context) ([]models.Protocol, error) {
+	var res []models.Protocol
+	if err := r.dbClient.WithContext(ctx).Order("code").Find(&res).Error; err != nil {
+		r.logger.Warn("error finding protocols", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// assignCyclesQuery maps the level of every delegation to its cycle with the same
+// rules as Mapper: eras start at the first cycle level of a protocol and last
+// until the next one.
+const assignCyclesQuery = `
+WITH eras AS (
+	SELECT first_cycle, first_cycle_level, blocks_per_cycle,
+		LEAD(first_cycle_level) OVER (ORDER BY first_cycle_level) AS next_cycle_level
+	FROM (
+		SELECT DISTINCT ON (first_cycle_level) first_cycle, first_cycle_level, blocks_per_cycle
+		FROM protocols
+		WHERE blocks_per_cycle > 0
+		ORDER BY first_cycle_level, code DESC
+	) p
+)
+UPDATE delegations d
+SET cycle = e.first_cycle + (d.level - e.first_cycle_level) / e.blocks_per_cycle
+FROM eras e
+WHERE d.level >= e.first_cycle_level
+	AND (e.next_cycle_level IS NULL OR d.level < e.next_cycle_level)
+	AND d.cycle IS DISTINCT FROM e.first_cycle + (d.level - e.first_cycle_level) / e.blocks_per_cycle`
+
+func (r *Repository) AssignCycles(ctx context.Context) (int64, error) {
+	res := r.dbClient.WithContext(ctx).Exec(assignCyclesQuery)
+	if res.Error != nil {
+		r.logger.Warn("error assigning cycles", "error", res.Error)
+		return 0, res.Error
+	}
+	return res.RowsAffected, nil
+}
+
+// cycleBakersQuery aggregates, for every baker involved in a cycle, the delegations
+// it received and lost during the cycle and the delegators delegating to it at the
+// end of the cycle. Self-delegations register the baker and are not counted as
+// delegations.
+const cycleBakersQuery = `
+WITH cycle_delegations AS (
+	SELECT delegator, baker_id, previous_baker, is_new_delegation, is_self_delegation
+	FROM delegations
+	WHERE cycle = @cycle
+),
+received AS (
+	SELECT baker_id AS baker, COUNT(*) AS delegations_received, COUNT(*) FILTER (WHERE is_new_delegation) AS new_delegators
+	FROM cycle_delegations
+	WHERE NOT is_self_delegation
+	GROUP BY baker_id
+),
+departed AS (
+	SELECT previous_baker AS baker, COUNT(*) AS departures
+	FROM cycle_delegations
+	WHERE previous_baker IS NOT NULL AND previous_baker <> baker_id AND previous_baker <> delegator
+	GROUP BY previous_baker
+),
+delegated AS (
+	SELECT baker_id AS baker, COUNT(*) AS delegators, SUM(amount) AS delegated_amount
+	FROM (
+		SELECT DISTINCT ON (delegator) delegator, baker_id, amount, is_self_delegation
+		FROM delegations
+		WHERE cycle <= @cycle
+		ORDER BY delegator, level DESC, id DESC
+	) c
+	WHERE NOT c.is_self_delegation
+	GROUP BY baker_id
+)
+SELECT
+	@cycle AS cycle,
+	b.address,
+	b.alias,
+	COALESCE(r.delegations_received, 0) AS delegations_received,
+	COALESCE(r.new_delegators, 0) AS new_delegators,
+	COALESCE(x.departures, 0) AS departures,
+	COALESCE(g.delegators, 0) AS delegators,
+	COALESCE(g.delegated_amount, 0) AS delegated_amount
+FROM bakers b
+LEFT JOIN received r ON r.baker = b.address
+LEFT JOIN departed x ON x.baker = b.address
+LEFT JOIN delegated g ON g.baker = b.address
+WHERE b.address <> 'UNDELEGATED' AND (r.baker IS NOT NULL OR x.baker IS NOT NULL OR g.baker IS NOT NULL)
+ORDER BY delegated_amount DESC, b.address`
+
+func (r *Repository) FindBakers(ctx context.Context, cycle int64) ([]models.CycleBakerStats, error) {
+	var res []models.CycleBakerStats
+	err := r.dbClient.WithContext(ctx).
+		Raw(cycleBakersQuery, sql.Named("cycle", cycle)).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding cycle bakers", "error", err, "cycle", cycle)
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
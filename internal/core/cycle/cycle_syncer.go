@@ -0,0 +1,82 @@
+package cycle
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultSyncInterval is the delay between two protocol syncs when none is configured.
+// Protocols only change on amendments, a few times a year.
+const DefaultSyncInterval = time.Hour
+
+// Syncer periodically syncs the protocols and the cycle of the delegations.
+type Syncer struct {
+	logger *slog.Logger
+
+	useCase  domain.CycleUseCase
+	interval time.Duration
+}
+
+type SyncerOptions func(*Syncer)
+
+func SyncerWithLogger(logger *slog.Logger) SyncerOptions {
+	return func(s *Syncer) {
+		s.logger = logger
+	}
+}
+
+func SyncerWithUseCase(useCase domain.CycleUseCase) SyncerOptions {
+	return func(s *Syncer) {
+		s.useCase = useCase
+	}
+}
+
+// SyncerWithInterval sets the delay between two syncs.
+func SyncerWithInterval(interval time.Duration) SyncerOptions {
+	return func(s *Syncer) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+func (s *Syncer) Run(ctx context.Context) error {
+	s.logger.Info("starting cycle syncer", "interval", s.interval)
+
+	if err := s.useCase.Sync(ctx); err != nil {
+		s.logger.Warn("initial cycle sync failed", "error", err)
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("cycle syncer stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := s.useCase.Sync(ctx); err != nil {
+				s.logger.Warn("cycle sync failed", "error", err)
+			}
+		}
+	}
+}
+
+func (s *Syncer) Shutdown(ctx context.Context) error {
+	s.logger.Info("shutting down cycle syncer")
+	return nil
+}
+
+func NewSyncer(opts ...SyncerOptions) *Syncer {
+	s := &Syncer{
+		interval: DefaultSyncInterval,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
@@ -0,0 +1,133 @@
+package cycle
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+)
+
+// UseCaseImpl represent the use case implementation of the cycles.
+type UseCaseImpl struct {
+	logger          *slog.Logger
+	repository      domain.CycleRepository
+	protocolService domain.ProtocolService
+	mapper          *Mapper
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.CycleRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// UseCaseWithProtocolService inject the service protocols are fetched from.
+func UseCaseWithProtocolService(protocolService domain.ProtocolService) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.protocolService = protocolService
+	}
+}
+
+// UseCaseWithMapper inject the mapper loaded with the protocols on every sync.
+func UseCaseWithMapper(mapper *Mapper) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.mapper = mapper
+	}
+}
+
+// Sync refreshes the protocols from TzKT and stores them. When TzKT is unavailable
+// the stored protocols are used instead, so cycles are known right after a restart.
+func (uc *UseCaseImpl) Sync(ctx context.Context) error {
+	protocols, err := uc.fetchProtocols(ctx)
+	if err != nil {
+		uc.logger.Warn("failed to fetch protocols, using the stored ones", "error", err)
+		protocols, err = uc.repository.FindProtocols(ctx)
+		if err != nil {
+			return err
+		}
+	} else if err := uc.repository.SaveProtocols(ctx, protocols); err != nil {
+		return err
+	}
+
+	uc.mapper.Load(protocols)
+
+	assigned, err := uc.repository.AssignCycles(ctx)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info("synced cycles", "protocols", len(protocols), "assigned", assigned)
+	return nil
+}
+
+func (uc *UseCaseImpl) fetchProtocols(ctx context.Context) ([]models.Protocol, error) {
+	response, err := uc.protocolService.GetProtocols(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	protocols := make([]models.Protocol, len(response))
+	for i, protocol := range response {
+		protocols[i] = models.Protocol{
+			Code:            protocol.Code,
+			Hash:            protocol.Hash,
+			FirstLevel:      protocol.FirstLevel,
+			LastLevel:       protocol.LastLevel,
+			FirstCycle:      protocol.FirstCycle,
+			FirstCycleLevel: protocol.FirstCycleLevel,
+			BlocksPerCycle:  protocol.Constants.BlocksPerCycle,
+		}
+	}
+	return protocols, nil
+}
+
+// GetBakers return the bakers involved in cycle with their delegation activity.
+func (uc *UseCaseImpl) GetBakers(ctx context.Context, cycle int64, opts domain.ResponseOptions) (domain.ApiResponse[domain.CycleBakerResponseType], error) {
+	bakers, err := uc.repository.FindBakers(ctx, cycle)
+	if err != nil {
+		return domain.ApiResponse[domain.CycleBakerResponseType]{}, err
+	}
+
+	unit := opts.Unit()
+	res := make([]domain.CycleBakerResponseType, len(bakers))
+	for i, baker := range bakers {
+		res[i] = domain.CycleBakerResponseType{
+			Cycle:               baker.Cycle,
+			Address:             domain.Address(baker.Address),
+			Alias:               baker.Alias,
+			DelegationsReceived: baker.DelegationsReceived,
+			NewDelegators:       baker.NewDelegators,
+			Departures:          baker.Departures,
+			Delegators:          baker.Delegators,
+			DelegatedAmount:     domain.NewAmount(baker.DelegatedAmount, unit),
+		}
+	}
+
+	return domain.ApiResponse[domain.CycleBakerResponseType]{
+		Data:  res,
+		Units: unit,
+	}, nil
+}
+
+// NewUseCase create a new use case for the cycles.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{
+		mapper: NewMapper(),
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
@@ -0,0 +1,173 @@
+package cycle
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUseCaseImpl_Sync(t *testing.T) {
+	t.Parallel()
+
+	lastLevel := int64(1000)
+	fetched := []domain.TzktApiProtocolResponse{
+		{
+			Code: 1, Hash: "PtFirst", FirstLevel: 1, FirstCycle: 0, FirstCycleLevel: 1, LastLevel: &lastLevel,
+			Constants: domain.ProtocolConstants{BlocksPerCycle: 100},
+		},
+		{
+			Code: 2, Hash: "PtSecond", FirstLevel: 1001, FirstCycle: 10, FirstCycleLevel: 1001,
+			Constants: domain.ProtocolConstants{BlocksPerCycle: 50},
+		},
+	}
+	protocols := []models.Protocol{
+		{Code: 1, Hash: "PtFirst", FirstLevel: 1, LastLevel: &lastLevel, FirstCycle: 0, FirstCycleLevel: 1, BlocksPerCycle: 100},
+		{Code: 2, Hash: "PtSecond", FirstLevel: 1001, FirstCycle: 10, FirstCycleLevel: 1001, BlocksPerCycle: 50},
+	}
+
+	tests := []struct {
+		name          string
+		setupMocks    func(*mocks.MockCycleRepository, *mocks.MockProtocolService)
+		wantErr       bool
+		expectedCycle int64
+		expectedOK    bool
+	}{
+		{
+			name: "Fetched_Protocols",
+			setupMocks: func(repo *mocks.MockCycleRepository, service *mocks.MockProtocolService) {
+				service.EXPECT().GetProtocols(mock.Anything).Return(fetched, nil).Once()
+				repo.EXPECT().SaveProtocols(mock.Anything, protocols).Return(nil).Once()
+				repo.EXPECT().AssignCycles(mock.Anything).Return(int64(3), nil).Once()
+			},
+			expectedCycle: 13,
+			expectedOK:    true,
+		},
+		{
+			name: "Falls_Back_To_Stored_Protocols",
+			setupMocks: func(repo *mocks.MockCycleRepository, service *mocks.MockProtocolService) {
+				service.EXPECT().GetProtocols(mock.Anything).Return(nil, errors.New("API returned status 503")).Once()
+				repo.EXPECT().FindProtocols(mock.Anything).Return(protocols, nil).Once()
+				repo.EXPECT().AssignCycles(mock.Anything).Return(int64(0), nil).Once()
+			},
+			expectedCycle: 13,
+			expectedOK:    true,
+		},
+		{
+			name: "Stored_Protocols_Error",
+			setupMocks: func(repo *mocks.MockCycleRepository, service *mocks.MockProtocolService) {
+				service.EXPECT().GetProtocols(mock.Anything).Return(nil, errors.New("API returned status 503")).Once()
+				repo.EXPECT().FindProtocols(mock.Anything).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name: "Save_Error",
+			setupMocks: func(repo *mocks.MockCycleRepository, service *mocks.MockProtocolService) {
+				service.EXPECT().GetProtocols(mock.Anything).Return(fetched, nil).Once()
+				repo.EXPECT().SaveProtocols(mock.Anything, protocols).Return(errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCycleRepository(t)
+			mockService := mocks.NewMockProtocolService(t)
+			tt.setupMocks(mockRepo, mockService)
+
+			mapper := NewMapper()
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithProtocolService(mockService),
+				UseCaseWithMapper(mapper),
+			)
+
+			err := uc.Sync(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			cycle, ok := mapper.CycleOf(1151)
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedCycle, cycle)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetBakers(t *testing.T) {
+	t.Parallel()
+
+	alias := "Baker"
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockCycleRepository)
+		expectedResult domain.ApiResponse[domain.CycleBakerResponseType]
+		wantErr        bool
+	}{
+		{
+			name: "Bakers",
+			setupMocks: func(repo *mocks.MockCycleRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, int64(750)).Return([]models.CycleBakerStats{
+					{
+						Cycle: 750, Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj", Alias: &alias,
+						DelegationsReceived: 4, NewDelegators: 3, Departures: 1, Delegators: 12, DelegatedAmount: 1500000,
+					},
+				}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.CycleBakerResponseType]{
+				Data: []domain.CycleBakerResponseType{
+					{
+						Cycle: 750, Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj", Alias: &alias,
+						DelegationsReceived: 4, NewDelegators: 3, Departures: 1, Delegators: 12,
+						DelegatedAmount: domain.NewAmount(1500000, domain.UnitTez),
+					},
+				},
+				Units: domain.UnitTez,
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockCycleRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, int64(750)).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockCycleRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			res, err := uc.GetBakers(context.Background(), 750, domain.ResponseOptions{Units: domain.UnitTez})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
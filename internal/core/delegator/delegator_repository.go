@@ -2,9 +2,13 @@ package delegator
 
 import (
 	"context"
+	"database/sql"
 	"delegator/internal/models"
 	"delegator/pkg/domain"
+	"encoding/json"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -14,6 +18,7 @@ type Repository struct {
 	logger *slog.Logger
 
 	dbClient *gorm.DB // TODO: implement driver on domain.
+	outbox   bool
 }
 
 type RepositoryOptions func(*Repository)
@@ -30,34 +35,445 @@ func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
 	}
 }
 
-func (r *Repository) Create(ctx context.Context, delegationToCreate []domain.CreateDelegationDTO) error {
-	r.logger.Info("create delegator", slog.Int("count", len(delegationToCreate)))
-	for _, delegation := range delegationToCreate {
-		err := r.createBaker(ctx, delegation.Baker)
-		if err != nil {
+// RepositoryWithOutbox records an outbox event with every stored delegation, for
+// the relay to publish.
+func RepositoryWithOutbox(enabled bool) RepositoryOptions {
+	return func(r *Repository) {
+		r.outbox = enabled
+	}
+}
+
+// Create stores the delegations, the failed ones and the webhook deliveries of a
+// batch in a single transaction, along with their outbox events when the outbox
+// is enabled.
+func (r *Repository) Create(ctx context.Context, batch domain.DelegationBatch) error {
+	delegationToCreate := batch.Delegations
+	r.logger.Info("create delegator", slog.Int("count", len(delegationToCreate)), slog.Int("failed", len(batch.Failed)))
+	return r.dbClient.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txRepository := r.withDB(tx)
+		if err := txRepository.createFailed(ctx, batch.Failed); err != nil {
 			return err
 		}
-		err = r.createDelegation(ctx, delegation.Delegation)
+		for _, delegation := range delegationToCreate {
+			err := txRepository.createBaker(ctx, delegation.Baker)
+			if err != nil {
+				return err
+			}
+			err = txRepository.createDelegation(ctx, delegation.Delegation, delegation.Quote)
+			if err != nil {
+				return err
+			}
+			if delegation.Delegation.IsSelfDelegation {
+				err = txRepository.registerBaker(ctx, delegation.Delegation)
+				if err != nil {
+					return err
+				}
+			}
+			if delegation.DeactivatedBaker != nil {
+				err = txRepository.deactivateBaker(ctx, *delegation.DeactivatedBaker, delegation.Delegation)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if err := txRepository.createWebhookDeliveries(ctx, batch.WebhookDeliveries); err != nil {
+			return err
+		}
+
+		if !r.outbox {
+			return nil
+		}
+		return txRepository.createOutboxEvents(ctx, delegationToCreate)
+	})
+}
+
+// createWebhookDeliveries stores pending webhook deliveries, an operation already
+// delivered to a webhook is left untouched.
+func (r *Repository) createWebhookDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	err := r.dbClient.WithContext(ctx).
+		Omit("Webhook").
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "webhook_id"}, {Name: "operation_id"}},
+			DoNothing: true,
+		}).
+		Create(&deliveries).Error
+	if err != nil {
+		r.logger.Warn("error creating webhook deliveries", "error", err, "count", len(deliveries))
+		return err
+	}
+	return nil
+}
+
+// createOutboxEvents records an event for every delegation, self-delegations
+// register a baker and are not delegation events.
+func (r *Repository) createOutboxEvents(ctx context.Context, delegationToCreate []domain.CreateDelegationDTO) error {
+	createdAt := time.Now().UTC()
+	var outboxEvents []models.OutboxEvent
+	for _, delegation := range delegationToCreate {
+		if delegation.Delegation.IsSelfDelegation {
+			continue
+		}
+
+		event := domain.NewDelegationEvent(delegation.Delegation)
+		payload, err := json.Marshal(domain.DelegationMessage{
+			Event:      domain.EventName(event.Kind),
+			CreatedAt:  createdAt,
+			Delegation: event,
+		})
 		if err != nil {
 			return err
 		}
+
+		outboxEvents = append(outboxEvents, models.OutboxEvent{
+			Event:     domain.EventName(event.Kind),
+			Key:       delegation.Delegation.Delegator,
+			Payload:   string(payload),
+			CreatedAt: createdAt,
+		})
+	}
+
+	if len(outboxEvents) == 0 {
+		return nil
+	}
+
+	if err := r.dbClient.WithContext(ctx).Create(&outboxEvents).Error; err != nil {
+		r.logger.Warn("error while creating outbox events", "error", err, "count", len(outboxEvents))
+		return err
 	}
 	return nil
 }
 
-func (r *Repository) FindAll(ctx context.Context) ([]models.Delegation, error) {
+// withDB returns a copy of the repository running its queries on db, e.g. a transaction.
+func (r *Repository) withDB(db *gorm.DB) *Repository {
+	return &Repository{
+		logger:   r.logger,
+		dbClient: db,
+		outbox:   r.outbox,
+	}
+}
+
+func (r *Repository) FindAll(ctx context.Context, filter domain.DelegationFilter) ([]models.Delegation, error) {
 	r.logger.Info("delegator repository FindAll")
-	res, err := gorm.G[models.Delegation](r.dbClient).Find(ctx)
+	var res []models.Delegation
+	err := applyDelegationFilter(r.dbClient.WithContext(ctx), filter).
+		Joins("Quote").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// createFailed stores delegations that did not take effect, operations already
+// stored are left untouched.
+func (r *Repository) createFailed(ctx context.Context, delegations []models.FailedDelegation) error {
+	r.logger.Info("create failed delegations", slog.Int("count", len(delegations)))
+	if len(delegations) == 0 {
+		return nil
+	}
+
+	err := r.dbClient.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "operation_id"}},
+			DoNothing: true,
+		}).
+		Create(&delegations).Error
+	if err != nil {
+		r.logger.Warn("error while creating failed delegations", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) FindFailed(ctx context.Context, filter domain.DelegationFilter) ([]models.FailedDelegation, error) {
+	r.logger.Info("delegator repository FindFailed")
+	var res []models.FailedDelegation
+	err := applyFailedDelegationFilter(r.dbClient.WithContext(ctx), filter).
+		Order("level DESC").
+		Find(&res).Error
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StreamAll calls fn with every delegation matching the filter, oldest first. Rows are
+// scanned one at a time from the database cursor, so the result is never held in memory.
+// Streaming stops at the first error returned by fn.
+func (r *Repository) StreamAll(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error) error {
+	r.logger.Info("delegator repository StreamAll")
+	query := applyDelegationFilter(r.dbClient.WithContext(ctx), filter).
+		Joins("Quote").
+		Order("delegations.level ASC, delegations.operation_id ASC")
+
+	return streamRows(query, fn)
+}
+
+// StreamFailed calls fn with every failed delegation matching the filter, oldest first,
+// scanned one at a time like StreamAll.
+func (r *Repository) StreamFailed(ctx context.Context, filter domain.DelegationFilter, fn func(models.FailedDelegation) error) error {
+	r.logger.Info("delegator repository StreamFailed")
+	query := applyFailedDelegationFilter(r.dbClient.WithContext(ctx), filter).
+		Order("level ASC, operation_id ASC")
+
+	return streamRows(query, fn)
+}
+
+// streamRows scans the rows of query one at a time into T and calls fn with each of them.
+func streamRows[T any](query *gorm.DB, fn func(T) error) error {
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var row T
+		if err := query.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// bakerStatsQuery aggregates every baker with the delegators currently delegating to it,
+// i.e. whose latest delegation points to the baker, and with its stakers, i.e. the
+// accounts whose stake operations exceed their unstake operations. Self-delegations
+// register the baker and are not counted as delegations.
+const bakerStatsQuery = `
+WITH current_delegations AS (
+	SELECT DISTINCT ON (delegator) delegator, baker_id, amount, is_self_delegation
+	FROM delegations
+	ORDER BY delegator, level DESC, id DESC
+),
+current_stakes AS (
+	SELECT baker, COUNT(*) AS stakers, SUM(staked) AS staked_amount
+	FROM (
+		SELECT staker, baker, SUM(CASE WHEN action = 'stake' THEN amount WHEN action = 'unstake' THEN -amount ELSE 0 END) AS staked
+		FROM staking_operations
+		GROUP BY staker, baker
+	) s
+	WHERE staked > 0
+	GROUP BY baker
+)
+SELECT
+	b.address,
+	b.alias,
+	b.first_seen,
+	b.last_seen,
+	b.active,
+	b.registration_level,
+	b.registration_timestamp,
+	b.deactivation_level,
+	b.deactivation_timestamp,
+	(SELECT COUNT(*) FROM delegations d WHERE d.baker_id = b.address AND NOT d.is_self_delegation) AS total_delegations_received,
+	COUNT(c.delegator) AS delegators,
+	COALESCE(SUM(c.amount), 0) AS delegated_amount,
+	COALESCE(MAX(s.stakers), 0) AS stakers,
+	COALESCE(MAX(s.staked_amount), 0) AS staked_amount
+FROM bakers b
+LEFT JOIN current_delegations c ON c.baker_id = b.address AND NOT c.is_self_delegation
+LEFT JOIN current_stakes s ON s.baker = b.address
+WHERE b.address <> 'UNDELEGATED' AND (@address = '' OR b.address = @address)
+GROUP BY b.address, b.alias, b.first_seen, b.last_seen, b.active,
+	b.registration_level, b.registration_timestamp, b.deactivation_level, b.deactivation_timestamp
+ORDER BY delegated_amount DESC, b.address`
+
+func (r *Repository) FindBakers(ctx context.Context, address *domain.Address) ([]models.BakerStats, error) {
+	r.logger.Info("delegator repository FindBakers")
+	filter := ""
+	if address != nil {
+		filter = address.String()
+	}
+
+	var res []models.BakerStats
+	err := r.dbClient.WithContext(ctx).
+		Raw(bakerStatsQuery, sql.Named("address", filter)).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding bakers", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) FindBakersByAddress(ctx context.Context, addresses []domain.Address) ([]models.BakerStats, error) {
+	var res []models.BakerStats
+	err := r.dbClient.WithContext(ctx).
+		Raw("SELECT * FROM ("+bakerStatsQuery+") AS stats WHERE stats.address IN @addresses",
+			sql.Named("address", ""),
+			sql.Named("addresses", addressStrings(addresses)),
+		).
+		Scan(&res).Error
 	if err != nil {
+		r.logger.Warn("error finding bakers by address", "error", err, "count", len(addresses))
 		return nil, err
 	}
 	return res, nil
 }
 
+func (r *Repository) FindPage(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.Delegation, error) {
+	query := applyDelegationFilter(r.dbClient.WithContext(ctx), filter)
+	if after != nil {
+		query = query.Where("(delegations.level, delegations.id) < (?, ?)", after.Level, after.ID)
+	}
+
+	var res []models.Delegation
+	err := query.
+		Joins("Quote").
+		Order("delegations.level DESC, delegations.id DESC").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding delegations page", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindFailedPage pages the delegations that did not take effect like FindPage
+// pages the applied ones.
+func (r *Repository) FindFailedPage(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.FailedDelegation, error) {
+	query := applyFailedDelegationFilter(r.dbClient.WithContext(ctx), filter)
+	if after != nil {
+		query = query.Where("(level, id) < (?, ?)", after.Level, after.ID)
+	}
+
+	var res []models.FailedDelegation
+	err := query.
+		Order("level DESC, id DESC").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding failed delegations page", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// delegationGroupColumns are the columns the delegations are paged by.
+var delegationGroupColumns = map[domain.DelegationGroup]string{
+	domain.GroupByDelegator: "delegator",
+	domain.GroupByBaker:     "baker_id",
+}
+
+// FindPages numbers the delegations of each address newest first and keeps the
+// first limit ones, so that the pages of a batch of addresses take one query.
+func (r *Repository) FindPages(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, after *domain.DelegationCursor, limit int) ([]models.Delegation, error) {
+	column, ok := delegationGroupColumns[group]
+	if !ok {
+		return nil, fmt.Errorf("unknown delegation group %q", group)
+	}
+
+	numbered := r.dbClient.
+		Model(&models.Delegation{}).
+		Select("delegations.*, ROW_NUMBER() OVER (PARTITION BY delegations."+column+" ORDER BY delegations.level DESC, delegations.id DESC) AS page_row").
+		Where("delegations."+column+" IN ?", addressStrings(addresses))
+	if after != nil {
+		numbered = numbered.Where("(delegations.level, delegations.id) < (?, ?)", after.Level, after.ID)
+	}
+
+	var res []models.Delegation
+	err := r.dbClient.WithContext(ctx).
+		Table("(?) AS delegations", numbered).
+		Where("page_row <= ?", limit).
+		Order("delegations." + column + ", delegations.level DESC, delegations.id DESC").
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding delegations pages", "error", err, "group", group, "count", len(addresses))
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) FindLatest(ctx context.Context, delegators []domain.Address) ([]models.Delegation, error) {
+	var res []models.Delegation
+	err := r.dbClient.WithContext(ctx).
+		Raw("SELECT DISTINCT ON (delegator) * FROM delegations WHERE delegator IN ? ORDER BY delegator, level DESC, id DESC",
+			addressStrings(delegators),
+		).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding latest delegations", "error", err, "count", len(delegators))
+		return nil, err
+	}
+	return res, nil
+}
+
+// currentDelegationsQuery selects the latest delegation of the delegators that ever
+// delegated to @baker, keeping the ones still delegating to it. Self-delegations
+// register the baker and are not counted as delegations.
+const currentDelegationsQuery = `
+SELECT * FROM (
+	SELECT DISTINCT ON (delegator) *
+	FROM delegations
+	WHERE delegator IN (SELECT delegator FROM delegations WHERE baker_id = @baker)
+	ORDER BY delegator, level DESC, id DESC
+) AS current
+WHERE current.baker_id = @baker AND NOT current.is_self_delegation AND current.delegator > @after
+ORDER BY current.delegator
+LIMIT @limit`
+
+func (r *Repository) FindCurrentDelegations(ctx context.Context, baker domain.Address, after *domain.Address, limit int) ([]models.Delegation, error) {
+	afterDelegator := ""
+	if after != nil {
+		afterDelegator = after.String()
+	}
+
+	var res []models.Delegation
+	err := r.dbClient.WithContext(ctx).
+		Raw(currentDelegationsQuery,
+			sql.Named("baker", baker.String()),
+			sql.Named("after", afterDelegator),
+			sql.Named("limit", limit),
+		).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding current delegations", "error", err, "baker", baker)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIndexHead returns the latest stored delegation, failed ones included like in
+// GetLastProcessedLevel.
+func (r *Repository) GetIndexHead(ctx context.Context) (domain.IndexHead, error) {
+	var head domain.IndexHead
+	err := r.dbClient.WithContext(ctx).
+		Raw("(SELECT level, timestamp FROM delegations ORDER BY level DESC LIMIT 1) " +
+			"UNION ALL (SELECT level, timestamp FROM failed_delegations ORDER BY level DESC LIMIT 1) " +
+			"ORDER BY level DESC LIMIT 1").
+		Scan(&head).Error
+	if err != nil {
+		r.logger.Warn("error getting index head", "error", err)
+		return domain.IndexHead{}, err
+	}
+	return head, nil
+}
+
+func addressStrings(addresses []domain.Address) []string {
+	res := make([]string, len(addresses))
+	for i, address := range addresses {
+		res[i] = address.String()
+	}
+	return res
+}
+
 func (r *Repository) GetLastProcessedLevel(ctx context.Context) (int64, error) {
+	// Failed delegations are part of the checkpoint, otherwise a batch holding
+	// only failed operations would be fetched again on every round. Both are
+	// stored in the transaction of Create, neither is ahead of the other.
 	var maxLevel int64
-	err := r.dbClient.Model(&models.Delegation{}).
-		Select("COALESCE(MAX(level), 0)").
+	err := r.dbClient.
+		Raw("SELECT GREATEST((SELECT COALESCE(MAX(level), 0) FROM delegations), (SELECT COALESCE(MAX(level), 0) FROM failed_delegations))").
 		Scan(&maxLevel).Error
 	if err != nil {
 		r.logger.Warn("error getting last processed level", "error", err)
@@ -77,7 +493,10 @@ func (r *Repository) CountDelegations(ctx context.Context) (int64, error) {
 	return count, nil
 }
 
-func (r *Repository) createDelegation(ctx context.Context, delegation models.Delegation) error {
+// createDelegation inserts delegation and its quote, when TzKT returned one. A
+// failed quote insert is returned like a failed delegation insert so that Create
+// rolls back the whole batch instead of storing a delegation without its quote.
+func (r *Repository) createDelegation(ctx context.Context, delegation models.Delegation, quote *models.DelegationQuote) error {
 	r.logger.Info("attempting to create delegation", "delegator", delegation.Delegator, "level", delegation.Level, "hash", delegation.OperationHash)
 	res := gorm.WithResult()
 	err := gorm.G[models.Delegation](r.dbClient, res).Create(ctx, &delegation)
@@ -87,14 +506,29 @@ func (r *Repository) createDelegation(ctx context.Context, delegation models.Del
 	}
 
 	r.logger.Info("successfully created delegation", "id", delegation.ID, "delegator", delegation.Delegator, "level", delegation.Level)
+
+	if quote == nil {
+		return nil
+	}
+
+	quote.DelegationID = delegation.ID
+	err = gorm.G[models.DelegationQuote](r.dbClient).Create(ctx, quote)
+	if err != nil {
+		r.logger.Warn("error while creating delegation quote", "error", err, "id", delegation.ID)
+		return err
+	}
 	return nil
 }
 
 func (r *Repository) createBaker(ctx context.Context, baker models.Baker) error {
 	err := r.dbClient.WithContext(ctx).
 		Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "address"}},
-			DoUpdates: clause.AssignmentColumns([]string{"last_seen"}),
+			Columns: []clause.Column{{Name: "address"}},
+			DoUpdates: clause.Set{
+				{Column: clause.Column{Name: "last_seen"}, Value: clause.Expr{SQL: "EXCLUDED.last_seen"}},
+				// Keep the known alias when TzKT does not return one.
+				{Column: clause.Column{Name: "alias"}, Value: clause.Expr{SQL: "COALESCE(EXCLUDED.alias, bakers.alias)"}},
+			},
 		}).
 		Create(&baker).Error
 
@@ -107,6 +541,80 @@ func (r *Repository) createBaker(ctx context.Context, baker models.Baker) error
 	return nil
 }
 
+// latestBakerEventLevel is the level of the latest registration or deactivation of a
+// baker. Events older than it are ignored, delegations are not always processed
+// in level order.
+const latestBakerEventLevel = "GREATEST(COALESCE(registration_level, 0), COALESCE(deactivation_level, 0))"
+
+// registerBaker marks the baker self-delegating in delegation as active.
+func (r *Repository) registerBaker(ctx context.Context, delegation models.Delegation) error {
+	err := r.dbClient.WithContext(ctx).
+		Model(&models.Baker{}).
+		Where("address = ? AND "+latestBakerEventLevel+" < ?", delegation.BakerID, delegation.Level).
+		Updates(map[string]any{
+			"active":                 true,
+			"registration_level":     delegation.Level,
+			"registration_timestamp": delegation.Timestamp,
+		}).Error
+	if err != nil {
+		r.logger.Warn("error while registering baker", "error", err, "address", delegation.BakerID)
+		return err
+	}
+
+	r.logger.Info("registered baker", "address", delegation.BakerID, "level", delegation.Level)
+	return nil
+}
+
+// deactivateBaker marks address as no longer registered from the level of delegation.
+func (r *Repository) deactivateBaker(ctx context.Context, address string, delegation models.Delegation) error {
+	err := r.dbClient.WithContext(ctx).
+		Model(&models.Baker{}).
+		Where("address = ? AND "+latestBakerEventLevel+" < ?", address, delegation.Level).
+		Updates(map[string]any{
+			"active":                 false,
+			"deactivation_level":     delegation.Level,
+			"deactivation_timestamp": delegation.Timestamp,
+		}).Error
+	if err != nil {
+		r.logger.Warn("error while deactivating baker", "error", err, "address", address)
+		return err
+	}
+
+	r.logger.Info("deactivated baker", "address", address, "level", delegation.Level)
+	return nil
+}
+
+func applyDelegationFilter(db *gorm.DB, filter domain.DelegationFilter) *gorm.DB {
+	query := db.Model(&models.Delegation{})
+	if filter.Delegator != nil {
+		query = query.Where("delegations.delegator = ?", filter.Delegator.String())
+	}
+	if filter.Baker != nil {
+		query = query.Where("delegations.baker_id = ?", filter.Baker.String())
+	}
+	if filter.Cycle != nil {
+		query = query.Where("delegations.cycle = ?", *filter.Cycle)
+	}
+	if filter.AfterOperationID != nil {
+		query = query.Where("delegations.operation_id > ?", *filter.AfterOperationID)
+	}
+	return query
+}
+
+func applyFailedDelegationFilter(db *gorm.DB, filter domain.DelegationFilter) *gorm.DB {
+	query := db.Model(&models.FailedDelegation{})
+	if filter.Delegator != nil {
+		query = query.Where("delegator = ?", filter.Delegator.String())
+	}
+	if filter.Baker != nil {
+		query = query.Where("baker = ?", filter.Baker.String())
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", string(filter.Status))
+	}
+	return query
+}
+
 func NewRepository(opts ...RepositoryOptions) *Repository {
 	r := &Repository{}
 	for _, opt := range opts {
@@ -86,7 +86,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 			},
 			mockSetup: func(repo *mocks.MockRepository) {
 				repo.EXPECT().Create(context.Background(), 
-					[]domain.CreateDelegationDTO{
+					domain.DelegationBatch{Delegations: []domain.CreateDelegationDTO{
 						{
 							Baker: models.Baker{
 								Address:   "tz1baker",
@@ -100,7 +100,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 								Level:     1000,
 							},
 						},
-					}).Return(nil).Once()
+					}}).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
@@ -117,7 +117,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 			},
 			mockSetup: func(repo *mocks.MockRepository) {
 				repo.EXPECT().Create(context.Background(), 
-					[]domain.CreateDelegationDTO{
+					domain.DelegationBatch{Delegations: []domain.CreateDelegationDTO{
 						{
 							Baker: models.Baker{Address: "tz1baker"},
 							Delegation: models.Delegation{
@@ -125,7 +125,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 								BakerID:   "tz1baker",
 							},
 						},
-					}).Return(errors.New("database error")).Once()
+					}}).Return(errors.New("database error")).Once()
 			},
 			expectedError: errors.New("database error"),
 		},
@@ -134,7 +134,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 			delegations: []domain.CreateDelegationDTO{},
 			mockSetup: func(repo *mocks.MockRepository) {
 				repo.EXPECT().Create(context.Background(), 
-					[]domain.CreateDelegationDTO{}).Return(nil).Once()
+					domain.DelegationBatch{Delegations: []domain.CreateDelegationDTO{}}).Return(nil).Once()
 			},
 			expectedError: nil,
 		},
@@ -147,7 +147,7 @@ func TestRepositoryInterface_Create(t *testing.T) {
 			mockRepo := mocks.NewMockRepository(t)
 			tt.mockSetup(mockRepo)
 
-			err := mockRepo.Create(context.Background(), tt.delegations)
+			err := mockRepo.Create(context.Background(), domain.DelegationBatch{Delegations: tt.delegations})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -189,7 +189,7 @@ func TestRepositoryInterface_FindAll(t *testing.T) {
 						Level:     1001,
 					},
 				}
-				repo.EXPECT().FindAll(context.Background()).Return(delegations, nil).Once()
+				repo.EXPECT().FindAll(context.Background(), domain.DelegationFilter{}).Return(delegations, nil).Once()
 			},
 			expectedResult: []models.Delegation{
 				{
@@ -214,7 +214,7 @@ func TestRepositoryInterface_FindAll(t *testing.T) {
 		{
 			name: "Success_Empty_Result",
 			mockSetup: func(repo *mocks.MockRepository) {
-				repo.EXPECT().FindAll(context.Background()).Return([]models.Delegation{}, nil).Once()
+				repo.EXPECT().FindAll(context.Background(), domain.DelegationFilter{}).Return([]models.Delegation{}, nil).Once()
 			},
 			expectedResult: []models.Delegation{},
 			expectedError:  nil,
@@ -222,7 +222,7 @@ func TestRepositoryInterface_FindAll(t *testing.T) {
 		{
 			name: "Error_Database_Failure",
 			mockSetup: func(repo *mocks.MockRepository) {
-				repo.EXPECT().FindAll(context.Background()).Return(nil, errors.New("connection failed")).Once()
+				repo.EXPECT().FindAll(context.Background(), domain.DelegationFilter{}).Return(nil, errors.New("connection failed")).Once()
 			},
 			expectedResult: nil,
 			expectedError:  errors.New("connection failed"),
@@ -236,7 +236,7 @@ func TestRepositoryInterface_FindAll(t *testing.T) {
 			mockRepo := mocks.NewMockRepository(t)
 			tt.mockSetup(mockRepo)
 
-			result, err := mockRepo.FindAll(context.Background())
+			result, err := mockRepo.FindAll(context.Background(), domain.DelegationFilter{})
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -85,8 +85,9 @@ func TestUseCaseImpl_Create(t *testing.T) {
 				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
 				repository: func(t *testing.T) domain.Repository {
 					mockRepo := mocks.NewMockRepository(t)
-					mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
-						return len(dtos) == 1 && dtos[0].Delegation.Delegator == "tz1delegator"
+					mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+						dtos := batch.Delegations
+						return len(dtos) == 1 && dtos[0].Delegation.Delegator == "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"
 					})).Return(nil).Once()
 					return mockRepo
 				},
@@ -102,10 +103,10 @@ func TestUseCaseImpl_Create(t *testing.T) {
 						Hash:      "ophash123",
 						Amount:    100000,
 						Sender: &domain.Account{
-							Address: "tz1delegator",
+							Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 						},
 						NewDelegate: &domain.Account{
-							Address: "tz1baker",
+							Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
 						},
 						PrevDelegate: nil,
 					},
@@ -167,7 +168,8 @@ func TestUseCaseImpl_Create(t *testing.T) {
 				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
 				repository: func(t *testing.T) domain.Repository {
 					mockRepo := mocks.NewMockRepository(t)
-					mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
+					mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+						dtos := batch.Delegations
 						return len(dtos) == 1 && dtos[0].Baker.Address == "UNDELEGATED"
 					})).Return(nil).Once()
 					return mockRepo
@@ -184,10 +186,10 @@ func TestUseCaseImpl_Create(t *testing.T) {
 						Hash:      "ophash123",
 						Amount:    100000,
 						Sender: &domain.Account{
-							Address: "tz1delegator",
+							Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 						},
 						NewDelegate:  nil,
-						PrevDelegate: &domain.Account{Address: "tz1oldbaker"},
+						PrevDelegate: &domain.Account{Address: "tz1e5DK7EaiGMLWRzrBo4ipqHg6ysTtj2fBt"},
 					},
 				},
 			},
@@ -241,13 +243,13 @@ func TestUseCaseImpl_GetDelegations(t *testing.T) {
 					delegations := []models.Delegation{
 						{
 							ID:        uuid.New(),
-							Delegator: "tz1delegator",
+							Delegator: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 							Amount:    100000,
 							Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
 							Level:     1000,
 						},
 					}
-					mockRepo.EXPECT().FindAll(mock.Anything).Return(delegations, nil).Once()
+					mockRepo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(delegations, nil).Once()
 					return mockRepo
 				},
 			},
@@ -258,11 +260,12 @@ func TestUseCaseImpl_GetDelegations(t *testing.T) {
 				Data: []domain.DelegationsResponseType{
 					{
 						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
-						Amount:    100000,
-						Delegator: "tz1delegator",
+						Amount:    domain.NewAmount(100000, domain.UnitMutez),
+						Delegator: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 						Level:     1000,
 					},
 				},
+				Units: domain.UnitMutez,
 			},
 			wantErr: false,
 		},
@@ -272,7 +275,7 @@ func TestUseCaseImpl_GetDelegations(t *testing.T) {
 				logger: slog.New(slog.NewJSONHandler(os.Stdout, nil)),
 				repository: func(t *testing.T) domain.Repository {
 					mockRepo := mocks.NewMockRepository(t)
-					mockRepo.EXPECT().FindAll(mock.Anything).Return(nil, assert.AnError).Once()
+					mockRepo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(nil, assert.AnError).Once()
 					return mockRepo
 				},
 			},
@@ -293,7 +296,7 @@ func TestUseCaseImpl_GetDelegations(t *testing.T) {
 				repository: tt.fields.repository(t),
 			}
 
-			got, err := uc.GetDelegations(tt.args.ctx)
+			got, err := uc.GetDelegations(tt.args.ctx, domain.DelegationFilter{}, domain.ResponseOptions{})
 			if tt.wantErr {
 				assert.Error(t, err)
 				assert.Equal(t, tt.want, got)
@@ -4,14 +4,23 @@ import (
 	"context"
 	"delegator/internal/models"
 	"delegator/pkg/domain"
+	"errors"
 	"log/slog"
 	"time"
 )
 
+// undelegatedBaker is the placeholder baker undelegations are stored against.
+const undelegatedBaker = domain.UndelegatedBaker
+
 // UseCaseImpl represent the use case implementation tf the delegator.
 type UseCaseImpl struct {
-	logger     *slog.Logger
-	repository domain.Repository
+	logger      *slog.Logger
+	repository  domain.Repository
+	indexFailed bool
+	cycleMapper domain.CycleMapper
+	broker      domain.DelegationBroker
+	webhooks    domain.WebhookUseCase
+	head        domain.IndexHeadTracker
 }
 
 // UseCaseOption represent the Option function to load option.
@@ -31,14 +40,61 @@ func UseCaseWithRepository(repository domain.Repository) UseCaseOption {
 	}
 }
 
+// UseCaseWithIndexFailed also stores the failed, backtracked and skipped delegations
+// instead of skipping them.
+func UseCaseWithIndexFailed(indexFailed bool) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.indexFailed = indexFailed
+	}
+}
+
+// UseCaseWithCycleMapper assigns the cycle of the delegations when they are created.
+// Delegations whose level the mapper does not know yet are assigned on the next cycle sync.
+func UseCaseWithCycleMapper(cycleMapper domain.CycleMapper) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.cycleMapper = cycleMapper
+	}
+}
+
+// UseCaseWithBroker publishes the delegations on the broker once they are stored,
+// and lets them be watched.
+func UseCaseWithBroker(broker domain.DelegationBroker) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.broker = broker
+	}
+}
+
+// UseCaseWithWebhooks stores the webhook deliveries of the delegations along with them.
+func UseCaseWithWebhooks(webhooks domain.WebhookUseCase) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.webhooks = webhooks
+	}
+}
+
+// UseCaseWithIndexHead advances the indexed head once the delegations are stored,
+// which invalidates the cached responses.
+func UseCaseWithIndexHead(head domain.IndexHeadTracker) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.head = head
+	}
+}
+
 // Create will create a new delegation.
 func (uc *UseCaseImpl) Create(ctx context.Context, data []domain.TzktApiDelegationsResponse) error {
 	uc.logger.Info("processing API responses", "total", len(data))
 	createDTOs := make([]domain.CreateDelegationDTO, 0, len(data))
+	var failed []models.FailedDelegation
 
 	for i, apiResponse := range data {
 		uc.logger.Info("processing delegation", "index", i, "type", apiResponse.Type, "status", apiResponse.Status, "level", apiResponse.Level)
-		if apiResponse.Type != "delegation" || apiResponse.Status != "applied" {
+		if uc.indexFailed && apiResponse.Type == "delegation" && isFailedStatus(apiResponse.Status) {
+			if delegation, ok := uc.toFailedDelegation(apiResponse); ok {
+				failed = append(failed, delegation)
+			}
+			continue
+		}
+
+		if apiResponse.Type != "delegation" || apiResponse.Status != string(domain.StatusApplied) {
 			uc.logger.Info("skipping delegation", "reason", "wrong type or status", "type", apiResponse.Type, "status", apiResponse.Status)
 			continue
 		}
@@ -59,19 +115,36 @@ func (uc *UseCaseImpl) Create(ctx context.Context, data []domain.TzktApiDelegati
 			continue
 		}
 
+		if _, err := domain.ParseAddress(delegatorAddress); err != nil {
+			uc.logger.Warn("invalid delegator address", "delegator", delegatorAddress, "error", err)
+			continue
+		}
+
 		bakerAddress := ""
 		isUndelegation := apiResponse.NewDelegate == nil
 
 		if !isUndelegation {
 			bakerAddress = apiResponse.NewDelegate.Address
+			if !isValidBakerAddress(bakerAddress) {
+				uc.logger.Warn("invalid baker address", "baker", bakerAddress)
+				continue
+			}
 		} else {
-			bakerAddress = "UNDELEGATED"
+			bakerAddress = undelegatedBaker
 		}
 
+		if apiResponse.PrevDelegate != nil && !isValidBakerAddress(apiResponse.PrevDelegate.Address) {
+			uc.logger.Warn("invalid previous baker address", "baker", apiResponse.PrevDelegate.Address)
+			continue
+		}
+
+		isSelfDelegation := !isUndelegation && bakerAddress == delegatorAddress
+
 		baker := models.Baker{
 			Address:   bakerAddress,
 			FirstSeen: timestamp,
 			LastSeen:  timestamp,
+			Alias:     accountAlias(apiResponse.NewDelegate),
 		}
 
 		delegation := models.Delegation{
@@ -81,53 +154,641 @@ func (uc *UseCaseImpl) Create(ctx context.Context, data []domain.TzktApiDelegati
 			Timestamp:       timestamp,
 			Level:           apiResponse.Level,
 			OperationHash:   &apiResponse.Hash,
-			IsNewDelegation: !isUndelegation && apiResponse.PrevDelegate == nil,
+			IsNewDelegation: !isUndelegation && !isSelfDelegation && apiResponse.PrevDelegate == nil,
 			PreviousBaker:   nil,
 			IndexedAt:       time.Now(),
+
+			IsSelfDelegation:    isSelfDelegation,
+			OperationID:         &apiResponse.ID,
+			Block:               &apiResponse.Block,
+			Counter:             &apiResponse.Counter,
+			GasUsed:             &apiResponse.GasUsed,
+			BakerFee:            &apiResponse.BakerFee,
+			Nonce:               apiResponse.Nonce,
+			StakingUpdatesCount: apiResponse.StakingUpdatesCount,
+			DelegatorAlias:      accountAlias(apiResponse.Sender),
+			BakerAlias:          accountAlias(apiResponse.NewDelegate),
+			PreviousBakerAlias:  accountAlias(apiResponse.PrevDelegate),
+			InitiatorAlias:      accountAlias(apiResponse.Initiator),
 		}
 
 		if apiResponse.PrevDelegate != nil {
 			delegation.PreviousBaker = &apiResponse.PrevDelegate.Address
 		}
 
+		if uc.cycleMapper != nil {
+			if cycle, ok := uc.cycleMapper.CycleOf(apiResponse.Level); ok {
+				delegation.Cycle = &cycle
+			}
+		}
+
+		if apiResponse.Initiator != nil {
+			delegation.Initiator = &apiResponse.Initiator.Address
+		}
+
 		createDTO := domain.CreateDelegationDTO{
 			Baker:      baker,
 			Delegation: delegation,
+			Quote:      toDelegationQuote(apiResponse.Quote),
+		}
+
+		// A baker delegating away from itself is no longer registered.
+		if !isSelfDelegation && apiResponse.PrevDelegate != nil && apiResponse.PrevDelegate.Address == delegatorAddress {
+			createDTO.DeactivatedBaker = &delegatorAddress
 		}
 
 		createDTOs = append(createDTOs, createDTO)
 	}
 
-	if len(createDTOs) == 0 {
+	if len(createDTOs) == 0 && len(failed) == 0 {
 		uc.logger.Info("no valid delegations to create")
 		return nil
 	}
 
-	return uc.repository.Create(ctx, createDTOs)
+	batch := domain.DelegationBatch{Delegations: createDTOs, Failed: failed}
+	if uc.webhooks != nil && len(createDTOs) > 0 {
+		deliveries, err := uc.webhooks.Deliveries(ctx, storedDelegations(createDTOs))
+		if err != nil {
+			return err
+		}
+		batch.WebhookDeliveries = deliveries
+	}
+
+	if err := uc.repository.Create(ctx, batch); err != nil {
+		return err
+	}
+
+	uc.advance(ctx, failed, createDTOs)
+	uc.publish(ctx, createDTOs)
+	return nil
+}
+
+// advance moves the indexed head to the latest delegation that was just stored.
+func (uc *UseCaseImpl) advance(ctx context.Context, failed []models.FailedDelegation, createDTOs []domain.CreateDelegationDTO) {
+	if uc.head == nil {
+		return
+	}
+
+	var head domain.IndexHead
+	for _, delegation := range failed {
+		if delegation.Level > head.Level {
+			head = domain.IndexHead{Level: delegation.Level, Timestamp: delegation.Timestamp}
+		}
+	}
+	for _, createDTO := range createDTOs {
+		if createDTO.Delegation.Level > head.Level {
+			head = domain.IndexHead{Level: createDTO.Delegation.Level, Timestamp: createDTO.Delegation.Timestamp}
+		}
+	}
+
+	if head.Level > 0 {
+		uc.head.Advance(ctx, head)
+	}
 }
 
-// GetDelegations return all delegations.
-func (uc *UseCaseImpl) GetDelegations(ctx context.Context) (domain.ApiResponse[domain.DelegationsResponseType], error) {
-	delegations, err := uc.repository.FindAll(ctx)
+// publish notifies the broker of the delegations that were just stored.
+func (uc *UseCaseImpl) publish(ctx context.Context, createDTOs []domain.CreateDelegationDTO) {
+	if len(createDTOs) == 0 || uc.broker == nil {
+		return
+	}
+	uc.broker.Publish(storedDelegations(createDTOs))
+}
+
+// storedDelegations returns the delegations of createDTOs with their quote.
+func storedDelegations(createDTOs []domain.CreateDelegationDTO) []models.Delegation {
+	delegations := make([]models.Delegation, len(createDTOs))
+	for i, createDTO := range createDTOs {
+		delegations[i] = createDTO.Delegation
+		delegations[i].Quote = createDTO.Quote
+	}
+	return delegations
+}
+
+// toFailedDelegation maps an operation that did not take effect, it reports false
+// when the operation can not be stored.
+func (uc *UseCaseImpl) toFailedDelegation(apiResponse domain.TzktApiDelegationsResponse) (models.FailedDelegation, bool) {
+	timestamp, err := time.Parse("2006-01-02T15:04:05Z", apiResponse.Timestamp)
+	if err != nil {
+		uc.logger.Warn("failed to parse timestamp", "timestamp", apiResponse.Timestamp, "error", err)
+		return models.FailedDelegation{}, false
+	}
+
+	if apiResponse.Sender == nil || apiResponse.Sender.Address == "" {
+		uc.logger.Warn("missing delegator address", "id", apiResponse.ID)
+		return models.FailedDelegation{}, false
+	}
+
+	errs := make([]string, len(apiResponse.Errors))
+	for i, e := range apiResponse.Errors {
+		errs[i] = e.Type
+	}
+
+	delegation := models.FailedDelegation{
+		OperationID:   apiResponse.ID,
+		OperationHash: apiResponse.Hash,
+		Status:        apiResponse.Status,
+		Errors:        errs,
+		Delegator:     apiResponse.Sender.Address,
+		Amount:        apiResponse.Amount,
+		Timestamp:     timestamp,
+		Level:         apiResponse.Level,
+		IndexedAt:     time.Now(),
+	}
+
+	if apiResponse.NewDelegate != nil {
+		delegation.Baker = &apiResponse.NewDelegate.Address
+	}
+	if apiResponse.PrevDelegate != nil {
+		delegation.PreviousBaker = &apiResponse.PrevDelegate.Address
+	}
+
+	return delegation, true
+}
+
+// GetDelegations return the delegations matching the filter.
+func (uc *UseCaseImpl) GetDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.DelegationsResponseType], error) {
+	if !filter.Status.IsApplied() {
+		return uc.getFailedDelegations(ctx, filter, opts)
+	}
+
+	delegations, err := uc.repository.FindAll(ctx, filter)
 	if err != nil {
 		return domain.ApiResponse[domain.DelegationsResponseType]{}, err
 	}
 
 	res := make([]domain.DelegationsResponseType, len(delegations))
 	for i, delegation := range delegations {
-		res[i] = domain.DelegationsResponseType{
-			Timestamp: delegation.Timestamp,
-			Amount:    delegation.Amount,
-			Delegator: delegation.Delegator,
-			Level:     delegation.Level,
-		}
+		res[i] = toDelegationResponse(delegation, opts)
 	}
 
 	return domain.ApiResponse[domain.DelegationsResponseType]{
-		Data: res,
+		Data:  res,
+		Units: opts.Unit(),
+	}, nil
+}
+
+func (uc *UseCaseImpl) getFailedDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.DelegationsResponseType], error) {
+	delegations, err := uc.repository.FindFailed(ctx, filter)
+	if err != nil {
+		return domain.ApiResponse[domain.DelegationsResponseType]{}, err
+	}
+
+	res := make([]domain.DelegationsResponseType, len(delegations))
+	for i, delegation := range delegations {
+		res[i] = toFailedDelegationResponse(delegation, opts)
+	}
+
+	return domain.ApiResponse[domain.DelegationsResponseType]{
+		Data:  res,
+		Units: opts.Unit(),
+	}, nil
+}
+
+// ExportDelegations streams the delegations matching the filter from the repository
+// and calls fn with each of them rendered like GetDelegations does.
+func (uc *UseCaseImpl) ExportDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+	if !filter.Status.IsApplied() {
+		return uc.repository.StreamFailed(ctx, filter, func(delegation models.FailedDelegation) error {
+			return fn(toFailedDelegationResponse(delegation, opts))
+		})
+	}
+
+	return uc.repository.StreamAll(ctx, filter, func(delegation models.Delegation) error {
+		return fn(toDelegationResponse(delegation, opts))
+	})
+}
+
+// WatchDelegations subscribes to the broker before replaying the stored delegations
+// after filter.AfterOperationID, so that none is lost in between, then forwards the
+// published delegations that were not replayed. It returns domain.ErrSubscriptionClosed
+// when the subscriber falls behind, the caller resumes from the last delegation it got.
+func (uc *UseCaseImpl) WatchDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+	if uc.broker == nil {
+		return errors.New("no delegation broker configured")
+	}
+
+	events, unsubscribe := uc.broker.Subscribe(domain.DelegationFilter{
+		Delegator: filter.Delegator,
+		Baker:     filter.Baker,
+		Cycle:     filter.Cycle,
+	})
+	defer unsubscribe()
+
+	opts.Expand = true
+	send := func(delegation models.Delegation) error {
+		if !filter.Matches(delegation) {
+			return nil
+		}
+		filter.AfterOperationID = delegation.OperationID
+		return fn(toDelegationResponse(delegation, opts))
+	}
+
+	if filter.AfterOperationID != nil {
+		if err := uc.repository.StreamAll(ctx, filter, send); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case delegation, ok := <-events:
+			if !ok {
+				return domain.ErrSubscriptionClosed
+			}
+			if err := send(delegation); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toDelegationResponse(delegation models.Delegation, opts domain.ResponseOptions) domain.DelegationsResponseType {
+	res := domain.DelegationsResponseType{
+		Timestamp: delegation.Timestamp,
+		Amount:    domain.NewAmount(delegation.Amount, opts.Unit()),
+		Delegator: domain.Address(delegation.Delegator),
+		Level:     delegation.Level,
+		Cycle:     delegation.Cycle,
+		FiatValue: fiatValue(delegation, opts.Currency),
+	}
+
+	if opts.Expand {
+		res.DelegationMetadata = toDelegationMetadata(delegation, opts.Unit())
+	}
+	return res
+}
+
+func toFailedDelegationResponse(delegation models.FailedDelegation, opts domain.ResponseOptions) domain.DelegationsResponseType {
+	res := domain.DelegationsResponseType{
+		Timestamp: delegation.Timestamp,
+		Amount:    domain.NewAmount(delegation.Amount, opts.Unit()),
+		Delegator: domain.Address(delegation.Delegator),
+		Level:     delegation.Level,
+		Status:    domain.OperationStatus(delegation.Status),
+		Errors:    delegation.Errors,
+	}
+
+	if opts.Expand {
+		res.DelegationMetadata = &domain.DelegationMetadata{
+			OperationID:   &delegation.OperationID,
+			OperationHash: &delegation.OperationHash,
+			Baker:         toAddress(delegation.Baker),
+			PreviousBaker: toAddress(delegation.PreviousBaker),
+		}
+	}
+	return res
+}
+
+// GetBakers return every baker with its current delegators aggregate.
+func (uc *UseCaseImpl) GetBakers(ctx context.Context, opts domain.ResponseOptions) (domain.ApiResponse[domain.BakerResponseType], error) {
+	bakers, err := uc.repository.FindBakers(ctx, nil)
+	if err != nil {
+		return domain.ApiResponse[domain.BakerResponseType]{}, err
+	}
+
+	res := make([]domain.BakerResponseType, len(bakers))
+	for i, baker := range bakers {
+		res[i] = toBakerResponse(baker, opts.Unit())
+	}
+
+	return domain.ApiResponse[domain.BakerResponseType]{
+		Data:  res,
+		Units: opts.Unit(),
 	}, nil
 }
 
+// GetBaker return a single baker, or domain.ErrNotFound when it was never delegated to.
+func (uc *UseCaseImpl) GetBaker(ctx context.Context, address domain.Address, opts domain.ResponseOptions) (domain.BakerResponseType, error) {
+	bakers, err := uc.repository.FindBakers(ctx, &address)
+	if err != nil {
+		return domain.BakerResponseType{}, err
+	}
+
+	if len(bakers) == 0 {
+		return domain.BakerResponseType{}, domain.ErrNotFound
+	}
+
+	return toBakerResponse(bakers[0], opts.Unit()), nil
+}
+
+// GetDelegationsPage returns a page of the delegations matching the filter, newest
+// first. A page is fetched with one more delegation than requested to tell whether
+// another one follows.
+func (uc *UseCaseImpl) GetDelegationsPage(ctx context.Context, filter domain.DelegationFilter, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error) {
+	var after *domain.DelegationCursor
+	if page.After != "" {
+		cursor, err := domain.ParseDelegationCursor(page.After)
+		if err != nil {
+			return domain.PageResponse[domain.DelegationsResponseType]{}, err
+		}
+		after = &cursor
+	}
+
+	if !filter.Status.IsApplied() {
+		return uc.getFailedDelegationsPage(ctx, filter, after, page.Size(), opts)
+	}
+
+	delegations, err := uc.repository.FindPage(ctx, filter, after, page.Size()+1)
+	if err != nil {
+		return domain.PageResponse[domain.DelegationsResponseType]{}, err
+	}
+
+	return toDelegationsPage(delegations, page.Size(), opts, func(delegation models.Delegation) string {
+		return domain.DelegationCursor{Level: delegation.Level, ID: delegation.ID}.String()
+	}), nil
+}
+
+func (uc *UseCaseImpl) getFailedDelegationsPage(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, size int, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error) {
+	delegations, err := uc.repository.FindFailedPage(ctx, filter, after, size+1)
+	if err != nil {
+		return domain.PageResponse[domain.DelegationsResponseType]{}, err
+	}
+
+	res := domain.PageResponse[domain.DelegationsResponseType]{
+		HasNextPage: len(delegations) > size,
+	}
+	delegations = delegations[:min(size, len(delegations))]
+
+	res.Data = make([]domain.DelegationsResponseType, len(delegations))
+	res.Cursors = make([]string, len(delegations))
+	for i, delegation := range delegations {
+		res.Data[i] = toFailedDelegationResponse(delegation, opts)
+		res.Cursors[i] = domain.DelegationCursor{Level: delegation.Level, ID: delegation.ID}.String()
+	}
+	return res, nil
+}
+
+// GetDelegationsPages returns the same page of the applied delegations of each of
+// the addresses, grouped by delegator or by baker. Addresses without delegations
+// get an empty page.
+func (uc *UseCaseImpl) GetDelegationsPages(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, page domain.Page, opts domain.ResponseOptions) (map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], error) {
+	var after *domain.DelegationCursor
+	if page.After != "" {
+		cursor, err := domain.ParseDelegationCursor(page.After)
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor
+	}
+
+	delegations, err := uc.repository.FindPages(ctx, group, addresses, after, page.Size()+1)
+	if err != nil {
+		return nil, err
+	}
+
+	grouped := make(map[domain.Address][]models.Delegation, len(addresses))
+	for _, delegation := range delegations {
+		address := domain.Address(delegation.Delegator)
+		if group == domain.GroupByBaker {
+			address = domain.Address(delegation.BakerID)
+		}
+		grouped[address] = append(grouped[address], delegation)
+	}
+
+	res := make(map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], len(addresses))
+	for _, address := range addresses {
+		res[address] = toDelegationsPage(grouped[address], page.Size(), opts, func(delegation models.Delegation) string {
+			return domain.DelegationCursor{Level: delegation.Level, ID: delegation.ID}.String()
+		})
+	}
+	return res, nil
+}
+
+// GetCurrentDelegations returns a page of the latest delegation of the delegators
+// currently delegating to baker, by delegator address.
+func (uc *UseCaseImpl) GetCurrentDelegations(ctx context.Context, baker domain.Address, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error) {
+	var after *domain.Address
+	if page.After != "" {
+		delegator, err := domain.ParseAddressCursor(page.After)
+		if err != nil {
+			return domain.PageResponse[domain.DelegationsResponseType]{}, err
+		}
+		after = &delegator
+	}
+
+	delegations, err := uc.repository.FindCurrentDelegations(ctx, baker, after, page.Size()+1)
+	if err != nil {
+		return domain.PageResponse[domain.DelegationsResponseType]{}, err
+	}
+
+	return toDelegationsPage(delegations, page.Size(), opts, func(delegation models.Delegation) string {
+		return domain.AddressCursor(domain.Address(delegation.Delegator))
+	}), nil
+}
+
+// GetLatestDelegations returns the latest delegation of each delegator that delegated,
+// delegators that never did are missing from the result.
+func (uc *UseCaseImpl) GetLatestDelegations(ctx context.Context, delegators []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.DelegationsResponseType, error) {
+	delegations, err := uc.repository.FindLatest(ctx, delegators)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[domain.Address]domain.DelegationsResponseType, len(delegations))
+	for _, delegation := range delegations {
+		res[domain.Address(delegation.Delegator)] = toDelegationResponse(delegation, opts)
+	}
+	return res, nil
+}
+
+// GetBakersPage returns a page of the bakers ordered by delegated amount. Bakers
+// are few, the page is cut from the full list.
+func (uc *UseCaseImpl) GetBakersPage(ctx context.Context, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.BakerResponseType], error) {
+	offset := 0
+	if page.After != "" {
+		after, err := domain.ParseOffsetCursor(page.After)
+		if err != nil {
+			return domain.PageResponse[domain.BakerResponseType]{}, err
+		}
+		offset = after + 1
+	}
+
+	bakers, err := uc.repository.FindBakers(ctx, nil)
+	if err != nil {
+		return domain.PageResponse[domain.BakerResponseType]{}, err
+	}
+
+	start := min(offset, len(bakers))
+	end := min(start+page.Size(), len(bakers))
+	res := domain.PageResponse[domain.BakerResponseType]{
+		Data:        make([]domain.BakerResponseType, 0, end-start),
+		Cursors:     make([]string, 0, end-start),
+		HasNextPage: end < len(bakers),
+	}
+	for i := start; i < end; i++ {
+		res.Data = append(res.Data, toBakerResponse(bakers[i], opts.Unit()))
+		res.Cursors = append(res.Cursors, domain.OffsetCursor(i))
+	}
+	return res, nil
+}
+
+// GetBakersByAddress returns the bakers that exist among addresses.
+func (uc *UseCaseImpl) GetBakersByAddress(ctx context.Context, addresses []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.BakerResponseType, error) {
+	bakers, err := uc.repository.FindBakersByAddress(ctx, addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[domain.Address]domain.BakerResponseType, len(bakers))
+	for _, baker := range bakers {
+		res[domain.Address(baker.Address)] = toBakerResponse(baker, opts.Unit())
+	}
+	return res, nil
+}
+
+// toDelegationsPage keeps the first size delegations, the one after them only
+// tells that another page follows.
+func toDelegationsPage(delegations []models.Delegation, size int, opts domain.ResponseOptions, cursor func(models.Delegation) string) domain.PageResponse[domain.DelegationsResponseType] {
+	res := domain.PageResponse[domain.DelegationsResponseType]{
+		HasNextPage: len(delegations) > size,
+	}
+	delegations = delegations[:min(size, len(delegations))]
+
+	res.Data = make([]domain.DelegationsResponseType, len(delegations))
+	res.Cursors = make([]string, len(delegations))
+	for i, delegation := range delegations {
+		res.Data[i] = toDelegationResponse(delegation, opts)
+		res.Cursors[i] = cursor(delegation)
+	}
+	return res
+}
+
+func toBakerResponse(baker models.BakerStats, unit domain.Unit) domain.BakerResponseType {
+	return domain.BakerResponseType{
+		Address:                  domain.Address(baker.Address),
+		Alias:                    baker.Alias,
+		FirstSeen:                baker.FirstSeen,
+		LastSeen:                 baker.LastSeen,
+		Active:                   baker.Active,
+		RegistrationLevel:        baker.RegistrationLevel,
+		RegistrationTimestamp:    baker.RegistrationTimestamp,
+		DeactivationLevel:        baker.DeactivationLevel,
+		DeactivationTimestamp:    baker.DeactivationTimestamp,
+		TotalDelegationsReceived: baker.TotalDelegationsReceived,
+		Delegators:               baker.Delegators,
+		DelegatedAmount:          domain.NewAmount(baker.DelegatedAmount, unit),
+		Stakers:                  baker.Stakers,
+		StakedAmount:             domain.NewAmount(baker.StakedAmount, unit),
+	}
+}
+
+func toDelegationMetadata(delegation models.Delegation, unit domain.Unit) *domain.DelegationMetadata {
+	metadata := &domain.DelegationMetadata{
+		OperationID:         delegation.OperationID,
+		OperationHash:       delegation.OperationHash,
+		Block:               delegation.Block,
+		Counter:             delegation.Counter,
+		GasUsed:             delegation.GasUsed,
+		Initiator:           toAddress(delegation.Initiator),
+		InitiatorAlias:      delegation.InitiatorAlias,
+		Nonce:               delegation.Nonce,
+		StakingUpdatesCount: delegation.StakingUpdatesCount,
+		SelfDelegation:      delegation.IsSelfDelegation,
+		DelegatorAlias:      delegation.DelegatorAlias,
+		BakerAlias:          delegation.BakerAlias,
+		PreviousBaker:       toAddress(delegation.PreviousBaker),
+		PreviousBakerAlias:  delegation.PreviousBakerAlias,
+	}
+
+	if delegation.BakerID != undelegatedBaker {
+		metadata.Baker = toAddress(&delegation.BakerID)
+	}
+
+	if delegation.BakerFee != nil {
+		fee := domain.NewAmount(*delegation.BakerFee, unit)
+		metadata.BakerFee = &fee
+	}
+
+	return metadata
+}
+
+func toAddress(address *string) *domain.Address {
+	if address == nil {
+		return nil
+	}
+	res := domain.Address(*address)
+	return &res
+}
+
+// accountAlias return the TzKT alias of account, or nil when it has none.
+func accountAlias(account *domain.Account) *string {
+	if account == nil || account.Alias == "" {
+		return nil
+	}
+	return &account.Alias
+}
+
+func toDelegationQuote(quote *domain.Quote) *models.DelegationQuote {
+	if quote == nil {
+		return nil
+	}
+
+	return &models.DelegationQuote{
+		BTC: quote.BTC,
+		EUR: quote.EUR,
+		USD: quote.USD,
+		CNY: quote.CNY,
+		JPY: quote.JPY,
+		KRW: quote.KRW,
+		ETH: quote.ETH,
+		GBP: quote.GBP,
+	}
+}
+
+// fiatValue return the value of the delegated amount in currency at the time of the delegation,
+// or nil when no currency was requested or the delegation has no quote.
+func fiatValue(delegation models.Delegation, currency domain.Currency) *domain.FiatValue {
+	if currency == "" || delegation.Quote == nil {
+		return nil
+	}
+
+	var quote float64
+	switch currency {
+	case domain.CurrencyBTC:
+		quote = delegation.Quote.BTC
+	case domain.CurrencyEUR:
+		quote = delegation.Quote.EUR
+	case domain.CurrencyUSD:
+		quote = delegation.Quote.USD
+	case domain.CurrencyCNY:
+		quote = delegation.Quote.CNY
+	case domain.CurrencyJPY:
+		quote = delegation.Quote.JPY
+	case domain.CurrencyKRW:
+		quote = delegation.Quote.KRW
+	case domain.CurrencyETH:
+		quote = delegation.Quote.ETH
+	case domain.CurrencyGBP:
+		quote = delegation.Quote.GBP
+	default:
+		return nil
+	}
+
+	return &domain.FiatValue{
+		Currency: currency,
+		Quote:    quote,
+		Value:    float64(delegation.Amount) / domain.MutezPerTez * quote,
+	}
+}
+
+// isFailedStatus reports whether status is the status of an operation that did not take effect.
+func isFailedStatus(status string) bool {
+	switch domain.OperationStatus(status) {
+	case domain.StatusFailed, domain.StatusBacktracked, domain.StatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
+// isValidBakerAddress reports whether address can be a baker, bakers are always implicit accounts.
+func isValidBakerAddress(address string) bool {
+	parsed, err := domain.ParseAddress(address)
+	return err == nil && parsed.IsImplicit()
+}
+
 // NewUseCase create a new use case for the delegator.
 func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
 	uc := &UseCaseImpl{}
@@ -37,18 +37,19 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    100000,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1baker",
+						Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
 					},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
 					return len(dtos) == 1 &&
-						dtos[0].Delegation.Delegator == "tz1delegator" &&
-						dtos[0].Baker.Address == "tz1baker" &&
+						dtos[0].Delegation.Delegator == "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT" &&
+						dtos[0].Baker.Address == "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj" &&
 						dtos[0].Delegation.Amount == 100000 &&
 						dtos[0].Delegation.Level == 1000 &&
 						dtos[0].Delegation.IsNewDelegation == true
@@ -67,17 +68,18 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    0,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate:  nil, // Undelegation
-					PrevDelegate: &domain.Account{Address: "tz1oldbaker"},
+					PrevDelegate: &domain.Account{Address: "tz1e5DK7EaiGMLWRzrBo4ipqHg6ysTtj2fBt"},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
 					return len(dtos) == 1 &&
 						dtos[0].Baker.Address == "UNDELEGATED" &&
-						*dtos[0].Delegation.PreviousBaker == "tz1oldbaker" &&
+						*dtos[0].Delegation.PreviousBaker == "tz1e5DK7EaiGMLWRzrBo4ipqHg6ysTtj2fBt" &&
 						dtos[0].Delegation.IsNewDelegation == false
 				})).Return(nil).Once()
 			},
@@ -94,21 +96,22 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    100000,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1newbaker",
+						Address: "tz1V4UEF1QmfPfYsACXAzkUp6AiXKjKpcqP4",
 					},
 					PrevDelegate: &domain.Account{
-						Address: "tz1oldbaker",
+						Address: "tz1e5DK7EaiGMLWRzrBo4ipqHg6ysTtj2fBt",
 					},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
 					return len(dtos) == 1 &&
-						dtos[0].Baker.Address == "tz1newbaker" &&
-						*dtos[0].Delegation.PreviousBaker == "tz1oldbaker" &&
+						dtos[0].Baker.Address == "tz1V4UEF1QmfPfYsACXAzkUp6AiXKjKpcqP4" &&
+						*dtos[0].Delegation.PreviousBaker == "tz1e5DK7EaiGMLWRzrBo4ipqHg6ysTtj2fBt" &&
 						dtos[0].Delegation.IsNewDelegation == false
 				})).Return(nil).Once()
 			},
@@ -125,10 +128,10 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    100000,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1baker",
+						Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
 					},
 				},
 			},
@@ -148,7 +151,7 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:        "ophash123",
 					Amount:      100000,
 					Sender:      nil, // Missing sender
-					NewDelegate: &domain.Account{Address: "tz1baker"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
@@ -169,7 +172,7 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Sender: &domain.Account{
 						Address: "", // Empty address
 					},
-					NewDelegate: &domain.Account{Address: "tz1baker"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
@@ -177,6 +180,190 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Delegation_With_Quote",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:        "delegation",
+					Status:      "applied",
+					Timestamp:   "2023-01-01T12:00:00Z",
+					Level:       1000,
+					Hash:        "ophash123",
+					Amount:      100000,
+					Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+					Quote:       &domain.Quote{EUR: 0.8, USD: 0.9},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
+					return len(dtos) == 1 &&
+						dtos[0].Quote != nil &&
+						dtos[0].Quote.EUR == 0.8 &&
+						dtos[0].Quote.USD == 0.9
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Delegation_With_Metadata",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:                "delegation",
+					Status:              "applied",
+					ID:                  42,
+					Timestamp:           "2023-01-01T12:00:00Z",
+					Level:               1000,
+					Block:               "BLockHash123",
+					Hash:                "ophash123",
+					Counter:             7,
+					GasUsed:             1000,
+					BakerFee:            397,
+					Nonce:               intPtr(3),
+					StakingUpdatesCount: intPtr(1),
+					Amount:              100000,
+					Initiator:           &domain.Account{Address: "KT1Hkg5qeNhfwpKW4fXvq7HGZB9z2EnmCCA9", Alias: "Proxy"},
+					Sender:              &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT", Alias: "Alice"},
+					NewDelegate:         &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj", Alias: "Baker"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
+					if len(dtos) != 1 {
+						return false
+					}
+					d := dtos[0].Delegation
+					return *d.OperationID == 42 &&
+						*d.Block == "BLockHash123" &&
+						*d.Counter == 7 &&
+						*d.GasUsed == 1000 &&
+						*d.BakerFee == 397 &&
+						*d.Nonce == 3 &&
+						*d.StakingUpdatesCount == 1 &&
+						*d.Initiator == "KT1Hkg5qeNhfwpKW4fXvq7HGZB9z2EnmCCA9" &&
+						*d.InitiatorAlias == "Proxy" &&
+						*d.DelegatorAlias == "Alice" &&
+						*d.BakerAlias == "Baker" &&
+						d.PreviousBakerAlias == nil &&
+						*dtos[0].Baker.Alias == "Baker"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Baker_Registration",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:        "delegation",
+					Status:      "applied",
+					Timestamp:   "2023-01-01T12:00:00Z",
+					Level:       1000,
+					Hash:        "ophash123",
+					Amount:      6000000000,
+					Sender:      &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
+					return len(dtos) == 1 &&
+						dtos[0].Delegation.IsSelfDelegation &&
+						!dtos[0].Delegation.IsNewDelegation &&
+						dtos[0].DeactivatedBaker == nil
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Baker_Deactivation",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:         "delegation",
+					Status:       "applied",
+					Timestamp:    "2023-01-01T12:00:00Z",
+					Level:        1000,
+					Hash:         "ophash123",
+					Amount:       6000000000,
+					Sender:       &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+					PrevDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+					NewDelegate:  &domain.Account{Address: "tz1ffzj59EsVjYwvPxzUMSWQdSczXEtQHC4b"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
+					return len(dtos) == 1 &&
+						!dtos[0].Delegation.IsSelfDelegation &&
+						dtos[0].DeactivatedBaker != nil &&
+						*dtos[0].DeactivatedBaker == "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name: "Truncated_Sender_Address",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:        "delegation",
+					Status:      "applied",
+					Timestamp:   "2023-01-01T12:00:00Z",
+					Level:       1000,
+					Hash:        "ophash123",
+					Amount:      100000,
+					Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgW"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				// Should not call Create since the sender address is malformed
+			},
+			wantErr: false,
+		},
+		{
+			name: "Contract_Baker_Address",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:        "delegation",
+					Status:      "applied",
+					Timestamp:   "2023-01-01T12:00:00Z",
+					Level:       1000,
+					Hash:        "ophash123",
+					Amount:      100000,
+					Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+					NewDelegate: &domain.Account{Address: "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				// Should not call Create since a contract cannot be a baker
+			},
+			wantErr: false,
+		},
+		{
+			name: "Contract_Delegator_Address",
+			data: []domain.TzktApiDelegationsResponse{
+				{
+					Type:        "delegation",
+					Status:      "applied",
+					Timestamp:   "2023-01-01T12:00:00Z",
+					Level:       1000,
+					Hash:        "ophash123",
+					Amount:      100000,
+					Sender:      &domain.Account{Address: "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn"},
+					NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+				},
+			},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
+					return len(dtos) == 1 &&
+						dtos[0].Delegation.Delegator == "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn"
+				})).Return(nil).Once()
+			},
+			wantErr: false,
+		},
 		{
 			name: "Non_Delegation_Type",
 			data: []domain.TzktApiDelegationsResponse{
@@ -222,10 +409,10 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    100000,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1baker",
+						Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
 					},
 				},
 			},
@@ -254,10 +441,10 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash123",
 					Amount:    100000,
 					Sender: &domain.Account{
-						Address: "tz1delegator",
+						Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1baker",
+						Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
 					},
 				},
 				{
@@ -276,15 +463,16 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 					Hash:      "ophash125",
 					Amount:    300000,
 					Sender: &domain.Account{
-						Address: "tz1delegator2",
+						Address: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
 					},
 					NewDelegate: &domain.Account{
-						Address: "tz1baker2",
+						Address: "tz1ffzj59EsVjYwvPxzUMSWQdSczXEtQHC4b",
 					},
 				},
 			},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(dtos []domain.CreateDelegationDTO) bool {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					dtos := batch.Delegations
 					// Should only have 2 valid delegations (skipping the origination)
 					return len(dtos) == 2
 				})).Return(nil).Once()
@@ -323,73 +511,71 @@ func TestUseCaseImpl_Create_Comprehensive(t *testing.T) {
 	}
 }
 
-func TestUseCaseImpl_GetDelegations_Comprehensive(t *testing.T) {
+func TestUseCaseImpl_Create_IndexFailed(t *testing.T) {
 	t.Parallel()
 
+	failed := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "failed",
+		ID:          42,
+		Timestamp:   "2023-01-01T12:00:00Z",
+		Level:       1000,
+		Hash:        "ophash123",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+		Errors:      []domain.Error{{Type: "delegate.unchanged"}},
+	}
+	applied := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "applied",
+		ID:          43,
+		Timestamp:   "2023-01-01T12:00:30Z",
+		Level:       1001,
+		Hash:        "ophash124",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
+
 	tests := []struct {
-		name           string
-		setupMocks     func(*mocks.MockRepository)
-		expectedResult domain.ApiResponse[domain.DelegationsResponseType]
-		wantErr        bool
-		expectedErr    string
+		name        string
+		indexFailed bool
+		data        []domain.TzktApiDelegationsResponse
+		setupMocks  func(*mocks.MockRepository)
+		wantErr     bool
 	}{
 		{
-			name: "Success_With_Delegations",
-			setupMocks: func(repo *mocks.MockRepository) {
-				delegations := []models.Delegation{
-					{
-						ID:        uuid.New(),
-						Delegator: "tz1delegator1",
-						Amount:    100000,
-						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
-						Level:     1000,
-					},
-					{
-						ID:        uuid.New(),
-						Delegator: "tz1delegator2",
-						Amount:    200000,
-						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
-						Level:     1001,
-					},
-				}
-				repo.EXPECT().FindAll(mock.Anything).Return(delegations, nil).Once()
-			},
-			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
-				Data: []domain.DelegationsResponseType{
-					{
-						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
-						Amount:    100000,
-						Delegator: "tz1delegator1",
-						Level:     1000,
-					},
-					{
-						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
-						Amount:    200000,
-						Delegator: "tz1delegator2",
-						Level:     1001,
-					},
-				},
-			},
-			wantErr: false,
+			name:        "Disabled_Skips_Failed",
+			indexFailed: false,
+			data:        []domain.TzktApiDelegationsResponse{failed},
 		},
 		{
-			name: "Success_Empty_Result",
+			name:        "Enabled_Stores_Failed_With_Errors",
+			indexFailed: true,
+			data:        []domain.TzktApiDelegationsResponse{failed, applied},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().FindAll(mock.Anything).Return([]models.Delegation{}, nil).Once()
-			},
-			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
-				Data: []domain.DelegationsResponseType{},
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					delegations := batch.Failed
+					return len(batch.Delegations) == 1 && *batch.Delegations[0].Delegation.OperationID == 43 &&
+						len(delegations) == 1 &&
+						delegations[0].OperationID == 42 &&
+						delegations[0].Status == "failed" &&
+						*delegations[0].Baker == "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj" &&
+						assert.ObjectsAreEqual([]string{"delegate.unchanged"}, delegations[0].Errors)
+				})).Return(nil).Once()
 			},
-			wantErr: false,
 		},
 		{
-			name: "Repository_Error",
+			name:        "Enabled_Repository_Error",
+			indexFailed: true,
+			data:        []domain.TzktApiDelegationsResponse{failed},
 			setupMocks: func(repo *mocks.MockRepository) {
-				repo.EXPECT().FindAll(mock.Anything).Return(nil, errors.New("database connection failed")).Once()
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					return len(batch.Delegations) == 0 && len(batch.Failed) == 1
+				})).Return(errors.New("insert failed")).Once()
 			},
-			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{},
-			wantErr:        true,
-			expectedErr:    "database connection failed",
+			wantErr: true,
 		},
 	}
 
@@ -397,107 +583,122 @@ func TestUseCaseImpl_GetDelegations_Comprehensive(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 			mockRepo := mocks.NewMockRepository(t)
-
 			if tt.setupMocks != nil {
 				tt.setupMocks(mockRepo)
 			}
 
-			uc := &UseCaseImpl{
-				logger:     logger,
-				repository: mockRepo,
-			}
-
-			result, err := uc.GetDelegations(context.Background())
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithIndexFailed(tt.indexFailed),
+			)
 
+			err := uc.Create(context.Background(), tt.data)
 			if tt.wantErr {
 				assert.Error(t, err)
-				if tt.expectedErr != "" {
-					assert.Contains(t, err.Error(), tt.expectedErr)
-				}
-				assert.Equal(t, tt.expectedResult, result)
-			} else {
-				assert.NoError(t, err)
-				assert.Equal(t, tt.expectedResult, result)
+				return
 			}
+			assert.NoError(t, err)
 		})
 	}
 }
 
-func TestUseCaseOptions(t *testing.T) {
+func TestUseCaseImpl_Create_CycleMapper(t *testing.T) {
 	t.Parallel()
 
-	t.Run("UseCaseWithLogger", func(t *testing.T) {
-		logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-		uc := &UseCaseImpl{}
+	delegation := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "applied",
+		ID:          43,
+		Timestamp:   "2023-01-01T12:00:30Z",
+		Level:       1001,
+		Hash:        "ophash124",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
 
-		option := UseCaseWithLogger(logger)
-		option(uc)
+	tests := []struct {
+		name          string
+		setupMapper   func(*mocks.MockCycleMapper)
+		expectedCycle *int64
+	}{
+		{
+			name: "Known_Level",
+			setupMapper: func(m *mocks.MockCycleMapper) {
+				m.EXPECT().CycleOf(int64(1001)).Return(int64(12), true).Once()
+			},
+			expectedCycle: int64Ptr(12),
+		},
+		{
+			name: "Unknown_Level",
+			setupMapper: func(m *mocks.MockCycleMapper) {
+				m.EXPECT().CycleOf(int64(1001)).Return(int64(0), false).Once()
+			},
+			expectedCycle: nil,
+		},
+	}
 
-		assert.Equal(t, logger, uc.logger)
-	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
-	t.Run("UseCaseWithRepository", func(t *testing.T) {
-		mockRepo := mocks.NewMockRepository(t)
-		uc := &UseCaseImpl{}
+			mockRepo := mocks.NewMockRepository(t)
+			mockMapper := mocks.NewMockCycleMapper(t)
+			tt.setupMapper(mockMapper)
 
-		option := UseCaseWithRepository(mockRepo)
-		option(uc)
+			mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+				dtos := batch.Delegations
+				return len(dtos) == 1 && assert.ObjectsAreEqual(tt.expectedCycle, dtos[0].Delegation.Cycle)
+			})).Return(nil).Once()
 
-		assert.Equal(t, mockRepo, uc.repository)
-	})
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithCycleMapper(mockMapper),
+			)
+
+			assert.NoError(t, uc.Create(context.Background(), []domain.TzktApiDelegationsResponse{delegation}))
+		})
+	}
 }
 
-func TestNewUseCase_Comprehensive(t *testing.T) {
+func TestUseCaseImpl_Create_Broker(t *testing.T) {
 	t.Parallel()
 
-	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	mockRepo := mocks.NewMockRepository(t)
+	delegation := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "applied",
+		ID:          43,
+		Timestamp:   "2023-01-01T12:00:30Z",
+		Level:       1001,
+		Hash:        "ophash124",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
 
 	tests := []struct {
-		name    string
-		options []UseCaseOption
-		checks  func(*testing.T, *UseCaseImpl)
+		name       string
+		setupMocks func(*mocks.MockRepository, *mocks.MockDelegationBroker)
+		wantErr    bool
 	}{
 		{
-			name: "With_All_Options",
-			options: []UseCaseOption{
-				UseCaseWithLogger(logger),
-				UseCaseWithRepository(mockRepo),
-			},
-			checks: func(t *testing.T, uc *UseCaseImpl) {
-				assert.Equal(t, logger, uc.logger)
-				assert.Equal(t, mockRepo, uc.repository)
-			},
-		},
-		{
-			name:    "With_No_Options",
-			options: []UseCaseOption{},
-			checks: func(t *testing.T, uc *UseCaseImpl) {
-				assert.Nil(t, uc.logger)
-				assert.Nil(t, uc.repository)
-			},
-		},
-		{
-			name: "With_Logger_Only",
-			options: []UseCaseOption{
-				UseCaseWithLogger(logger),
-			},
-			checks: func(t *testing.T, uc *UseCaseImpl) {
-				assert.Equal(t, logger, uc.logger)
-				assert.Nil(t, uc.repository)
+			name: "Published_After_Create",
+			setupMocks: func(repo *mocks.MockRepository, broker *mocks.MockDelegationBroker) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+				broker.EXPECT().Publish(mock.MatchedBy(func(delegations []models.Delegation) bool {
+					return len(delegations) == 1 && *delegations[0].OperationID == 43 && delegations[0].Delegator == "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"
+				})).Once()
 			},
 		},
 		{
-			name: "With_Repository_Only",
-			options: []UseCaseOption{
-				UseCaseWithRepository(mockRepo),
-			},
-			checks: func(t *testing.T, uc *UseCaseImpl) {
-				assert.Nil(t, uc.logger)
-				assert.Equal(t, mockRepo, uc.repository)
+			name: "Not_Published_On_Error",
+			setupMocks: func(repo *mocks.MockRepository, broker *mocks.MockDelegationBroker) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
 			},
+			wantErr: true,
 		},
 	}
 
@@ -505,12 +706,1182 @@ func TestNewUseCase_Comprehensive(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			uc := NewUseCase(tt.options...)
+			mockRepo := mocks.NewMockRepository(t)
+			mockBroker := mocks.NewMockDelegationBroker(t)
+			tt.setupMocks(mockRepo, mockBroker)
 
-			assert.NotNil(t, uc)
-			if tt.checks != nil {
-				tt.checks(t, uc)
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithBroker(mockBroker),
+			)
+
+			err := uc.Create(context.Background(), []domain.TzktApiDelegationsResponse{delegation})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
 			}
+			assert.NoError(t, err)
 		})
 	}
 }
+
+func TestUseCaseImpl_Create_IndexHead(t *testing.T) {
+	t.Parallel()
+
+	failed := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "failed",
+		ID:          45,
+		Timestamp:   "2023-01-01T12:02:00Z",
+		Level:       1004,
+		Hash:        "ophash126",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
+	applied := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "applied",
+		ID:          44,
+		Timestamp:   "2023-01-01T12:01:00Z",
+		Level:       1002,
+		Hash:        "ophash125",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
+
+	tests := []struct {
+		name       string
+		data       []domain.TzktApiDelegationsResponse
+		setupMocks func(*mocks.MockRepository, *mocks.MockIndexHeadTracker)
+		wantErr    bool
+	}{
+		{
+			name: "Advanced_To_Latest_Stored",
+			data: []domain.TzktApiDelegationsResponse{applied, failed},
+			setupMocks: func(repo *mocks.MockRepository, head *mocks.MockIndexHeadTracker) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+				head.EXPECT().Advance(mock.Anything, domain.IndexHead{
+					Level:     1004,
+					Timestamp: time.Date(2023, 1, 1, 12, 2, 0, 0, time.UTC),
+				}).Once()
+			},
+		},
+		{
+			name: "Advanced_By_Failed_Only",
+			data: []domain.TzktApiDelegationsResponse{failed},
+			setupMocks: func(repo *mocks.MockRepository, head *mocks.MockIndexHeadTracker) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(nil).Once()
+				head.EXPECT().Advance(mock.Anything, mock.MatchedBy(func(h domain.IndexHead) bool {
+					return h.Level == 1004
+				})).Once()
+			},
+		},
+		{
+			name: "Not_Advanced_On_Error",
+			data: []domain.TzktApiDelegationsResponse{applied},
+			setupMocks: func(repo *mocks.MockRepository, head *mocks.MockIndexHeadTracker) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			mockHead := mocks.NewMockIndexHeadTracker(t)
+			tt.setupMocks(mockRepo, mockHead)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithIndexFailed(true),
+				UseCaseWithIndexHead(mockHead),
+			)
+
+			err := uc.Create(context.Background(), tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_Create_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	delegation := domain.TzktApiDelegationsResponse{
+		Type:        "delegation",
+		Status:      "applied",
+		ID:          44,
+		Timestamp:   "2023-01-01T12:01:00Z",
+		Level:       1002,
+		Hash:        "ophash125",
+		Amount:      100000,
+		Sender:      &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"},
+		NewDelegate: &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
+
+	deliveries := []models.WebhookDelivery{{ID: uuid.New(), OperationID: 44}}
+
+	tests := []struct {
+		name          string
+		deliveriesErr error
+		wantErr       bool
+	}{
+		{
+			name: "Stored_With_Delegations",
+		},
+		{
+			// Nothing is stored, the batch is indexed again on the next round.
+			name:          "Deliveries_Error",
+			deliveriesErr: errors.New("db down"),
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			mockWebhooks := mocks.NewMockWebhookUseCase(t)
+			mockWebhooks.EXPECT().Deliveries(mock.Anything, mock.MatchedBy(func(delegations []models.Delegation) bool {
+				return len(delegations) == 1 && *delegations[0].OperationID == 44
+			})).Return(deliveries, tt.deliveriesErr).Once()
+			if !tt.wantErr {
+				mockRepo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(batch domain.DelegationBatch) bool {
+					return len(batch.Delegations) == 1 && assert.ObjectsAreEqual(deliveries, batch.WebhookDeliveries)
+				})).Return(nil).Once()
+			}
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithWebhooks(mockWebhooks),
+			)
+
+			err := uc.Create(context.Background(), []domain.TzktApiDelegationsResponse{delegation})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_WatchDelegations(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	delegationAt := func(operationID int64) models.Delegation {
+		return models.Delegation{
+			Delegator:   "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+			BakerID:     baker.String(),
+			Amount:      100000,
+			Level:       1000 + operationID,
+			OperationID: int64Ptr(operationID),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		filter      domain.DelegationFilter
+		setupMocks  func(*mocks.MockRepository, chan models.Delegation)
+		expectedIDs []int64
+	}{
+		{
+			name:   "Live_Only",
+			filter: domain.DelegationFilter{Baker: &baker},
+			setupMocks: func(repo *mocks.MockRepository, events chan models.Delegation) {
+				events <- delegationAt(10)
+				events <- delegationAt(11)
+				close(events)
+			},
+			expectedIDs: []int64{10, 11},
+		},
+		{
+			name:   "Replay_Then_Live_Without_Duplicates",
+			filter: domain.DelegationFilter{Baker: &baker, AfterOperationID: int64Ptr(5)},
+			setupMocks: func(repo *mocks.MockRepository, events chan models.Delegation) {
+				repo.EXPECT().StreamAll(mock.Anything, domain.DelegationFilter{Baker: &baker, AfterOperationID: int64Ptr(5)}, mock.Anything).
+					RunAndReturn(func(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error) error {
+						// Published while replaying, it is both in the database and on the channel.
+						events <- delegationAt(7)
+						events <- delegationAt(8)
+						close(events)
+						for _, id := range []int64{6, 7} {
+							if err := fn(delegationAt(id)); err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Once()
+			},
+			expectedIDs: []int64{6, 7, 8},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			mockBroker := mocks.NewMockDelegationBroker(t)
+			events := make(chan models.Delegation, 4)
+			unsubscribed := false
+			mockBroker.EXPECT().Subscribe(domain.DelegationFilter{Baker: &baker}).
+				Return((<-chan models.Delegation)(events), func() { unsubscribed = true }).Once()
+			tt.setupMocks(mockRepo, events)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithBroker(mockBroker),
+			)
+
+			var ids []int64
+			err := uc.WatchDelegations(context.Background(), tt.filter, domain.ResponseOptions{}, func(delegation domain.DelegationsResponseType) error {
+				ids = append(ids, *delegation.OperationID)
+				return nil
+			})
+
+			assert.ErrorIs(t, err, domain.ErrSubscriptionClosed)
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.True(t, unsubscribed)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetDelegations_Failed(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT")
+	filter := domain.DelegationFilter{Delegator: &delegator, Status: domain.StatusFailed}
+
+	mockRepo := mocks.NewMockRepository(t)
+	mockRepo.EXPECT().FindFailed(mock.Anything, filter).Return([]models.FailedDelegation{
+		{
+			OperationID:   42,
+			OperationHash: "ophash123",
+			Status:        "failed",
+			Errors:        []string{"delegate.unchanged"},
+			Delegator:     delegator.String(),
+			Baker:         stringPtr("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"),
+			Amount:        100000,
+			Timestamp:     time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			Level:         1000,
+		},
+	}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	res, err := uc.GetDelegations(context.Background(), filter, domain.ResponseOptions{Expand: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ApiResponse[domain.DelegationsResponseType]{
+		Data: []domain.DelegationsResponseType{
+			{
+				Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+				Amount:    domain.NewAmount(100000, domain.UnitMutez),
+				Delegator: delegator,
+				Level:     1000,
+				Status:    domain.StatusFailed,
+				Errors:    []string{"delegate.unchanged"},
+				DelegationMetadata: &domain.DelegationMetadata{
+					OperationID:   int64Ptr(42),
+					OperationHash: stringPtr("ophash123"),
+					Baker:         addressPtr("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"),
+				},
+			},
+		},
+		Units: domain.UnitMutez,
+	}, res)
+}
+
+func TestUseCaseImpl_ExportDelegations(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT")
+	timestamp := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		filter     domain.DelegationFilter
+		setupMocks func(*mocks.MockRepository)
+		expected   []domain.DelegationsResponseType
+		wantErr    bool
+	}{
+		{
+			name:   "Applied",
+			filter: domain.DelegationFilter{Delegator: &delegator},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().StreamAll(mock.Anything, domain.DelegationFilter{Delegator: &delegator}, mock.Anything).
+					RunAndReturn(func(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error) error {
+						for _, level := range []int64{1000, 1001} {
+							err := fn(models.Delegation{
+								Delegator: delegator.String(),
+								BakerID:   "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+								Amount:    100000,
+								Timestamp: timestamp,
+								Level:     level,
+							})
+							if err != nil {
+								return err
+							}
+						}
+						return nil
+					}).Once()
+			},
+			expected: []domain.DelegationsResponseType{
+				{Timestamp: timestamp, Amount: domain.NewAmount(100000, domain.UnitTez), Delegator: delegator, Level: 1000},
+				{Timestamp: timestamp, Amount: domain.NewAmount(100000, domain.UnitTez), Delegator: delegator, Level: 1001},
+			},
+		},
+		{
+			name:   "Failed",
+			filter: domain.DelegationFilter{Status: domain.StatusBacktracked},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().StreamFailed(mock.Anything, domain.DelegationFilter{Status: domain.StatusBacktracked}, mock.Anything).
+					RunAndReturn(func(ctx context.Context, filter domain.DelegationFilter, fn func(models.FailedDelegation) error) error {
+						return fn(models.FailedDelegation{
+							Status:    "backtracked",
+							Delegator: delegator.String(),
+							Amount:    100000,
+							Timestamp: timestamp,
+							Level:     1000,
+						})
+					}).Once()
+			},
+			expected: []domain.DelegationsResponseType{
+				{Timestamp: timestamp, Amount: domain.NewAmount(100000, domain.UnitTez), Delegator: delegator, Level: 1000, Status: domain.StatusBacktracked},
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().StreamAll(mock.Anything, domain.DelegationFilter{}, mock.Anything).Return(errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			var exported []domain.DelegationsResponseType
+			err := uc.ExportDelegations(context.Background(), tt.filter, domain.ResponseOptions{Units: domain.UnitTez}, func(delegation domain.DelegationsResponseType) error {
+				exported = append(exported, delegation)
+				return nil
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, exported)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetDelegations_Comprehensive(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		opts           domain.ResponseOptions
+		setupMocks     func(*mocks.MockRepository)
+		expectedResult domain.ApiResponse[domain.DelegationsResponseType]
+		wantErr        bool
+		expectedErr    string
+	}{
+		{
+			name: "Success_With_Delegations",
+			setupMocks: func(repo *mocks.MockRepository) {
+				delegations := []models.Delegation{
+					{
+						ID:        uuid.New(),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Amount:    100000,
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Level:     1000,
+					},
+					{
+						ID:        uuid.New(),
+						Delegator: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						Amount:    200000,
+						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Level:     1001,
+					},
+				}
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(delegations, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
+				Data: []domain.DelegationsResponseType{
+					{
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(100000, domain.UnitMutez),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Level:     1000,
+					},
+					{
+						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(200000, domain.UnitMutez),
+						Delegator: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						Level:     1001,
+					},
+				},
+				Units: domain.UnitMutez,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success_In_Tez",
+			opts: domain.ResponseOptions{Units: domain.UnitTez},
+			setupMocks: func(repo *mocks.MockRepository) {
+				delegations := []models.Delegation{
+					{
+						ID:        uuid.New(),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Amount:    1500000,
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Level:     1000,
+					},
+				}
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(delegations, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
+				Data: []domain.DelegationsResponseType{
+					{
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(1500000, domain.UnitTez),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Level:     1000,
+					},
+				},
+				Units: domain.UnitTez,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success_With_Fiat_Value",
+			opts: domain.ResponseOptions{Units: domain.UnitMutez, Currency: domain.CurrencyEUR},
+			setupMocks: func(repo *mocks.MockRepository) {
+				delegations := []models.Delegation{
+					{
+						ID:        uuid.New(),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Amount:    2000000,
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Level:     1000,
+						Quote:     &models.DelegationQuote{EUR: 0.5, USD: 0.6},
+					},
+					{
+						ID:        uuid.New(),
+						Delegator: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						Amount:    1000000,
+						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Level:     1001,
+					},
+				}
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(delegations, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
+				Data: []domain.DelegationsResponseType{
+					{
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(2000000, domain.UnitMutez),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Level:     1000,
+						FiatValue: &domain.FiatValue{Currency: domain.CurrencyEUR, Quote: 0.5, Value: 1},
+					},
+					{
+						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(1000000, domain.UnitMutez),
+						Delegator: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						Level:     1001,
+					},
+				},
+				Units: domain.UnitMutez,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success_Expanded",
+			opts: domain.ResponseOptions{Units: domain.UnitTez, Expand: true},
+			setupMocks: func(repo *mocks.MockRepository) {
+				delegations := []models.Delegation{
+					{
+						ID:             uuid.New(),
+						Delegator:      "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						BakerID:        "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+						Amount:         2000000,
+						Timestamp:      time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Level:          1000,
+						OperationID:    int64Ptr(42),
+						Block:          stringPtr("BLockHash123"),
+						BakerFee:       int64Ptr(397),
+						DelegatorAlias: stringPtr("Alice"),
+						BakerAlias:     stringPtr("Baker"),
+					},
+					{
+						ID:            uuid.New(),
+						Delegator:     "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						BakerID:       "UNDELEGATED",
+						Amount:        1000000,
+						Timestamp:     time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Level:         1001,
+						PreviousBaker: stringPtr("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"),
+					},
+				}
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(delegations, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
+				Data: []domain.DelegationsResponseType{
+					{
+						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(2000000, domain.UnitTez),
+						Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP",
+						Level:     1000,
+						DelegationMetadata: &domain.DelegationMetadata{
+							OperationID:    int64Ptr(42),
+							Block:          stringPtr("BLockHash123"),
+							BakerFee:       &domain.Amount{Mutez: 397, Unit: domain.UnitTez},
+							DelegatorAlias: stringPtr("Alice"),
+							Baker:          addressPtr("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"),
+							BakerAlias:     stringPtr("Baker"),
+						},
+					},
+					{
+						Timestamp: time.Date(2023, 1, 2, 12, 0, 0, 0, time.UTC),
+						Amount:    domain.NewAmount(1000000, domain.UnitTez),
+						Delegator: "tz1gjzranjwdSTgKzVsYsbBomJUzGMwQ2Sjq",
+						Level:     1001,
+						DelegationMetadata: &domain.DelegationMetadata{
+							PreviousBaker: addressPtr("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"),
+						},
+					},
+				},
+				Units: domain.UnitTez,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Success_Empty_Result",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return([]models.Delegation{}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{
+				Data:  []domain.DelegationsResponseType{},
+				Units: domain.UnitMutez,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindAll(mock.Anything, domain.DelegationFilter{}).Return(nil, errors.New("database connection failed")).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.DelegationsResponseType]{},
+			wantErr:        true,
+			expectedErr:    "database connection failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			mockRepo := mocks.NewMockRepository(t)
+
+			if tt.setupMocks != nil {
+				tt.setupMocks(mockRepo)
+			}
+
+			uc := &UseCaseImpl{
+				logger:     logger,
+				repository: mockRepo,
+			}
+
+			result, err := uc.GetDelegations(context.Background(), domain.DelegationFilter{}, tt.opts)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				if tt.expectedErr != "" {
+					assert.Contains(t, err.Error(), tt.expectedErr)
+				}
+				assert.Equal(t, tt.expectedResult, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
+
+func TestUseCaseOptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("UseCaseWithLogger", func(t *testing.T) {
+		logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+		uc := &UseCaseImpl{}
+
+		option := UseCaseWithLogger(logger)
+		option(uc)
+
+		assert.Equal(t, logger, uc.logger)
+	})
+
+	t.Run("UseCaseWithRepository", func(t *testing.T) {
+		mockRepo := mocks.NewMockRepository(t)
+		uc := &UseCaseImpl{}
+
+		option := UseCaseWithRepository(mockRepo)
+		option(uc)
+
+		assert.Equal(t, mockRepo, uc.repository)
+	})
+}
+
+func TestNewUseCase_Comprehensive(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	mockRepo := mocks.NewMockRepository(t)
+
+	tests := []struct {
+		name    string
+		options []UseCaseOption
+		checks  func(*testing.T, *UseCaseImpl)
+	}{
+		{
+			name: "With_All_Options",
+			options: []UseCaseOption{
+				UseCaseWithLogger(logger),
+				UseCaseWithRepository(mockRepo),
+				UseCaseWithIndexFailed(true),
+			},
+			checks: func(t *testing.T, uc *UseCaseImpl) {
+				assert.Equal(t, logger, uc.logger)
+				assert.Equal(t, mockRepo, uc.repository)
+				assert.True(t, uc.indexFailed)
+			},
+		},
+		{
+			name:    "With_No_Options",
+			options: []UseCaseOption{},
+			checks: func(t *testing.T, uc *UseCaseImpl) {
+				assert.Nil(t, uc.logger)
+				assert.Nil(t, uc.repository)
+			},
+		},
+		{
+			name: "With_Logger_Only",
+			options: []UseCaseOption{
+				UseCaseWithLogger(logger),
+			},
+			checks: func(t *testing.T, uc *UseCaseImpl) {
+				assert.Equal(t, logger, uc.logger)
+				assert.Nil(t, uc.repository)
+			},
+		},
+		{
+			name: "With_Repository_Only",
+			options: []UseCaseOption{
+				UseCaseWithRepository(mockRepo),
+			},
+			checks: func(t *testing.T, uc *UseCaseImpl) {
+				assert.Nil(t, uc.logger)
+				assert.Equal(t, mockRepo, uc.repository)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			uc := NewUseCase(tt.options...)
+
+			assert.NotNil(t, uc)
+			if tt.checks != nil {
+				tt.checks(t, uc)
+			}
+		})
+	}
+}
+
+func TestUseCaseImpl_GetBakers(t *testing.T) {
+	t.Parallel()
+
+	firstSeen := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastSeen := time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		opts           domain.ResponseOptions
+		setupMocks     func(*mocks.MockRepository)
+		expectedResult domain.ApiResponse[domain.BakerResponseType]
+		wantErr        bool
+	}{
+		{
+			name: "Success_In_Tez",
+			opts: domain.ResponseOptions{Units: domain.UnitTez},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, (*domain.Address)(nil)).Return([]models.BakerStats{
+					{
+						Address:                  "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+						FirstSeen:                firstSeen,
+						LastSeen:                 lastSeen,
+						TotalDelegationsReceived: 3,
+						Delegators:               2,
+						DelegatedAmount:          2500000,
+						Stakers:                  1,
+						StakedAmount:             6000000000,
+					},
+				}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.BakerResponseType]{
+				Data: []domain.BakerResponseType{
+					{
+						Address:                  "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+						FirstSeen:                firstSeen,
+						LastSeen:                 lastSeen,
+						TotalDelegationsReceived: 3,
+						Delegators:               2,
+						DelegatedAmount:          domain.NewAmount(2500000, domain.UnitTez),
+						Stakers:                  1,
+						StakedAmount:             domain.NewAmount(6000000000, domain.UnitTez),
+					},
+				},
+				Units: domain.UnitTez,
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, (*domain.Address)(nil)).Return(nil, errors.New("database error")).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.BakerResponseType]{},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			result, err := uc.GetBakers(context.Background(), tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetBaker(t *testing.T) {
+	t.Parallel()
+
+	address := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	registrationLevel := int64(900)
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*mocks.MockRepository)
+		expectedErr error
+		expected    domain.BakerResponseType
+	}{
+		{
+			name: "Found",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, &address).Return([]models.BakerStats{
+					{Address: address.String(), Active: true, RegistrationLevel: &registrationLevel, Delegators: 1, DelegatedAmount: 100},
+				}, nil).Once()
+			},
+			expected: domain.BakerResponseType{
+				Address:           address,
+				Active:            true,
+				RegistrationLevel: &registrationLevel,
+				Delegators:        1,
+				DelegatedAmount:   domain.NewAmount(100, domain.UnitMutez),
+				StakedAmount:      domain.NewAmount(0, domain.UnitMutez),
+			},
+		},
+		{
+			name: "Not_Found",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindBakers(mock.Anything, &address).Return([]models.BakerStats{}, nil).Once()
+			},
+			expectedErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			result, err := uc.GetBaker(context.Background(), address, domain.ResponseOptions{})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetDelegationsPage(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+	first := models.Delegation{ID: uuid.MustParse("11111111-1111-1111-1111-111111111111"), Delegator: delegator.String(), Level: 300, Amount: 10}
+	second := models.Delegation{ID: uuid.MustParse("22222222-2222-2222-2222-222222222222"), Delegator: delegator.String(), Level: 200, Amount: 20}
+	third := models.Delegation{ID: uuid.MustParse("33333333-3333-3333-3333-333333333333"), Delegator: delegator.String(), Level: 100, Amount: 30}
+	after := domain.DelegationCursor{Level: 300, ID: first.ID}
+
+	tests := []struct {
+		name            string
+		status          domain.OperationStatus
+		page            domain.Page
+		setupMocks      func(*mocks.MockRepository)
+		expectedLevels  []int64
+		expectedCursors []string
+		hasNextPage     bool
+		expectedErr     error
+	}{
+		{
+			name: "Has_Next_Page",
+			page: domain.Page{First: 2},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindPage(mock.Anything, domain.DelegationFilter{Delegator: &delegator}, (*domain.DelegationCursor)(nil), 3).
+					Return([]models.Delegation{first, second, third}, nil).Once()
+			},
+			expectedLevels:  []int64{300, 200},
+			expectedCursors: []string{after.String(), domain.DelegationCursor{Level: 200, ID: second.ID}.String()},
+			hasNextPage:     true,
+		},
+		{
+			name: "Last_Page_After_Cursor",
+			page: domain.Page{First: 2, After: after.String()},
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindPage(mock.Anything, domain.DelegationFilter{Delegator: &delegator}, &after, 3).
+					Return([]models.Delegation{second, third}, nil).Once()
+			},
+			expectedLevels: []int64{200, 100},
+			expectedCursors: []string{
+				domain.DelegationCursor{Level: 200, ID: second.ID}.String(),
+				domain.DelegationCursor{Level: 100, ID: third.ID}.String(),
+			},
+		},
+		{
+			name:   "Failed",
+			status: domain.StatusBacktracked,
+			page:   domain.Page{First: 1, After: after.String()},
+			setupMocks: func(repo *mocks.MockRepository) {
+				filter := domain.DelegationFilter{Delegator: &delegator, Status: domain.StatusBacktracked}
+				repo.EXPECT().FindFailedPage(mock.Anything, filter, &after, 2).
+					Return([]models.FailedDelegation{
+						{ID: second.ID, Delegator: delegator.String(), Level: 200, Status: "backtracked"},
+						{ID: third.ID, Delegator: delegator.String(), Level: 100, Status: "backtracked"},
+					}, nil).Once()
+			},
+			expectedLevels:  []int64{200},
+			expectedCursors: []string{domain.DelegationCursor{Level: 200, ID: second.ID}.String()},
+			hasNextPage:     true,
+		},
+		{
+			name:        "Invalid_Cursor",
+			page:        domain.Page{After: "not a cursor"},
+			setupMocks:  func(repo *mocks.MockRepository) {},
+			expectedErr: domain.ErrInvalidCursor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			filter := domain.DelegationFilter{Delegator: &delegator, Status: tt.status}
+			result, err := uc.GetDelegationsPage(context.Background(), filter, tt.page, domain.ResponseOptions{})
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			levels := make([]int64, len(result.Data))
+			for i, delegation := range result.Data {
+				levels[i] = delegation.Level
+			}
+			assert.Equal(t, tt.expectedLevels, levels)
+			assert.Equal(t, tt.expectedCursors, result.Cursors)
+			assert.Equal(t, tt.hasNextPage, result.HasNextPage)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetDelegationsPages(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	busy := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+	quiet := domain.Address("tz1b5y8fXzW5u3Ax3TDyNmdjx1WRBKEuNLjs")
+	idle := domain.Address("tz1gjaF81ZRRvdzjobyfVNsAeSC6PScjfQwN")
+
+	mockRepo := mocks.NewMockRepository(t)
+	mockRepo.EXPECT().FindPages(mock.Anything, domain.GroupByDelegator, []domain.Address{busy, quiet, idle}, (*domain.DelegationCursor)(nil), 2).
+		Return([]models.Delegation{
+			{ID: uuid.New(), Delegator: busy.String(), BakerID: baker.String(), Level: 300},
+			{ID: uuid.New(), Delegator: busy.String(), BakerID: baker.String(), Level: 200},
+			{ID: uuid.New(), Delegator: quiet.String(), BakerID: baker.String(), Level: 100},
+		}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	result, err := uc.GetDelegationsPages(context.Background(), domain.GroupByDelegator, []domain.Address{busy, quiet, idle}, domain.Page{First: 1}, domain.ResponseOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 3)
+
+	assert.Len(t, result[busy].Data, 1)
+	assert.Equal(t, int64(300), result[busy].Data[0].Level)
+	assert.True(t, result[busy].HasNextPage)
+
+	assert.Len(t, result[quiet].Data, 1)
+	assert.False(t, result[quiet].HasNextPage)
+
+	assert.Empty(t, result[idle].Data)
+	assert.NotNil(t, result[idle].Data)
+	assert.False(t, result[idle].HasNextPage)
+}
+
+func TestUseCaseImpl_GetCurrentDelegations(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	first := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+	second := domain.Address("tz1b5y8fXzW5u3Ax3TDyNmdjx1WRBKEuNLjs")
+
+	mockRepo := mocks.NewMockRepository(t)
+	mockRepo.EXPECT().FindCurrentDelegations(mock.Anything, baker, &first, 2).
+		Return([]models.Delegation{{Delegator: second.String(), BakerID: baker.String(), Amount: 5}}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	result, err := uc.GetCurrentDelegations(context.Background(), baker, domain.Page{First: 1, After: domain.AddressCursor(first)}, domain.ResponseOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, result.Data, 1)
+	assert.Equal(t, second, result.Data[0].Delegator)
+	assert.Equal(t, []string{domain.AddressCursor(second)}, result.Cursors)
+	assert.False(t, result.HasNextPage)
+}
+
+func TestUseCaseImpl_GetBakersPage(t *testing.T) {
+	t.Parallel()
+
+	bakers := []models.BakerStats{
+		{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+		{Address: "tz1aRoaRhSpRYvFdyvgWLL6TGyRoGF51wDjM"},
+		{Address: "tz1KfEsrtDaA1sX7vdM4qmEPWuSytuqCDp5j"},
+	}
+
+	tests := []struct {
+		name              string
+		page              domain.Page
+		expectedAddresses []domain.Address
+		expectedCursors   []string
+		hasNextPage       bool
+	}{
+		{
+			name:              "First_Page",
+			page:              domain.Page{First: 2},
+			expectedAddresses: []domain.Address{"tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj", "tz1aRoaRhSpRYvFdyvgWLL6TGyRoGF51wDjM"},
+			expectedCursors:   []string{domain.OffsetCursor(0), domain.OffsetCursor(1)},
+			hasNextPage:       true,
+		},
+		{
+			name:              "Last_Page",
+			page:              domain.Page{First: 2, After: domain.OffsetCursor(1)},
+			expectedAddresses: []domain.Address{"tz1KfEsrtDaA1sX7vdM4qmEPWuSytuqCDp5j"},
+			expectedCursors:   []string{domain.OffsetCursor(2)},
+		},
+		{
+			name:              "Past_The_End",
+			page:              domain.Page{After: domain.OffsetCursor(10)},
+			expectedAddresses: []domain.Address{},
+			expectedCursors:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			mockRepo.EXPECT().FindBakers(mock.Anything, (*domain.Address)(nil)).Return(bakers, nil).Once()
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			result, err := uc.GetBakersPage(context.Background(), tt.page, domain.ResponseOptions{})
+			assert.NoError(t, err)
+
+			addresses := make([]domain.Address, len(result.Data))
+			for i, baker := range result.Data {
+				addresses[i] = baker.Address
+			}
+			assert.Equal(t, tt.expectedAddresses, addresses)
+			assert.Equal(t, tt.expectedCursors, result.Cursors)
+			assert.Equal(t, tt.hasNextPage, result.HasNextPage)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetBakersByAddress(t *testing.T) {
+	t.Parallel()
+
+	known := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	unknown := domain.Address("tz1aRoaRhSpRYvFdyvgWLL6TGyRoGF51wDjM")
+
+	mockRepo := mocks.NewMockRepository(t)
+	mockRepo.EXPECT().FindBakersByAddress(mock.Anything, []domain.Address{known, unknown}).
+		Return([]models.BakerStats{{Address: known.String(), DelegatedAmount: 100}}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	result, err := uc.GetBakersByAddress(context.Background(), []domain.Address{known, unknown}, domain.ResponseOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, domain.NewAmount(100, domain.UnitMutez), result[known].DelegatedAmount)
+	assert.NotContains(t, result, unknown)
+}
+
+func TestUseCaseImpl_GetLatestDelegations(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+
+	tests := []struct {
+		name       string
+		setupMocks func(*mocks.MockRepository)
+		expected   map[domain.Address]domain.DelegationsResponseType
+		wantErr    bool
+	}{
+		{
+			name: "Success",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindLatest(mock.Anything, []domain.Address{delegator}).
+					Return([]models.Delegation{{Delegator: delegator.String(), Level: 42, Amount: 7}}, nil).Once()
+			},
+			expected: map[domain.Address]domain.DelegationsResponseType{
+				delegator: {Delegator: delegator, Level: 42, Amount: domain.NewAmount(7, domain.UnitMutez)},
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockRepository) {
+				repo.EXPECT().FindLatest(mock.Anything, []domain.Address{delegator}).
+					Return(nil, errors.New("database error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			result, err := uc.GetLatestDelegations(context.Background(), []domain.Address{delegator}, domain.ResponseOptions{})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
+
+func addressPtr(v domain.Address) *domain.Address {
+	return &v
+}
@@ -2,8 +2,10 @@ package indexer
 
 import (
 	"context"
+	"delegator/conf"
 	"delegator/pkg/domain"
 	"log/slog"
+	"sync"
 	"time"
 )
 
@@ -13,6 +15,13 @@ type DelegatorIndexer struct {
 	delegatorUseCase  domain.UseCase
 	DelegationHandler domain.DelegationService
 	repository        domain.Repository
+
+	stakingUseCase    domain.StakingUseCase
+	stakingRepository domain.StakingRepository
+
+	mu              sync.Mutex
+	pollInterval    time.Duration
+	intervalChanged chan struct{}
 }
 
 type Options func(*DelegatorIndexer)
@@ -41,14 +50,75 @@ func WithRepository(repository domain.Repository) Options {
 	}
 }
 
+// WithStakingUseCase enables the staking operations ingestion, it requires WithStakingRepository.
+func WithStakingUseCase(stakingUseCase domain.StakingUseCase) Options {
+	return func(i *DelegatorIndexer) {
+		i.stakingUseCase = stakingUseCase
+	}
+}
+
+// WithStakingRepository sets the repository holding the staking checkpoint.
+func WithStakingRepository(stakingRepository domain.StakingRepository) Options {
+	return func(i *DelegatorIndexer) {
+		i.stakingRepository = stakingRepository
+	}
+}
+
+// WithPollInterval sets the delay between two indexing rounds.
+func WithPollInterval(interval time.Duration) Options {
+	return func(i *DelegatorIndexer) {
+		i.pollInterval = interval
+	}
+}
+
+// SetPollInterval updates the delay between two indexing rounds while the indexer runs.
+func (d *DelegatorIndexer) SetPollInterval(interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	d.mu.Lock()
+	changed := d.pollInterval != interval
+	d.pollInterval = interval
+	d.mu.Unlock()
+
+	if !changed {
+		return
+	}
+
+	d.logger.Info("updated indexer poll interval", "interval", interval)
+	select {
+	case d.intervalChanged <- struct{}{}:
+	default:
+	}
+}
+
+// Reload applies the poll interval from a freshly loaded configuration.
+func (d *DelegatorIndexer) Reload(cfg *conf.DelegatorConfig) {
+	d.SetPollInterval(cfg.PollInterval())
+}
+
+func (d *DelegatorIndexer) currentPollInterval() time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.pollInterval <= 0 {
+		return conf.DefaultPollInterval
+	}
+	return d.pollInterval
+}
+
 func (d *DelegatorIndexer) Run(ctx context.Context) error {
 	d.logger.Info("starting delegator indexer")
 
 	if err := d.indexOnce(ctx); err != nil {
 		d.logger.Warn("initial indexing failed", "error", err)
 	}
+	if err := d.indexStakingOnce(ctx); err != nil {
+		d.logger.Warn("initial staking indexing failed", "error", err)
+	}
 
-	ticker := time.NewTicker(30 * time.Second)
+	ticker := time.NewTicker(d.currentPollInterval())
 	defer ticker.Stop()
 
 	for {
@@ -56,10 +126,15 @@ func (d *DelegatorIndexer) Run(ctx context.Context) error {
 		case <-ctx.Done():
 			d.logger.Info("indexer stopping due to context cancellation")
 			return ctx.Err()
+		case <-d.intervalChanged:
+			ticker.Reset(d.currentPollInterval())
 		case <-ticker.C:
 			if err := d.indexOnce(ctx); err != nil {
 				d.logger.Warn("indexing failed", "error", err)
 			}
+			if err := d.indexStakingOnce(ctx); err != nil {
+				d.logger.Warn("staking indexing failed", "error", err)
+			}
 		}
 	}
 }
@@ -74,7 +149,7 @@ func (d *DelegatorIndexer) indexOnce(ctx context.Context) error {
 	var data []domain.TzktApiDelegationsResponse
 	if count == 0 {
 		d.logger.Info("database is empty, fetching initial batch of recent delegations")
-		data, err = d.DelegationHandler.GetDelegationsFromLevel(0, 1000)
+		data, err = d.DelegationHandler.GetDelegationsFromLevel(ctx, 0, 1000)
 	} else {
 		lastLevel, err := d.repository.GetLastProcessedLevel(ctx)
 		if err != nil {
@@ -83,7 +158,7 @@ func (d *DelegatorIndexer) indexOnce(ctx context.Context) error {
 		}
 
 		d.logger.Info("fetching new delegations", "lastLevel", lastLevel)
-		data, err = d.DelegationHandler.GetDelegationsFromLevel(lastLevel, 100)
+		data, err = d.DelegationHandler.GetDelegationsFromLevel(ctx, lastLevel, 100)
 	}
 
 	if err != nil {
@@ -99,13 +174,48 @@ func (d *DelegatorIndexer) indexOnce(ctx context.Context) error {
 	return d.delegatorUseCase.Create(ctx, data)
 }
 
+// indexStakingOnce fetches the staking operations after the staking checkpoint,
+// it does nothing unless the staking ingestion is enabled.
+func (d *DelegatorIndexer) indexStakingOnce(ctx context.Context) error {
+	if d.stakingUseCase == nil || d.stakingRepository == nil {
+		return nil
+	}
+
+	lastLevel, err := d.stakingRepository.GetLastProcessedLevel(ctx)
+	if err != nil {
+		d.logger.Warn("failed to get last processed staking level", "error", err)
+		return err
+	}
+
+	limit := 100
+	if lastLevel == 0 {
+		d.logger.Info("no staking operation indexed yet, fetching initial batch of recent staking operations")
+		limit = 1000
+	}
+
+	data, err := d.DelegationHandler.GetStakingFromLevel(ctx, lastLevel, limit)
+	if err != nil {
+		return err
+	}
+
+	if len(data) == 0 {
+		d.logger.Info("no new staking operations found")
+		return nil
+	}
+
+	d.logger.Info("processing staking operations", "count", len(data))
+	return d.stakingUseCase.Create(ctx, data)
+}
+
 func (d *DelegatorIndexer) Shutdown(ctx context.Context) error {
 	d.logger.Info("shutting down delegator indexer")
 	return nil
 }
 
 func NewDelegatorIndexer(options ...Options) *DelegatorIndexer {
-	i := &DelegatorIndexer{}
+	i := &DelegatorIndexer{
+		intervalChanged: make(chan struct{}, 1),
+	}
 	for _, option := range options {
 		option(i)
 	}
@@ -2,6 +2,7 @@ package indexer
 
 import (
 	"context"
+	"delegator/conf"
 	"delegator/mocks"
 	"delegator/pkg/domain"
 	"errors"
@@ -64,7 +65,7 @@ func TestDelegatorIndexer_indexOnce_EmptyDatabase(t *testing.T) {
 	}
 
 	mockRepository.EXPECT().CountDelegations(ctx).Return(int64(0), nil).Once()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(int64(0), 1000).Return(testData, nil).Once()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, int64(0), 1000).Return(testData, nil).Once()
 	mockUseCase.EXPECT().Create(ctx, testData).Return(nil).Once()
 
 	err := indexer.indexOnce(ctx)
@@ -100,7 +101,7 @@ func TestDelegatorIndexer_indexOnce_ExistingData(t *testing.T) {
 
 	mockRepository.EXPECT().CountDelegations(ctx).Return(int64(5), nil).Once()
 	mockRepository.EXPECT().GetLastProcessedLevel(ctx).Return(int64(1000), nil).Once()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(int64(1000), 100).Return(testData, nil).Once()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, int64(1000), 100).Return(testData, nil).Once()
 	mockUseCase.EXPECT().Create(ctx, testData).Return(nil).Once()
 
 	err := indexer.indexOnce(ctx)
@@ -126,7 +127,7 @@ func TestDelegatorIndexer_indexOnce_NoNewData(t *testing.T) {
 
 	mockRepository.EXPECT().CountDelegations(ctx).Return(int64(5), nil).Once()
 	mockRepository.EXPECT().GetLastProcessedLevel(ctx).Return(int64(1000), nil).Once()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(int64(1000), 100).Return([]domain.TzktApiDelegationsResponse{}, nil).Once()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, int64(1000), 100).Return([]domain.TzktApiDelegationsResponse{}, nil).Once()
 
 	err := indexer.indexOnce(ctx)
 	assert.NoError(t, err)
@@ -203,7 +204,7 @@ func TestDelegatorIndexer_indexOnce_DelegationHandlerError(t *testing.T) {
 	expectedError := errors.New("delegation handler error")
 
 	mockRepository.EXPECT().CountDelegations(ctx).Return(int64(0), nil).Once()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(int64(0), 1000).Return(nil, expectedError).Once()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, int64(0), 1000).Return(nil, expectedError).Once()
 
 	err := indexer.indexOnce(ctx)
 	assert.Error(t, err)
@@ -236,7 +237,7 @@ func TestDelegatorIndexer_indexOnce_UseCaseError(t *testing.T) {
 	expectedError := errors.New("use case error")
 
 	mockRepository.EXPECT().CountDelegations(ctx).Return(int64(0), nil).Once()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(int64(0), 1000).Return(testData, nil).Once()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, int64(0), 1000).Return(testData, nil).Once()
 	mockUseCase.EXPECT().Create(ctx, testData).Return(expectedError).Once()
 
 	err := indexer.indexOnce(ctx)
@@ -244,6 +245,95 @@ func TestDelegatorIndexer_indexOnce_UseCaseError(t *testing.T) {
 	assert.Equal(t, expectedError, err)
 }
 
+func TestDelegatorIndexer_indexStakingOnce(t *testing.T) {
+	t.Parallel()
+
+	testData := []domain.TzktApiStakingResponse{
+		{
+			Type:   "staking",
+			Status: "applied",
+			Action: "stake",
+			Level:  1001,
+			Amount: 200000,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		enabled    bool
+		setupMocks func(*mocks.MockStakingUseCase, *mocks.MockStakingRepository, *mocks.MockDelegationService)
+		wantErr    bool
+	}{
+		{
+			name:       "Disabled",
+			enabled:    false,
+			setupMocks: func(*mocks.MockStakingUseCase, *mocks.MockStakingRepository, *mocks.MockDelegationService) {},
+		},
+		{
+			name:    "Initial_Batch",
+			enabled: true,
+			setupMocks: func(uc *mocks.MockStakingUseCase, repo *mocks.MockStakingRepository, handler *mocks.MockDelegationService) {
+				repo.EXPECT().GetLastProcessedLevel(mock.Anything).Return(int64(0), nil).Once()
+				handler.EXPECT().GetStakingFromLevel(mock.Anything, int64(0), 1000).Return(testData, nil).Once()
+				uc.EXPECT().Create(mock.Anything, testData).Return(nil).Once()
+			},
+		},
+		{
+			name:    "From_Checkpoint",
+			enabled: true,
+			setupMocks: func(uc *mocks.MockStakingUseCase, repo *mocks.MockStakingRepository, handler *mocks.MockDelegationService) {
+				repo.EXPECT().GetLastProcessedLevel(mock.Anything).Return(int64(1000), nil).Once()
+				handler.EXPECT().GetStakingFromLevel(mock.Anything, int64(1000), 100).Return(testData, nil).Once()
+				uc.EXPECT().Create(mock.Anything, testData).Return(nil).Once()
+			},
+		},
+		{
+			name:    "No_New_Data",
+			enabled: true,
+			setupMocks: func(uc *mocks.MockStakingUseCase, repo *mocks.MockStakingRepository, handler *mocks.MockDelegationService) {
+				repo.EXPECT().GetLastProcessedLevel(mock.Anything).Return(int64(1000), nil).Once()
+				handler.EXPECT().GetStakingFromLevel(mock.Anything, int64(1000), 100).Return(nil, nil).Once()
+			},
+		},
+		{
+			name:    "Handler_Error",
+			enabled: true,
+			setupMocks: func(uc *mocks.MockStakingUseCase, repo *mocks.MockStakingRepository, handler *mocks.MockDelegationService) {
+				repo.EXPECT().GetLastProcessedLevel(mock.Anything).Return(int64(1000), nil).Once()
+				handler.EXPECT().GetStakingFromLevel(mock.Anything, int64(1000), 100).Return(nil, errors.New("API error")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockStakingUseCase := mocks.NewMockStakingUseCase(t)
+			mockStakingRepository := mocks.NewMockStakingRepository(t)
+			mockDelegationHandler := mocks.NewMockDelegationService(t)
+			tt.setupMocks(mockStakingUseCase, mockStakingRepository, mockDelegationHandler)
+
+			opts := []Options{
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithDelegationHandler(mockDelegationHandler),
+			}
+			if tt.enabled {
+				opts = append(opts, WithStakingUseCase(mockStakingUseCase), WithStakingRepository(mockStakingRepository))
+			}
+			indexer := NewDelegatorIndexer(opts...)
+
+			err := indexer.indexStakingOnce(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestDelegatorIndexer_Shutdown(t *testing.T) {
 	t.Parallel()
 
@@ -276,7 +366,7 @@ func TestDelegatorIndexer_Run_CancellationContext(t *testing.T) {
 	defer cancel()
 
 	mockRepository.EXPECT().CountDelegations(mock.Anything).Return(int64(0), nil).Maybe()
-	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, mock.Anything).Return([]domain.TzktApiDelegationsResponse{}, nil).Maybe()
+	mockDelegationHandler.EXPECT().GetDelegationsFromLevel(mock.Anything, mock.Anything, mock.Anything).Return([]domain.TzktApiDelegationsResponse{}, nil).Maybe()
 
 	err := indexer.Run(ctx)
 	assert.Error(t, err)
@@ -326,3 +416,74 @@ func TestIndexerOptions(t *testing.T) {
 		assert.Equal(t, mockRepo, indexer.repository)
 	})
 }
+
+func TestDelegatorIndexer_SetPollInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		initial  time.Duration
+		interval time.Duration
+		expected time.Duration
+		notified bool
+	}{
+		{
+			name:     "Default_When_Unset",
+			initial:  0,
+			interval: 0,
+			expected: conf.DefaultPollInterval,
+			notified: false,
+		},
+		{
+			name:     "Update_Interval",
+			initial:  30 * time.Second,
+			interval: 5 * time.Second,
+			expected: 5 * time.Second,
+			notified: true,
+		},
+		{
+			name:     "Same_Interval_Not_Notified",
+			initial:  5 * time.Second,
+			interval: 5 * time.Second,
+			expected: 5 * time.Second,
+			notified: false,
+		},
+		{
+			name:     "Negative_Interval_Ignored",
+			initial:  5 * time.Second,
+			interval: -1,
+			expected: 5 * time.Second,
+			notified: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			indexer := NewDelegatorIndexer(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithPollInterval(tt.initial),
+			)
+
+			indexer.SetPollInterval(tt.interval)
+
+			assert.Equal(t, tt.expected, indexer.currentPollInterval())
+			assert.Equal(t, tt.notified, len(indexer.intervalChanged) == 1)
+		})
+	}
+}
+
+func TestDelegatorIndexer_Reload(t *testing.T) {
+	t.Parallel()
+
+	indexer := NewDelegatorIndexer(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+	)
+
+	cfg := &conf.DelegatorConfig{}
+	cfg.Indexer.PollInterval = 12
+	indexer.Reload(cfg)
+
+	assert.Equal(t, 12*time.Second, indexer.currentPollInterval())
+}
@@ -0,0 +1,123 @@
+package events
+
+import (
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"sync"
+)
+
+// DefaultBufferSize is the number of delegations queued for a subscriber before it
+// is considered too slow and dropped.
+const DefaultBufferSize = 256
+
+// subscriber is a channel with the filter its delegations are selected with.
+type subscriber struct {
+	filter domain.DelegationFilter
+	events chan models.Delegation
+}
+
+// Broker is an in-process publish/subscribe of the indexed delegations, it is safe
+// for concurrent use. Publish never blocks: a subscriber whose buffer is full is
+// dropped and its channel closed, it is expected to resume from the database.
+type Broker struct {
+	logger *slog.Logger
+
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type BrokerOptions func(*Broker)
+
+func BrokerWithLogger(logger *slog.Logger) BrokerOptions {
+	return func(b *Broker) {
+		b.logger = logger
+	}
+}
+
+// BrokerWithBufferSize sets the number of delegations queued per subscriber.
+func BrokerWithBufferSize(bufferSize int) BrokerOptions {
+	return func(b *Broker) {
+		if bufferSize > 0 {
+			b.bufferSize = bufferSize
+		}
+	}
+}
+
+// Publish sends the delegations to every subscriber they match.
+func (b *Broker) Publish(delegations []models.Delegation) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for sub := range b.subscribers {
+		if !sub.deliver(delegations) {
+			b.logger.Warn("dropping slow delegation subscriber", "buffer_size", b.bufferSize)
+			b.remove(sub)
+		}
+	}
+}
+
+// deliver queues the matching delegations, it reports false when the buffer is full.
+func (s *subscriber) deliver(delegations []models.Delegation) bool {
+	for _, delegation := range delegations {
+		if !s.filter.Matches(delegation) {
+			continue
+		}
+
+		select {
+		case s.events <- delegation:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// Subscribe registers a subscriber to the delegations matching filter.
+func (b *Broker) Subscribe(filter domain.DelegationFilter) (<-chan models.Delegation, func()) {
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan models.Delegation, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub.events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.remove(sub)
+	}
+}
+
+// Subscribers returns the number of active subscribers.
+func (b *Broker) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subscribers)
+}
+
+// remove unregisters sub and closes its channel, b.mu must be held.
+func (b *Broker) remove(sub *subscriber) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+func NewBroker(opts ...BrokerOptions) *Broker {
+	b := &Broker{
+		logger:      slog.Default(),
+		bufferSize:  DefaultBufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
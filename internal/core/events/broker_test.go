@@ -0,0 +1,96 @@
+package events
+
+import (
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker_Publish(t *testing.T) {
+	t.Parallel()
+
+	bakerA := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	bakerB := "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6"
+	delegations := []models.Delegation{
+		{Delegator: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT", BakerID: bakerA.String(), Level: 1},
+		{Delegator: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT", BakerID: bakerB, Level: 2},
+		{Delegator: "tz1gXp58RA6Gks75Furb6XgYNBFSZDCBwFrP", BakerID: bakerA.String(), Level: 3},
+	}
+
+	tests := []struct {
+		name           string
+		filter         domain.DelegationFilter
+		bufferSize     int
+		expectedLevels []int64
+		expectDropped  bool
+	}{
+		{
+			name:           "All",
+			bufferSize:     10,
+			expectedLevels: []int64{1, 2, 3},
+		},
+		{
+			name:           "Baker_Filter",
+			filter:         domain.DelegationFilter{Baker: &bakerA},
+			bufferSize:     10,
+			expectedLevels: []int64{1, 3},
+		},
+		{
+			name:           "Slow_Subscriber_Dropped",
+			bufferSize:     2,
+			expectedLevels: []int64{1, 2},
+			expectDropped:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			broker := NewBroker(
+				BrokerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				BrokerWithBufferSize(tt.bufferSize),
+			)
+			events, unsubscribe := broker.Subscribe(tt.filter)
+			defer unsubscribe()
+
+			broker.Publish(delegations)
+
+			var levels []int64
+			for len(events) > 0 {
+				levels = append(levels, (<-events).Level)
+			}
+			assert.Equal(t, tt.expectedLevels, levels)
+
+			if tt.expectDropped {
+				_, ok := <-events
+				assert.False(t, ok)
+				assert.Zero(t, broker.Subscribers())
+				return
+			}
+			assert.Equal(t, 1, broker.Subscribers())
+		})
+	}
+}
+
+func TestBroker_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	broker := NewBroker(BrokerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
+	events, unsubscribe := broker.Subscribe(domain.DelegationFilter{})
+	assert.Equal(t, 1, broker.Subscribers())
+
+	unsubscribe()
+	unsubscribe()
+
+	_, ok := <-events
+	assert.False(t, ok)
+	assert.Zero(t, broker.Subscribers())
+	assert.NotPanics(t, func() {
+		broker.Publish([]models.Delegation{{Level: 1}})
+	})
+}
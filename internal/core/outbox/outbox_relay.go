@@ -0,0 +1,150 @@
+package outbox
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+const (
+	// DefaultRelayInterval is the delay between two relay rounds when none is configured.
+	DefaultRelayInterval = time.Second
+	// DefaultBatchSize is the number of events published at once when none is configured.
+	DefaultBatchSize = 100
+)
+
+// Relay publishes the outbox events to a sink in the order they were recorded.
+// An event is marked published once the sink acknowledged it, so it is
+// published again when the relay stops in between: delivery is at least once.
+// A single relay is expected to run against the database.
+type Relay struct {
+	logger *slog.Logger
+
+	repository domain.OutboxRepository
+	sink       domain.EventSink
+	interval   time.Duration
+	batchSize  int
+	now        func() time.Time
+}
+
+type RelayOptions func(*Relay)
+
+func RelayWithLogger(logger *slog.Logger) RelayOptions {
+	return func(r *Relay) {
+		r.logger = logger
+	}
+}
+
+func RelayWithRepository(repository domain.OutboxRepository) RelayOptions {
+	return func(r *Relay) {
+		r.repository = repository
+	}
+}
+
+func RelayWithSink(sink domain.EventSink) RelayOptions {
+	return func(r *Relay) {
+		r.sink = sink
+	}
+}
+
+// RelayWithInterval sets the delay between two relay rounds.
+func RelayWithInterval(interval time.Duration) RelayOptions {
+	return func(r *Relay) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// RelayWithBatchSize sets the number of events published at once.
+func RelayWithBatchSize(batchSize int) RelayOptions {
+	return func(r *Relay) {
+		if batchSize > 0 {
+			r.batchSize = batchSize
+		}
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) error {
+	r.logger.Info("starting outbox relay", "interval", r.interval, "batch_size", r.batchSize)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("outbox relay stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := r.PublishPending(ctx); err != nil {
+				r.logger.Warn("outbox relay round failed", "error", err)
+			}
+		}
+	}
+}
+
+func (r *Relay) Shutdown(ctx context.Context) error {
+	r.logger.Info("shutting down outbox relay")
+	return r.sink.Close()
+}
+
+// PublishPending publishes the pending events batch after batch, until none is
+// left. A failed batch is retried from its first event on the next call.
+func (r *Relay) PublishPending(ctx context.Context) error {
+	for {
+		events, err := r.repository.FindPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		if err := r.sink.Publish(ctx, toMessages(events)); err != nil {
+			return err
+		}
+
+		ids := make([]int64, len(events))
+		for i, event := range events {
+			ids[i] = event.ID
+		}
+		if err := r.repository.MarkPublished(ctx, ids, r.now()); err != nil {
+			return err
+		}
+
+		r.logger.Info("published outbox events", "count", len(events), "last_id", ids[len(ids)-1])
+		if len(events) < r.batchSize {
+			return nil
+		}
+	}
+}
+
+func toMessages(events []models.OutboxEvent) []domain.OutboxMessage {
+	messages := make([]domain.OutboxMessage, len(events))
+	for i, event := range events {
+		messages[i] = domain.OutboxMessage{
+			ID:        event.ID,
+			Event:     event.Event,
+			Key:       event.Key,
+			Payload:   []byte(event.Payload),
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return messages
+}
+
+func NewRelay(opts ...RelayOptions) *Relay {
+	r := &Relay{
+		interval:  DefaultRelayInterval,
+		batchSize: DefaultBatchSize,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
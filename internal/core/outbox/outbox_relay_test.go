@@ -0,0 +1,146 @@
+package outbox
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/internal/services"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRelay_PublishPending(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	events := []models.OutboxEvent{
+		{ID: 1, Event: "delegation.new", Key: "tz1a", Payload: `{"n":1}`, CreatedAt: now},
+		{ID: 2, Event: "delegation.redelegation", Key: "tz1b", Payload: `{"n":2}`, CreatedAt: now},
+		{ID: 3, Event: "delegation.undelegation", Key: "tz1a", Payload: `{"n":3}`, CreatedAt: now},
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*mocks.MockOutboxRepository)
+		expectedIDs []int64
+		wantErr     bool
+	}{
+		{
+			name: "Batches_Until_Drained",
+			setupMocks: func(repo *mocks.MockOutboxRepository) {
+				repo.EXPECT().FindPending(mock.Anything, 2).Return(events[:2], nil).Once()
+				repo.EXPECT().MarkPublished(mock.Anything, []int64{1, 2}, now).Return(nil).Once()
+				repo.EXPECT().FindPending(mock.Anything, 2).Return(events[2:], nil).Once()
+				repo.EXPECT().MarkPublished(mock.Anything, []int64{3}, now).Return(nil).Once()
+			},
+			expectedIDs: []int64{1, 2, 3},
+		},
+		{
+			name: "Nothing_Pending",
+			setupMocks: func(repo *mocks.MockOutboxRepository) {
+				repo.EXPECT().FindPending(mock.Anything, 2).Return(nil, nil).Once()
+			},
+		},
+		{
+			// The batch stays pending, it is published again on the next round.
+			name: "Mark_Error",
+			setupMocks: func(repo *mocks.MockOutboxRepository) {
+				repo.EXPECT().FindPending(mock.Anything, 2).Return(events[:2], nil).Once()
+				repo.EXPECT().MarkPublished(mock.Anything, []int64{1, 2}, now).Return(errors.New("db down")).Once()
+			},
+			expectedIDs: []int64{1, 2},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockOutboxRepository(t)
+			tt.setupMocks(mockRepo)
+			sink := services.NewMemorySink()
+
+			relay := NewRelay(
+				RelayWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				RelayWithRepository(mockRepo),
+				RelayWithSink(sink),
+				RelayWithBatchSize(2),
+			)
+			relay.now = func() time.Time { return now }
+
+			err := relay.PublishPending(context.Background())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			var ids []int64
+			for _, message := range sink.Messages() {
+				ids = append(ids, message.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestRelay_PublishPending_SinkError(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := mocks.NewMockOutboxRepository(t)
+	mockSink := mocks.NewMockEventSink(t)
+	mockRepo.EXPECT().FindPending(mock.Anything, DefaultBatchSize).Return([]models.OutboxEvent{{ID: 1, Event: "delegation.new", Key: "tz1a", Payload: "{}"}}, nil).Once()
+	mockSink.EXPECT().Publish(mock.Anything, []domain.OutboxMessage{{ID: 1, Event: "delegation.new", Key: "tz1a", Payload: []byte("{}")}}).
+		Return(errors.New("bus down")).Once()
+
+	relay := NewRelay(
+		RelayWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		RelayWithRepository(mockRepo),
+		RelayWithSink(mockSink),
+	)
+
+	// Nothing is marked published when the sink fails.
+	assert.Error(t, relay.PublishPending(context.Background()))
+}
+
+func TestRelay_Run(t *testing.T) {
+	t.Parallel()
+
+	mockRepo := mocks.NewMockOutboxRepository(t)
+	mockSink := mocks.NewMockEventSink(t)
+	found := make(chan struct{}, 2)
+	mockRepo.EXPECT().FindPending(mock.Anything, DefaultBatchSize).RunAndReturn(func(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+		found <- struct{}{}
+		return nil, errors.New("db down")
+	}).Times(2)
+	mockSink.EXPECT().Close().Return(nil).Once()
+
+	relay := NewRelay(
+		RelayWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		RelayWithRepository(mockRepo),
+		RelayWithSink(mockSink),
+		RelayWithInterval(10*time.Millisecond),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- relay.Run(ctx)
+	}()
+
+	// A failed round is logged and retried on the next tick.
+	<-found
+	<-found
+	cancel()
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.NoError(t, relay.Shutdown(context.Background()))
+}
@@ -0,0 +1,66 @@
+package outbox
+
+import (
+	"context"
+	"delegator/internal/models"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+// FindPending returns the oldest events that were not published yet, in ID order.
+func (r *Repository) FindPending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	var res []models.OutboxEvent
+	err := r.dbClient.WithContext(ctx).
+		Where("published_at IS NULL").
+		Order("id").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding pending outbox events", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) MarkPublished(ctx context.Context, ids []int64, publishedAt time.Time) error {
+	err := r.dbClient.WithContext(ctx).
+		Model(&models.OutboxEvent{}).
+		Where("id IN ?", ids).
+		Update("published_at", publishedAt).Error
+	if err != nil {
+		r.logger.Warn("error marking outbox events published", "error", err, "count", len(ids))
+		return err
+	}
+	return nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
@@ -0,0 +1,131 @@
+package staking
+
+import (
+	"context"
+	"database/sql"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+// Create stores the staking operations, operations already stored are left untouched.
+func (r *Repository) Create(ctx context.Context, operations []models.StakingOperation) error {
+	r.logger.Info("create staking operations", slog.Int("count", len(operations)))
+	if len(operations) == 0 {
+		return nil
+	}
+
+	err := r.dbClient.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "operation_id"}},
+			DoNothing: true,
+		}).
+		Create(&operations).Error
+	if err != nil {
+		r.logger.Warn("error while creating staking operations", "error", err)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) FindAll(ctx context.Context, filter domain.StakingFilter) ([]models.StakingOperation, error) {
+	r.logger.Info("staking repository FindAll")
+	query := r.dbClient.WithContext(ctx).Model(&models.StakingOperation{})
+	if filter.Staker != nil {
+		query = query.Where("staker = ?", filter.Staker.String())
+	}
+	if filter.Baker != nil {
+		query = query.Where("baker = ?", filter.Baker.String())
+	}
+	if filter.Action != nil {
+		query = query.Where("action = ?", string(*filter.Action))
+	}
+
+	var res []models.StakingOperation
+	if err := query.Order("level DESC").Find(&res).Error; err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// stakersQuery nets the stake and unstake operations of every staker with every
+// baker, stakers that unstaked everything are left out.
+const stakersQuery = `
+SELECT
+	staker,
+	baker,
+	SUM(CASE WHEN action = 'stake' THEN amount WHEN action = 'unstake' THEN -amount ELSE 0 END) AS staked_amount,
+	MIN(timestamp) AS first_staked,
+	MAX(timestamp) AS last_updated
+FROM staking_operations
+WHERE (@staker = '' OR staker = @staker) AND (@baker = '' OR baker = @baker)
+GROUP BY staker, baker
+HAVING SUM(CASE WHEN action = 'stake' THEN amount WHEN action = 'unstake' THEN -amount ELSE 0 END) > 0
+ORDER BY staked_amount DESC, staker`
+
+func (r *Repository) FindStakers(ctx context.Context, filter domain.StakingFilter) ([]models.StakerStats, error) {
+	r.logger.Info("staking repository FindStakers")
+	staker, baker := "", ""
+	if filter.Staker != nil {
+		staker = filter.Staker.String()
+	}
+	if filter.Baker != nil {
+		baker = filter.Baker.String()
+	}
+
+	var res []models.StakerStats
+	err := r.dbClient.WithContext(ctx).
+		Raw(stakersQuery, sql.Named("staker", staker), sql.Named("baker", baker)).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding stakers", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetLastProcessedLevel returns the staking checkpoint, independent from the delegations one.
+func (r *Repository) GetLastProcessedLevel(ctx context.Context) (int64, error) {
+	var maxLevel int64
+	err := r.dbClient.WithContext(ctx).Model(&models.StakingOperation{}).
+		Select("COALESCE(MAX(level), 0)").
+		Scan(&maxLevel).Error
+	if err != nil {
+		r.logger.Warn("error getting last processed staking level", "error", err)
+		return 0, err
+	}
+	r.logger.Info("last processed staking level", "level", maxLevel)
+	return maxLevel, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
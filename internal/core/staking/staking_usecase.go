@@ -0,0 +1,175 @@
+package staking
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// UseCaseImpl represent the use case implementation of the staking operations.
+type UseCaseImpl struct {
+	logger     *slog.Logger
+	repository domain.StakingRepository
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.StakingRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// Create will store the applied staking operations of data.
+func (uc *UseCaseImpl) Create(ctx context.Context, data []domain.TzktApiStakingResponse) error {
+	uc.logger.Info("processing staking operations", "total", len(data))
+	operations := make([]models.StakingOperation, 0, len(data))
+
+	for _, apiResponse := range data {
+		if apiResponse.Type != "staking" || apiResponse.Status != string(domain.StatusApplied) {
+			uc.logger.Info("skipping staking operation", "reason", "wrong type or status", "type", apiResponse.Type, "status", apiResponse.Status)
+			continue
+		}
+
+		action, err := domain.ParseStakingAction(apiResponse.Action)
+		if err != nil {
+			uc.logger.Warn("unknown staking action", "action", apiResponse.Action, "id", apiResponse.ID)
+			continue
+		}
+
+		timestamp, err := time.Parse("2006-01-02T15:04:05Z", apiResponse.Timestamp)
+		if err != nil {
+			uc.logger.Warn("failed to parse timestamp", "timestamp", apiResponse.Timestamp, "error", err)
+			continue
+		}
+
+		if apiResponse.Sender == nil || apiResponse.Baker == nil {
+			uc.logger.Warn("missing staker or baker", "id", apiResponse.ID)
+			continue
+		}
+
+		if _, err := domain.ParseAddress(apiResponse.Sender.Address); err != nil {
+			uc.logger.Warn("invalid staker address", "staker", apiResponse.Sender.Address, "error", err)
+			continue
+		}
+
+		baker, err := domain.ParseAddress(apiResponse.Baker.Address)
+		if err != nil || !baker.IsImplicit() {
+			uc.logger.Warn("invalid baker address", "baker", apiResponse.Baker.Address)
+			continue
+		}
+
+		operations = append(operations, models.StakingOperation{
+			OperationID:         apiResponse.ID,
+			OperationHash:       apiResponse.Hash,
+			Block:               apiResponse.Block,
+			Level:               apiResponse.Level,
+			Timestamp:           timestamp,
+			Action:              string(action),
+			Staker:              apiResponse.Sender.Address,
+			StakerAlias:         accountAlias(apiResponse.Sender),
+			Baker:               apiResponse.Baker.Address,
+			BakerAlias:          accountAlias(apiResponse.Baker),
+			RequestedAmount:     apiResponse.RequestedAmount,
+			Amount:              apiResponse.Amount,
+			BakerFee:            apiResponse.BakerFee,
+			GasUsed:             apiResponse.GasUsed,
+			StakingUpdatesCount: apiResponse.StakingUpdatesCount,
+			IndexedAt:           time.Now(),
+		})
+	}
+
+	if len(operations) == 0 {
+		uc.logger.Info("no valid staking operations to create")
+		return nil
+	}
+
+	return uc.repository.Create(ctx, operations)
+}
+
+// GetStaking return the staking operations matching the filter.
+func (uc *UseCaseImpl) GetStaking(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakingResponseType], error) {
+	operations, err := uc.repository.FindAll(ctx, filter)
+	if err != nil {
+		return domain.ApiResponse[domain.StakingResponseType]{}, err
+	}
+
+	unit := opts.Unit()
+	res := make([]domain.StakingResponseType, len(operations))
+	for i, operation := range operations {
+		res[i] = domain.StakingResponseType{
+			OperationID:     operation.OperationID,
+			OperationHash:   operation.OperationHash,
+			Block:           operation.Block,
+			Level:           operation.Level,
+			Timestamp:       operation.Timestamp,
+			Action:          domain.StakingAction(operation.Action),
+			Staker:          domain.Address(operation.Staker),
+			StakerAlias:     operation.StakerAlias,
+			Baker:           domain.Address(operation.Baker),
+			BakerAlias:      operation.BakerAlias,
+			RequestedAmount: domain.NewAmount(operation.RequestedAmount, unit),
+			Amount:          domain.NewAmount(operation.Amount, unit),
+			BakerFee:        domain.NewAmount(operation.BakerFee, unit),
+		}
+	}
+
+	return domain.ApiResponse[domain.StakingResponseType]{
+		Data:  res,
+		Units: unit,
+	}, nil
+}
+
+// GetStakers return the stakers with a positive net stake matching the filter.
+func (uc *UseCaseImpl) GetStakers(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakerResponseType], error) {
+	stakers, err := uc.repository.FindStakers(ctx, filter)
+	if err != nil {
+		return domain.ApiResponse[domain.StakerResponseType]{}, err
+	}
+
+	unit := opts.Unit()
+	res := make([]domain.StakerResponseType, len(stakers))
+	for i, staker := range stakers {
+		res[i] = domain.StakerResponseType{
+			Staker:       domain.Address(staker.Staker),
+			Baker:        domain.Address(staker.Baker),
+			StakedAmount: domain.NewAmount(staker.StakedAmount, unit),
+			FirstStaked:  staker.FirstStaked,
+			LastUpdated:  staker.LastUpdated,
+		}
+	}
+
+	return domain.ApiResponse[domain.StakerResponseType]{
+		Data:  res,
+		Units: unit,
+	}, nil
+}
+
+// accountAlias return the TzKT alias of account, or nil when it has none.
+func accountAlias(account *domain.Account) *string {
+	if account == nil || account.Alias == "" {
+		return nil
+	}
+	return &account.Alias
+}
+
+// NewUseCase create a new use case for the staking operations.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
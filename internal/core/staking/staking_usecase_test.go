@@ -0,0 +1,263 @@
+package staking
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewUseCase(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	mockRepo := mocks.NewMockStakingRepository(t)
+
+	uc := NewUseCase(
+		UseCaseWithLogger(logger),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	assert.NotNil(t, uc)
+	assert.Equal(t, logger, uc.logger)
+	assert.Equal(t, mockRepo, uc.repository)
+}
+
+func TestUseCaseImpl_Create(t *testing.T) {
+	t.Parallel()
+
+	stake := domain.TzktApiStakingResponse{
+		Type:            "staking",
+		Status:          "applied",
+		ID:              42,
+		Timestamp:       "2024-06-01T12:00:00Z",
+		Level:           5001,
+		Block:           "BLockHash123",
+		Hash:            "ophash123",
+		Action:          "stake",
+		RequestedAmount: 1000000,
+		Amount:          1000000,
+		BakerFee:        500,
+		Sender:          &domain.Account{Address: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT", Alias: "Alice"},
+		Baker:           &domain.Account{Address: "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"},
+	}
+
+	withAction := func(action string) domain.TzktApiStakingResponse {
+		op := stake
+		op.Action = action
+		return op
+	}
+	withStatus := func(status string) domain.TzktApiStakingResponse {
+		op := stake
+		op.Status = status
+		return op
+	}
+	withBaker := func(address string) domain.TzktApiStakingResponse {
+		op := stake
+		op.Baker = &domain.Account{Address: address}
+		return op
+	}
+
+	tests := []struct {
+		name       string
+		data       []domain.TzktApiStakingResponse
+		setupMocks func(*mocks.MockStakingRepository)
+		wantErr    bool
+	}{
+		{
+			name: "Valid_Stake",
+			data: []domain.TzktApiStakingResponse{stake},
+			setupMocks: func(repo *mocks.MockStakingRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(ops []models.StakingOperation) bool {
+					return len(ops) == 1 &&
+						ops[0].OperationID == 42 &&
+						ops[0].Action == "stake" &&
+						ops[0].Staker == "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT" &&
+						*ops[0].StakerAlias == "Alice" &&
+						ops[0].BakerAlias == nil &&
+						ops[0].Amount == 1000000 &&
+						ops[0].BakerFee == 500
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name: "Skips_Invalid_Operations",
+			data: []domain.TzktApiStakingResponse{
+				withAction("restake"),
+				withStatus("failed"),
+				withBaker("KT1Hkg5qeNhfwpKW4fXvq7HGZB9z2EnmCCA9"),
+				withAction("unstake"),
+			},
+			setupMocks: func(repo *mocks.MockStakingRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.MatchedBy(func(ops []models.StakingOperation) bool {
+					return len(ops) == 1 && ops[0].Action == "unstake"
+				})).Return(nil).Once()
+			},
+		},
+		{
+			name:       "Nothing_To_Create",
+			data:       []domain.TzktApiStakingResponse{withStatus("backtracked")},
+			setupMocks: func(repo *mocks.MockStakingRepository) {},
+		},
+		{
+			name: "Repository_Error",
+			data: []domain.TzktApiStakingResponse{stake},
+			setupMocks: func(repo *mocks.MockStakingRepository) {
+				repo.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("insert failed")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockStakingRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			err := uc.Create(context.Background(), tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetStaking(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	action := domain.StakingActionStake
+	filter := domain.StakingFilter{Action: &action}
+
+	mockRepo := mocks.NewMockStakingRepository(t)
+	mockRepo.EXPECT().FindAll(mock.Anything, filter).Return([]models.StakingOperation{
+		{
+			OperationID:     42,
+			OperationHash:   "ophash123",
+			Block:           "BLockHash123",
+			Level:           5001,
+			Timestamp:       timestamp,
+			Action:          "stake",
+			Staker:          "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+			Baker:           "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+			RequestedAmount: 1500000,
+			Amount:          1500000,
+			BakerFee:        500,
+		},
+	}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	res, err := uc.GetStaking(context.Background(), filter, domain.ResponseOptions{Units: domain.UnitTez})
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ApiResponse[domain.StakingResponseType]{
+		Data: []domain.StakingResponseType{
+			{
+				OperationID:     42,
+				OperationHash:   "ophash123",
+				Block:           "BLockHash123",
+				Level:           5001,
+				Timestamp:       timestamp,
+				Action:          domain.StakingActionStake,
+				Staker:          "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+				Baker:           "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj",
+				RequestedAmount: domain.NewAmount(1500000, domain.UnitTez),
+				Amount:          domain.NewAmount(1500000, domain.UnitTez),
+				BakerFee:        domain.NewAmount(500, domain.UnitTez),
+			},
+		},
+		Units: domain.UnitTez,
+	}, res)
+}
+
+func TestUseCaseImpl_GetStakers(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	timestamp := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockStakingRepository)
+		expectedResult domain.ApiResponse[domain.StakerResponseType]
+		wantErr        bool
+	}{
+		{
+			name: "Success",
+			setupMocks: func(repo *mocks.MockStakingRepository) {
+				repo.EXPECT().FindStakers(mock.Anything, domain.StakingFilter{Baker: &baker}).Return([]models.StakerStats{
+					{
+						Staker:       "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+						Baker:        baker.String(),
+						StakedAmount: 700000,
+						FirstStaked:  timestamp,
+						LastUpdated:  timestamp,
+					},
+				}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.StakerResponseType]{
+				Data: []domain.StakerResponseType{
+					{
+						Staker:       "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT",
+						Baker:        baker,
+						StakedAmount: domain.NewAmount(700000, domain.UnitMutez),
+						FirstStaked:  timestamp,
+						LastUpdated:  timestamp,
+					},
+				},
+				Units: domain.UnitMutez,
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockStakingRepository) {
+				repo.EXPECT().FindStakers(mock.Anything, domain.StakingFilter{Baker: &baker}).Return(nil, errors.New("query failed")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockStakingRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+
+			res, err := uc.GetStakers(context.Background(), domain.StakingFilter{Baker: &baker}, domain.ResponseOptions{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package stats
+
+import (
+	"context"
+	"database/sql"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"fmt"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+// metricQueries selects, for every metric, the aggregated value and the delegations
+// it is computed from. Self-delegations register a baker and are never counted.
+var metricQueries = map[domain.StatsMetric]struct {
+	value string
+	where string
+}{
+	domain.MetricCount: {
+		value: "COUNT(*)",
+		where: "baker_id <> 'UNDELEGATED' AND NOT is_self_delegation AND (@baker = '' OR baker_id = @baker)",
+	},
+	domain.MetricVolume: {
+		value: "SUM(amount)",
+		where: "baker_id <> 'UNDELEGATED' AND NOT is_self_delegation AND (@baker = '' OR baker_id = @baker)",
+	},
+	domain.MetricNewDelegators: {
+		value: "COUNT(*)",
+		where: "is_new_delegation AND (@baker = '' OR baker_id = @baker)",
+	},
+	domain.MetricUndelegations: {
+		value: "COUNT(*)",
+		where: "baker_id = 'UNDELEGATED' AND (@baker = '' OR previous_baker = @baker)",
+	},
+}
+
+// timeseriesQuery buckets the delegations by DATE(timestamp), so the range is served
+// by idx_delegations_date, and fills the buckets without delegations with zero.
+const timeseriesQuery = `
+WITH buckets AS (
+	SELECT generate_series(
+		date_trunc(@interval, @from::date::timestamp),
+		date_trunc(@interval, @to::date::timestamp),
+		('1 ' || @interval)::interval
+	) AS bucket
+),
+points AS (
+	SELECT date_trunc(@interval, DATE(timestamp)::timestamp) AS bucket, %s AS value
+	FROM delegations
+	WHERE DATE(timestamp) >= date_trunc(@interval, @from::date::timestamp)::date
+		AND DATE(timestamp) <= @to::date
+		AND %s
+	GROUP BY 1
+)
+SELECT b.bucket, COALESCE(p.value, 0) AS value
+FROM buckets b
+LEFT JOIN points p ON p.bucket = b.bucket
+ORDER BY b.bucket`
+
+// FindTimeseries returns one point per bucket between filter.From and filter.To,
+// which must be set.
+func (r *Repository) FindTimeseries(ctx context.Context, filter domain.TimeseriesFilter) ([]models.TimeseriesPoint, error) {
+	metric, ok := metricQueries[filter.Metric]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidMetric, filter.Metric)
+	}
+
+	baker := ""
+	if filter.Baker != nil {
+		baker = filter.Baker.String()
+	}
+
+	var res []models.TimeseriesPoint
+	err := r.dbClient.WithContext(ctx).
+		Raw(fmt.Sprintf(timeseriesQuery, metric.value, metric.where),
+			sql.Named("interval", string(filter.Interval)),
+			sql.Named("from", *filter.From),
+			sql.Named("to", *filter.To),
+			sql.Named("baker", baker),
+		).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding timeseries", "error", err, "metric", filter.Metric, "interval", filter.Interval)
+		return nil, err
+	}
+	return res, nil
+}
+
+// flowsQuery groups the delegations of the period by previous and new baker. Bakers
+// registering or deactivating themselves are not delegators moving and are skipped.
+const flowsQuery = `
+SELECT
+	previous_baker AS from_baker,
+	NULLIF(baker_id, 'UNDELEGATED') AS to_baker,
+	COUNT(*) AS count,
+	SUM(amount) AS amount
+FROM delegations
+WHERE timestamp >= @from AND timestamp <= @to
+	AND NOT is_self_delegation
+	AND previous_baker IS DISTINCT FROM delegator
+	AND previous_baker IS DISTINCT FROM NULLIF(baker_id, 'UNDELEGATED')
+GROUP BY 1, 2
+ORDER BY amount DESC, count DESC`
+
+// FindFlows returns the baker to baker matrix between filter.From and filter.To,
+// which must be set.
+func (r *Repository) FindFlows(ctx context.Context, filter domain.PeriodFilter) ([]models.BakerFlow, error) {
+	var res []models.BakerFlow
+	err := r.dbClient.WithContext(ctx).
+		Raw(flowsQuery, sql.Named("from", *filter.From), sql.Named("to", *filter.To)).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding flows", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindLargestDelegations returns the largest delegations to a baker of the period,
+// read in idx_delegations_amount order.
+func (r *Repository) FindLargestDelegations(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error) {
+	var res []models.Delegation
+	err := r.dbClient.WithContext(ctx).
+		Where("timestamp >= ? AND timestamp <= ?", *filter.From, *filter.To).
+		Where("baker_id <> ? AND NOT is_self_delegation", domain.UndelegatedBaker).
+		Order("amount DESC").
+		Limit(filter.Limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding largest delegations", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindWhaleMovements returns the redelegations and undelegations of the period of
+// at least filter.MinAmount, the largest first. Bakers deactivating themselves are
+// not delegators moving and are skipped.
+func (r *Repository) FindWhaleMovements(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error) {
+	var res []models.Delegation
+	err := r.dbClient.WithContext(ctx).
+		Where("amount >= ?", int64(*filter.MinAmount)).
+		Where("timestamp >= ? AND timestamp <= ?", *filter.From, *filter.To).
+		Where("previous_baker IS NOT NULL AND previous_baker <> delegator AND previous_baker <> baker_id").
+		Order("amount DESC").
+		Limit(filter.Limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding whale movements", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// concentrationQuery computes, over the current delegators of the largest bakers,
+// the sum of their squared shares and the share of the 10 largest.
+const concentrationQuery = `
+WITH current_delegations AS (
+	SELECT DISTINCT ON (delegator) delegator, baker_id, amount, is_self_delegation
+	FROM delegations
+	ORDER BY delegator, level DESC, id DESC
+),
+ranked AS (
+	SELECT
+		baker_id,
+		amount,
+		ROW_NUMBER() OVER (PARTITION BY baker_id ORDER BY amount DESC) AS rank,
+		SUM(amount) OVER (PARTITION BY baker_id) AS total
+	FROM current_delegations
+	WHERE baker_id <> 'UNDELEGATED' AND NOT is_self_delegation
+)
+SELECT
+	baker_id AS baker,
+	COUNT(*) AS delegators,
+	MAX(total) AS delegated_amount,
+	COALESCE(SUM(POWER(amount::float8 / NULLIF(total, 0), 2)), 0) AS herfindahl_index,
+	COALESCE(SUM(amount) FILTER (WHERE rank <= 10)::float8 / NULLIF(MAX(total), 0), 0) AS top10_share
+FROM ranked
+GROUP BY baker_id
+ORDER BY delegated_amount DESC, baker_id
+LIMIT @limit`
+
+func (r *Repository) FindConcentration(ctx context.Context, limit int) ([]models.BakerConcentration, error) {
+	var res []models.BakerConcentration
+	err := r.dbClient.WithContext(ctx).
+		Raw(concentrationQuery, sql.Named("limit", limit)).
+		Scan(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding concentration", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) SaveWhaleReport(ctx context.Context, report models.WhaleReport) error {
+	if err := r.dbClient.WithContext(ctx).Create(&report).Error; err != nil {
+		r.logger.Warn("error saving whale report", "error", err)
+		return err
+	}
+	return nil
+}
+
+// FindWhaleReports returns the latest stored whale reports, the most recent first.
+func (r *Repository) FindWhaleReports(ctx context.Context, limit int) ([]models.WhaleReport, error) {
+	var res []models.WhaleReport
+	err := r.dbClient.WithContext(ctx).
+		Order("generated_at DESC").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding whale reports", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
@@ -0,0 +1,376 @@
+package stats
+
+import (
+	"cmp"
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTimeseriesBuckets is the number of buckets returned when no range is requested.
+	DefaultTimeseriesBuckets = 30
+	// MaxTimeseriesBuckets caps the number of buckets of a time series, a year of days.
+	MaxTimeseriesBuckets = 366
+	// DefaultPeriod is the period flows are computed over when no range is requested.
+	DefaultPeriod = 30 * 24 * time.Hour
+	// TopBakers is the number of gainers and losers reported.
+	TopBakers = 10
+	// DefaultWhaleLimit is the number of delegations and movements listed by default.
+	DefaultWhaleLimit = 20
+	// DefaultWhaleMinAmount is the smallest whale movement by default, 100k tez.
+	DefaultWhaleMinAmount domain.Mutez = 100_000 * domain.MutezPerTez
+	// ConcentrationBakers is the number of bakers, the largest first, the concentration is reported for.
+	ConcentrationBakers = 100
+	// ConcentrationTTL is how long the concentration, computed over every current
+	// delegation, is reused between whale reports.
+	ConcentrationTTL = 5 * time.Minute
+)
+
+// UseCaseImpl represent the use case implementation of the statistics.
+type UseCaseImpl struct {
+	logger     *slog.Logger
+	repository domain.StatsRepository
+	now        func() time.Time
+
+	concentrationMu sync.Mutex
+	concentration   []models.BakerConcentration
+	concentrationAt time.Time
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.StatsRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// GetTimeseries return the metric aggregated per interval. Without a range, the
+// last DefaultTimeseriesBuckets buckets up to now are returned.
+func (uc *UseCaseImpl) GetTimeseries(ctx context.Context, filter domain.TimeseriesFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.TimeseriesPointResponseType], error) {
+	if filter.To == nil {
+		to := uc.now()
+		filter.To = &to
+	}
+	if filter.From == nil {
+		from := filter.Interval.AddTo(filter.Interval.Truncate(*filter.To), 1-DefaultTimeseriesBuckets)
+		filter.From = &from
+	}
+	if buckets := filter.Interval.Buckets(*filter.From, *filter.To); buckets > MaxTimeseriesBuckets {
+		return domain.ApiResponse[domain.TimeseriesPointResponseType]{}, fmt.Errorf("%w: %d %s buckets, at most %d", domain.ErrRangeTooLarge, buckets, filter.Interval, MaxTimeseriesBuckets)
+	}
+
+	points, err := uc.repository.FindTimeseries(ctx, filter)
+	if err != nil {
+		return domain.ApiResponse[domain.TimeseriesPointResponseType]{}, err
+	}
+
+	res := make([]domain.TimeseriesPointResponseType, len(points))
+	for i, point := range points {
+		res[i] = domain.TimeseriesPointResponseType{Timestamp: point.Bucket}
+		if filter.Metric == domain.MetricVolume {
+			amount := domain.NewAmount(point.Value, opts.Unit())
+			res[i].Amount = &amount
+		} else {
+			value := point.Value
+			res[i].Value = &value
+		}
+	}
+
+	response := domain.ApiResponse[domain.TimeseriesPointResponseType]{Data: res}
+	if filter.Metric == domain.MetricVolume {
+		response.Units = opts.Unit()
+	}
+	return response, nil
+}
+
+// GetFlows return the delegators moving between bakers during the period, with the
+// net flow of every baker. Without a range, the last DefaultPeriod up to now is used.
+func (uc *UseCaseImpl) GetFlows(ctx context.Context, filter domain.PeriodFilter, opts domain.ResponseOptions) (domain.FlowsResponseType, error) {
+	filter = uc.defaultPeriod(filter)
+
+	flows, err := uc.repository.FindFlows(ctx, filter)
+	if err != nil {
+		return domain.FlowsResponseType{}, err
+	}
+
+	unit := opts.Unit()
+	net := make(map[string]*netFlow)
+	netOf := func(baker string) *netFlow {
+		if net[baker] == nil {
+			net[baker] = &netFlow{baker: baker}
+		}
+		return net[baker]
+	}
+
+	res := domain.FlowsResponseType{
+		From:  *filter.From,
+		To:    *filter.To,
+		Flows: make([]domain.BakerFlow, len(flows)),
+	}
+	for i, flow := range flows {
+		res.Flows[i] = domain.BakerFlow{
+			From:   toAddress(flow.FromBaker),
+			To:     toAddress(flow.ToBaker),
+			Count:  flow.Count,
+			Amount: domain.NewAmount(flow.Amount, unit),
+		}
+
+		if flow.FromBaker != nil {
+			from := netOf(*flow.FromBaker)
+			from.outflowCount += flow.Count
+			from.outflowAmount += flow.Amount
+		}
+		if flow.ToBaker != nil {
+			to := netOf(*flow.ToBaker)
+			to.inflowCount += flow.Count
+			to.inflowAmount += flow.Amount
+		}
+	}
+
+	bakers := make([]*netFlow, 0, len(net))
+	for _, baker := range net {
+		bakers = append(bakers, baker)
+	}
+	// Largest net gain first, ties broken by address for stable responses.
+	slices.SortFunc(bakers, func(a, b *netFlow) int {
+		return cmp.Or(cmp.Compare(b.netAmount(), a.netAmount()), cmp.Compare(a.baker, b.baker))
+	})
+
+	res.Bakers = make([]domain.BakerNetFlow, len(bakers))
+	res.TopGainers = []domain.BakerNetFlow{}
+	res.TopLosers = []domain.BakerNetFlow{}
+	for i, baker := range bakers {
+		res.Bakers[i] = baker.toResponse(unit)
+		if baker.netAmount() > 0 && len(res.TopGainers) < TopBakers {
+			res.TopGainers = append(res.TopGainers, res.Bakers[i])
+		}
+	}
+	for i := len(bakers) - 1; i >= 0 && len(res.TopLosers) < TopBakers; i-- {
+		if bakers[i].netAmount() < 0 {
+			res.TopLosers = append(res.TopLosers, res.Bakers[i])
+		}
+	}
+
+	return res, nil
+}
+
+// GetWhales return the largest delegations and the whale movements of the period with
+// the concentration of every baker. Without a range, the last DefaultPeriod up to
+// now is used.
+func (uc *UseCaseImpl) GetWhales(ctx context.Context, filter domain.WhaleFilter, opts domain.ResponseOptions) (domain.WhalesResponseType, error) {
+	filter.PeriodFilter = uc.defaultPeriod(filter.PeriodFilter)
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultWhaleLimit
+	}
+	if filter.MinAmount == nil {
+		minAmount := DefaultWhaleMinAmount
+		filter.MinAmount = &minAmount
+	}
+
+	largest, err := uc.repository.FindLargestDelegations(ctx, filter)
+	if err != nil {
+		return domain.WhalesResponseType{}, err
+	}
+
+	movements, err := uc.repository.FindWhaleMovements(ctx, filter)
+	if err != nil {
+		return domain.WhalesResponseType{}, err
+	}
+
+	concentration, err := uc.findConcentration(ctx)
+	if err != nil {
+		return domain.WhalesResponseType{}, err
+	}
+
+	unit := opts.Unit()
+	res := domain.WhalesResponseType{
+		From:               *filter.From,
+		To:                 *filter.To,
+		MinAmount:          domain.NewAmount(int64(*filter.MinAmount), unit),
+		LargestDelegations: toWhaleDelegations(largest, unit),
+		Movements:          toWhaleDelegations(movements, unit),
+		Concentration:      make([]domain.BakerConcentration, len(concentration)),
+	}
+	for i, baker := range concentration {
+		res.Concentration[i] = domain.BakerConcentration{
+			Baker:           domain.Address(baker.Baker),
+			Delegators:      baker.Delegators,
+			DelegatedAmount: domain.NewAmount(baker.DelegatedAmount, unit),
+			HerfindahlIndex: baker.HerfindahlIndex,
+			Top10Share:      baker.Top10Share,
+		}
+	}
+
+	return res, nil
+}
+
+// findConcentration returns the concentration of the largest bakers, computed at
+// most once per ConcentrationTTL. Concurrent callers wait for the same computation.
+func (uc *UseCaseImpl) findConcentration(ctx context.Context) ([]models.BakerConcentration, error) {
+	uc.concentrationMu.Lock()
+	defer uc.concentrationMu.Unlock()
+
+	if uc.concentration != nil && uc.now().Sub(uc.concentrationAt) < ConcentrationTTL {
+		return uc.concentration, nil
+	}
+
+	concentration, err := uc.repository.FindConcentration(ctx, ConcentrationBakers)
+	if err != nil {
+		return nil, err
+	}
+	if concentration == nil {
+		concentration = []models.BakerConcentration{}
+	}
+
+	uc.concentration = concentration
+	uc.concentrationAt = uc.now()
+	return concentration, nil
+}
+
+// CreateWhaleReport stores the whale report of the period rendered in mutez.
+func (uc *UseCaseImpl) CreateWhaleReport(ctx context.Context, filter domain.WhaleFilter) error {
+	whales, err := uc.GetWhales(ctx, filter, domain.ResponseOptions{Units: domain.UnitMutez})
+	if err != nil {
+		return err
+	}
+
+	report, err := json.Marshal(whales)
+	if err != nil {
+		return err
+	}
+
+	uc.logger.Info("whale report",
+		"from", whales.From,
+		"to", whales.To,
+		"largest_delegations", len(whales.LargestDelegations),
+		"movements", len(whales.Movements),
+	)
+	return uc.repository.SaveWhaleReport(ctx, models.WhaleReport{
+		PeriodFrom:  whales.From,
+		PeriodTo:    whales.To,
+		GeneratedAt: uc.now(),
+		Report:      string(report),
+	})
+}
+
+// GetWhaleReports return the latest stored whale reports, the most recent first.
+func (uc *UseCaseImpl) GetWhaleReports(ctx context.Context, limit int) (domain.ApiResponse[domain.WhaleReportResponseType], error) {
+	if limit <= 0 {
+		limit = DefaultWhaleLimit
+	}
+
+	reports, err := uc.repository.FindWhaleReports(ctx, limit)
+	if err != nil {
+		return domain.ApiResponse[domain.WhaleReportResponseType]{}, err
+	}
+
+	res := make([]domain.WhaleReportResponseType, len(reports))
+	for i, report := range reports {
+		res[i] = domain.WhaleReportResponseType{
+			ID:          report.ID.String(),
+			GeneratedAt: report.GeneratedAt,
+			From:        report.PeriodFrom,
+			To:          report.PeriodTo,
+			Report:      json.RawMessage(report.Report),
+		}
+	}
+
+	return domain.ApiResponse[domain.WhaleReportResponseType]{
+		Data:  res,
+		Units: domain.UnitMutez,
+	}, nil
+}
+
+func toWhaleDelegations(delegations []models.Delegation, unit domain.Unit) []domain.WhaleDelegation {
+	res := make([]domain.WhaleDelegation, len(delegations))
+	for i, delegation := range delegations {
+		res[i] = domain.WhaleDelegation{
+			OperationHash: delegation.OperationHash,
+			Timestamp:     delegation.Timestamp,
+			Level:         delegation.Level,
+			Kind:          domain.KindOf(delegation),
+			Delegator:     domain.Address(delegation.Delegator),
+			PreviousBaker: toAddress(delegation.PreviousBaker),
+			Amount:        domain.NewAmount(delegation.Amount, unit),
+		}
+		if delegation.BakerID != domain.UndelegatedBaker {
+			res[i].Baker = toAddress(&delegation.BakerID)
+		}
+	}
+	return res
+}
+
+// defaultPeriod bounds filter to the last DefaultPeriod up to now when unset.
+func (uc *UseCaseImpl) defaultPeriod(filter domain.PeriodFilter) domain.PeriodFilter {
+	if filter.To == nil {
+		to := uc.now()
+		filter.To = &to
+	}
+	if filter.From == nil {
+		from := filter.To.Add(-DefaultPeriod)
+		filter.From = &from
+	}
+	return filter
+}
+
+// netFlow accumulates the flows in and out of a baker.
+type netFlow struct {
+	baker         string
+	inflowCount   int64
+	inflowAmount  int64
+	outflowCount  int64
+	outflowAmount int64
+}
+
+func (f *netFlow) netAmount() int64 {
+	return f.inflowAmount - f.outflowAmount
+}
+
+func (f *netFlow) toResponse(unit domain.Unit) domain.BakerNetFlow {
+	return domain.BakerNetFlow{
+		Baker:         domain.Address(f.baker),
+		InflowCount:   f.inflowCount,
+		InflowAmount:  domain.NewAmount(f.inflowAmount, unit),
+		OutflowCount:  f.outflowCount,
+		OutflowAmount: domain.NewAmount(f.outflowAmount, unit),
+		NetCount:      f.inflowCount - f.outflowCount,
+		NetAmount:     domain.NewAmount(f.netAmount(), unit),
+	}
+}
+
+func toAddress(address *string) *domain.Address {
+	if address == nil {
+		return nil
+	}
+	res := domain.Address(*address)
+	return &res
+}
+
+// NewUseCase create a new use case for the statistics.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{
+		now: func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
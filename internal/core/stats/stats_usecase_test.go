@@ -0,0 +1,403 @@
+package stats
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUseCaseImpl_GetTimeseries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+	longAgo := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+
+	tests := []struct {
+		name           string
+		filter         domain.TimeseriesFilter
+		opts           domain.ResponseOptions
+		setupMocks     func(*mocks.MockStatsRepository)
+		expectedResult domain.ApiResponse[domain.TimeseriesPointResponseType]
+		wantErr        bool
+	}{
+		{
+			name:   "Count_With_Range",
+			filter: domain.TimeseriesFilter{Interval: domain.IntervalDay, Metric: domain.MetricCount, Baker: &baker, From: &from, To: &to},
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindTimeseries(mock.Anything, domain.TimeseriesFilter{
+					Interval: domain.IntervalDay, Metric: domain.MetricCount, Baker: &baker, From: &from, To: &to,
+				}).Return([]models.TimeseriesPoint{
+					{Bucket: from, Value: 3},
+					{Bucket: to, Value: 0},
+				}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.TimeseriesPointResponseType]{
+				Data: []domain.TimeseriesPointResponseType{
+					{Timestamp: from, Value: int64Ptr(3)},
+					{Timestamp: to, Value: int64Ptr(0)},
+				},
+			},
+		},
+		{
+			name:   "Volume_Default_Range",
+			filter: domain.TimeseriesFilter{Interval: domain.IntervalWeek, Metric: domain.MetricVolume},
+			opts:   domain.ResponseOptions{Units: domain.UnitTez},
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindTimeseries(mock.Anything, mock.MatchedBy(func(filter domain.TimeseriesFilter) bool {
+					// 30 weeks up to the week of now, which starts on Monday June 10.
+					return filter.To.Equal(now) && filter.From.Equal(time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC))
+				})).Return([]models.TimeseriesPoint{
+					{Bucket: from, Value: 1500000},
+				}, nil).Once()
+			},
+			expectedResult: domain.ApiResponse[domain.TimeseriesPointResponseType]{
+				Data: []domain.TimeseriesPointResponseType{
+					{Timestamp: from, Amount: amountPtr(domain.NewAmount(1500000, domain.UnitTez))},
+				},
+				Units: domain.UnitTez,
+			},
+		},
+		{
+			name:       "Range_Too_Large",
+			filter:     domain.TimeseriesFilter{Interval: domain.IntervalDay, Metric: domain.MetricCount, From: &longAgo},
+			setupMocks: func(repo *mocks.MockStatsRepository) {},
+			wantErr:    true,
+		},
+		{
+			name:   "Repository_Error",
+			filter: domain.TimeseriesFilter{Interval: domain.IntervalDay, Metric: domain.MetricCount},
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindTimeseries(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockStatsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.GetTimeseries(context.Background(), tt.filter, tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetFlows(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+	from := time.Date(2024, 5, 14, 15, 4, 5, 0, time.UTC)
+	bakerA := "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"
+	bakerB := "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6"
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockStatsRepository)
+		expectedResult domain.FlowsResponseType
+		wantErr        bool
+	}{
+		{
+			name: "Matrix_And_Net_Flows",
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindFlows(mock.Anything, domain.PeriodFilter{From: &from, To: &now}).Return([]models.BakerFlow{
+					{FromBaker: &bakerA, ToBaker: &bakerB, Count: 2, Amount: 500},
+					{FromBaker: nil, ToBaker: &bakerA, Count: 1, Amount: 100},
+					{FromBaker: &bakerB, ToBaker: nil, Count: 1, Amount: 50},
+				}, nil).Once()
+			},
+			expectedResult: domain.FlowsResponseType{
+				From: from,
+				To:   now,
+				Flows: []domain.BakerFlow{
+					{From: addressPtr(bakerA), To: addressPtr(bakerB), Count: 2, Amount: domain.NewAmount(500, domain.UnitMutez)},
+					{To: addressPtr(bakerA), Count: 1, Amount: domain.NewAmount(100, domain.UnitMutez)},
+					{From: addressPtr(bakerB), Count: 1, Amount: domain.NewAmount(50, domain.UnitMutez)},
+				},
+				Bakers: []domain.BakerNetFlow{
+					{
+						Baker: domain.Address(bakerB), InflowCount: 2, InflowAmount: domain.NewAmount(500, domain.UnitMutez),
+						OutflowCount: 1, OutflowAmount: domain.NewAmount(50, domain.UnitMutez),
+						NetCount: 1, NetAmount: domain.NewAmount(450, domain.UnitMutez),
+					},
+					{
+						Baker: domain.Address(bakerA), InflowCount: 1, InflowAmount: domain.NewAmount(100, domain.UnitMutez),
+						OutflowCount: 2, OutflowAmount: domain.NewAmount(500, domain.UnitMutez),
+						NetCount: -1, NetAmount: domain.NewAmount(-400, domain.UnitMutez),
+					},
+				},
+				TopGainers: []domain.BakerNetFlow{
+					{
+						Baker: domain.Address(bakerB), InflowCount: 2, InflowAmount: domain.NewAmount(500, domain.UnitMutez),
+						OutflowCount: 1, OutflowAmount: domain.NewAmount(50, domain.UnitMutez),
+						NetCount: 1, NetAmount: domain.NewAmount(450, domain.UnitMutez),
+					},
+				},
+				TopLosers: []domain.BakerNetFlow{
+					{
+						Baker: domain.Address(bakerA), InflowCount: 1, InflowAmount: domain.NewAmount(100, domain.UnitMutez),
+						OutflowCount: 2, OutflowAmount: domain.NewAmount(500, domain.UnitMutez),
+						NetCount: -1, NetAmount: domain.NewAmount(-400, domain.UnitMutez),
+					},
+				},
+			},
+		},
+		{
+			name: "No_Flows",
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindFlows(mock.Anything, domain.PeriodFilter{From: &from, To: &now}).Return(nil, nil).Once()
+			},
+			expectedResult: domain.FlowsResponseType{
+				From:       from,
+				To:         now,
+				Flows:      []domain.BakerFlow{},
+				Bakers:     []domain.BakerNetFlow{},
+				TopGainers: []domain.BakerNetFlow{},
+				TopLosers:  []domain.BakerNetFlow{},
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindFlows(mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockStatsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.GetFlows(context.Background(), domain.PeriodFilter{}, domain.ResponseOptions{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetWhales(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+	from := time.Date(2024, 5, 14, 15, 4, 5, 0, time.UTC)
+	bakerA := "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"
+	bakerB := "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6"
+	delegator := "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT"
+	defaultMinAmount := DefaultWhaleMinAmount
+	defaultFilter := domain.WhaleFilter{
+		PeriodFilter: domain.PeriodFilter{From: &from, To: &now},
+		Limit:        DefaultWhaleLimit,
+		MinAmount:    &defaultMinAmount,
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func(*mocks.MockStatsRepository)
+		expectedResult domain.WhalesResponseType
+		wantErr        bool
+	}{
+		{
+			name: "Report",
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindLargestDelegations(mock.Anything, defaultFilter).Return([]models.Delegation{
+					{Delegator: delegator, BakerID: bakerA, Amount: 500_000_000_000, Timestamp: now, Level: 100},
+				}, nil).Once()
+				repo.EXPECT().FindWhaleMovements(mock.Anything, defaultFilter).Return([]models.Delegation{
+					{Delegator: delegator, BakerID: bakerB, PreviousBaker: &bakerA, Amount: 400_000_000_000, Timestamp: now, Level: 101},
+					{Delegator: delegator, BakerID: domain.UndelegatedBaker, PreviousBaker: &bakerB, Amount: 300_000_000_000, Timestamp: now, Level: 102},
+				}, nil).Once()
+				repo.EXPECT().FindConcentration(mock.Anything, ConcentrationBakers).Return([]models.BakerConcentration{
+					{Baker: bakerA, Delegators: 2, DelegatedAmount: 1000, HerfindahlIndex: 0.5, Top10Share: 1},
+				}, nil).Once()
+			},
+			expectedResult: domain.WhalesResponseType{
+				From:      from,
+				To:        now,
+				MinAmount: domain.NewAmount(100_000_000_000, domain.UnitMutez),
+				LargestDelegations: []domain.WhaleDelegation{
+					{Timestamp: now, Level: 100, Kind: domain.KindNew, Delegator: domain.Address(delegator), Baker: addressPtr(bakerA), Amount: domain.NewAmount(500_000_000_000, domain.UnitMutez)},
+				},
+				Movements: []domain.WhaleDelegation{
+					{Timestamp: now, Level: 101, Kind: domain.KindRedelegation, Delegator: domain.Address(delegator), Baker: addressPtr(bakerB), PreviousBaker: addressPtr(bakerA), Amount: domain.NewAmount(400_000_000_000, domain.UnitMutez)},
+					{Timestamp: now, Level: 102, Kind: domain.KindUndelegation, Delegator: domain.Address(delegator), PreviousBaker: addressPtr(bakerB), Amount: domain.NewAmount(300_000_000_000, domain.UnitMutez)},
+				},
+				Concentration: []domain.BakerConcentration{
+					{Baker: domain.Address(bakerA), Delegators: 2, DelegatedAmount: domain.NewAmount(1000, domain.UnitMutez), HerfindahlIndex: 0.5, Top10Share: 1},
+				},
+			},
+		},
+		{
+			name: "Repository_Error",
+			setupMocks: func(repo *mocks.MockStatsRepository) {
+				repo.EXPECT().FindLargestDelegations(mock.Anything, defaultFilter).Return(nil, nil).Once()
+				repo.EXPECT().FindWhaleMovements(mock.Anything, defaultFilter).Return(nil, errors.New("db down")).Once()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockStatsRepository(t)
+			tt.setupMocks(mockRepo)
+
+			uc := NewUseCase(
+				UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				UseCaseWithRepository(mockRepo),
+			)
+			uc.now = func() time.Time { return now }
+
+			res, err := uc.GetWhales(context.Background(), domain.WhaleFilter{}, domain.ResponseOptions{})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, res)
+		})
+	}
+}
+
+func TestUseCaseImpl_CreateWhaleReport(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 13, 0, 0, 0, 0, time.UTC)
+	from := now.Add(-24 * time.Hour)
+	minAmount := domain.Mutez(1000)
+	filter := domain.WhaleFilter{
+		PeriodFilter: domain.PeriodFilter{From: &from, To: &now},
+		Limit:        DefaultWhaleLimit,
+		MinAmount:    &minAmount,
+	}
+
+	mockRepo := mocks.NewMockStatsRepository(t)
+	mockRepo.EXPECT().FindLargestDelegations(mock.Anything, filter).Return(nil, nil).Once()
+	mockRepo.EXPECT().FindWhaleMovements(mock.Anything, filter).Return(nil, nil).Once()
+	mockRepo.EXPECT().FindConcentration(mock.Anything, ConcentrationBakers).Return(nil, nil).Once()
+	mockRepo.EXPECT().SaveWhaleReport(mock.Anything, models.WhaleReport{
+		PeriodFrom:  from,
+		PeriodTo:    now,
+		GeneratedAt: now,
+		Report:      `{"from":"2024-06-12T00:00:00Z","to":"2024-06-13T00:00:00Z","min_amount":"1000","largest_delegations":[],"movements":[],"concentration":[]}`,
+	}).Return(nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+	uc.now = func() time.Time { return now }
+
+	assert.NoError(t, uc.CreateWhaleReport(context.Background(), domain.WhaleFilter{
+		PeriodFilter: domain.PeriodFilter{From: &from, To: &now},
+		MinAmount:    &minAmount,
+	}))
+}
+
+func TestUseCaseImpl_GetWhales_Concentration(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 13, 0, 0, 0, 0, time.UTC)
+	noThreshold := domain.Mutez(0)
+
+	mockRepo := mocks.NewMockStatsRepository(t)
+	mockRepo.EXPECT().FindLargestDelegations(mock.Anything, mock.Anything).Return(nil, nil).Times(3)
+	mockRepo.EXPECT().FindWhaleMovements(mock.Anything, mock.MatchedBy(func(filter domain.WhaleFilter) bool {
+		return *filter.MinAmount == 0
+	})).Return(nil, nil).Times(3)
+	// Computed once per ConcentrationTTL.
+	mockRepo.EXPECT().FindConcentration(mock.Anything, ConcentrationBakers).Return(nil, nil).Twice()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+	uc.now = func() time.Time { return now }
+
+	for _, elapsed := range []time.Duration{0, ConcentrationTTL - time.Second, ConcentrationTTL} {
+		uc.now = func() time.Time { return now.Add(elapsed) }
+		res, err := uc.GetWhales(context.Background(), domain.WhaleFilter{MinAmount: &noThreshold}, domain.ResponseOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "0", res.MinAmount.String())
+	}
+}
+
+func TestUseCaseImpl_GetWhaleReports(t *testing.T) {
+	t.Parallel()
+
+	generatedAt := time.Date(2024, 6, 13, 0, 0, 0, 0, time.UTC)
+	mockRepo := mocks.NewMockStatsRepository(t)
+	mockRepo.EXPECT().FindWhaleReports(mock.Anything, DefaultWhaleLimit).Return([]models.WhaleReport{
+		{PeriodFrom: generatedAt.Add(-24 * time.Hour), PeriodTo: generatedAt, GeneratedAt: generatedAt, Report: `{"movements":[]}`},
+	}, nil).Once()
+
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(mockRepo),
+	)
+
+	res, err := uc.GetWhaleReports(context.Background(), 0)
+
+	assert.NoError(t, err)
+	assert.Equal(t, domain.UnitMutez, res.Units)
+	assert.Len(t, res.Data, 1)
+	assert.JSONEq(t, `{"movements":[]}`, string(res.Data[0].Report))
+	assert.Equal(t, generatedAt, res.Data[0].To)
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func amountPtr(a domain.Amount) *domain.Amount {
+	return &a
+}
+
+func addressPtr(s string) *domain.Address {
+	a := domain.Address(s)
+	return &a
+}
@@ -0,0 +1,116 @@
+package stats
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultReportInterval is the delay between two whale reports when none is configured.
+const DefaultReportInterval = 24 * time.Hour
+
+// WhaleReporter stores a whale report covering the previous interval on every interval.
+type WhaleReporter struct {
+	logger *slog.Logger
+
+	useCase   domain.StatsUseCase
+	interval  time.Duration
+	minAmount *domain.Mutez
+}
+
+type WhaleReporterOptions func(*WhaleReporter)
+
+func WhaleReporterWithLogger(logger *slog.Logger) WhaleReporterOptions {
+	return func(r *WhaleReporter) {
+		r.logger = logger
+	}
+}
+
+func WhaleReporterWithUseCase(useCase domain.StatsUseCase) WhaleReporterOptions {
+	return func(r *WhaleReporter) {
+		r.useCase = useCase
+	}
+}
+
+// WhaleReporterWithInterval sets the delay between two reports, which is also the
+// period every report covers.
+func WhaleReporterWithInterval(interval time.Duration) WhaleReporterOptions {
+	return func(r *WhaleReporter) {
+		if interval > 0 {
+			r.interval = interval
+		}
+	}
+}
+
+// WhaleReporterWithMinAmount sets the smallest whale movement reported, the use
+// case default is used when zero.
+func WhaleReporterWithMinAmount(minAmount domain.Mutez) WhaleReporterOptions {
+	return func(r *WhaleReporter) {
+		if minAmount > 0 {
+			r.minAmount = &minAmount
+		}
+	}
+}
+
+// Run reports on every interval. A report is also made at start when the last
+// stored one is older than the interval, so that restarting more often than the
+// interval does not skip every report.
+func (r *WhaleReporter) Run(ctx context.Context) error {
+	r.logger.Info("starting whale reporter", "interval", r.interval)
+
+	if r.stale(ctx) {
+		r.report(ctx, time.Now().UTC())
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("whale reporter stopping due to context cancellation")
+			return ctx.Err()
+		case tick := <-ticker.C:
+			r.report(ctx, tick.UTC())
+		}
+	}
+}
+
+// stale reports whether no report was stored during the last interval.
+func (r *WhaleReporter) stale(ctx context.Context) bool {
+	reports, err := r.useCase.GetWhaleReports(ctx, 1)
+	if err != nil {
+		r.logger.Warn("failed to get the last whale report", "error", err)
+		return false
+	}
+	return len(reports.Data) == 0 || time.Since(reports.Data[0].GeneratedAt) >= r.interval
+}
+
+// report stores the report of the interval ending at to.
+func (r *WhaleReporter) report(ctx context.Context, to time.Time) {
+	from := to.Add(-r.interval)
+	filter := domain.WhaleFilter{
+		PeriodFilter: domain.PeriodFilter{From: &from, To: &to},
+		MinAmount:    r.minAmount,
+	}
+	if err := r.useCase.CreateWhaleReport(ctx, filter); err != nil {
+		r.logger.Warn("whale report failed", "error", err)
+	}
+}
+
+func (r *WhaleReporter) Shutdown(ctx context.Context) error {
+	r.logger.Info("shutting down whale reporter")
+	return nil
+}
+
+func NewWhaleReporter(opts ...WhaleReporterOptions) *WhaleReporter {
+	r := &WhaleReporter{
+		interval: DefaultReportInterval,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
@@ -0,0 +1,105 @@
+package stats
+
+import (
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWhaleReporter_Run(t *testing.T) {
+	t.Parallel()
+
+	interval := 10 * time.Millisecond
+	mockUseCase := mocks.NewMockStatsUseCase(t)
+	mockUseCase.EXPECT().GetWhaleReports(mock.Anything, 1).Return(domain.ApiResponse[domain.WhaleReportResponseType]{
+		Data: []domain.WhaleReportResponseType{{GeneratedAt: time.Now().Add(time.Hour)}},
+	}, nil).Once()
+	reported := make(chan domain.WhaleFilter, 2)
+	mockUseCase.EXPECT().CreateWhaleReport(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, filter domain.WhaleFilter) error {
+		reported <- filter
+		return errors.New("db down")
+	}).Times(2)
+
+	reporter := NewWhaleReporter(
+		WhaleReporterWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WhaleReporterWithUseCase(mockUseCase),
+		WhaleReporterWithInterval(interval),
+		WhaleReporterWithMinAmount(1000),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- reporter.Run(ctx)
+	}()
+
+	// Every report covers the interval before its tick, a failed one is retried on the next tick.
+	filter := <-reported
+	assert.Equal(t, interval, filter.To.Sub(*filter.From))
+	assert.Equal(t, domain.Mutez(1000), *filter.MinAmount)
+	<-reported
+	cancel()
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.NoError(t, reporter.Shutdown(context.Background()))
+}
+
+func TestWhaleReporter_Run_Stale(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		reports  []domain.WhaleReportResponseType
+		err      error
+		expected bool
+	}{
+		{name: "No_Report", expected: true},
+		{name: "Older_Than_Interval", reports: []domain.WhaleReportResponseType{{GeneratedAt: time.Now().Add(-2 * time.Hour)}}, expected: true},
+		{name: "Recent_Report", reports: []domain.WhaleReportResponseType{{GeneratedAt: time.Now().Add(-time.Minute)}}},
+		{name: "Repository_Error", err: errors.New("db down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockStatsUseCase(t)
+			mockUseCase.EXPECT().GetWhaleReports(mock.Anything, 1).Return(domain.ApiResponse[domain.WhaleReportResponseType]{Data: tt.reports}, tt.err).Once()
+			reported := make(chan domain.WhaleFilter, 1)
+			if tt.expected {
+				mockUseCase.EXPECT().CreateWhaleReport(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, filter domain.WhaleFilter) error {
+					reported <- filter
+					return nil
+				}).Once()
+			}
+
+			reporter := NewWhaleReporter(
+				WhaleReporterWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WhaleReporterWithUseCase(mockUseCase),
+				WhaleReporterWithInterval(time.Hour),
+			)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan error, 1)
+			go func() {
+				done <- reporter.Run(ctx)
+			}()
+
+			if tt.expected {
+				filter := <-reported
+				assert.Equal(t, time.Hour, filter.To.Sub(*filter.From))
+				assert.Nil(t, filter.MinAmount)
+			}
+			cancel()
+			assert.ErrorIs(t, <-done, context.Canceled)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+)
+
+// DefaultDeliveryInterval is the delay between two delivery rounds when none is configured.
+const DefaultDeliveryInterval = 5 * time.Second
+
+// Dispatcher periodically sends the webhook deliveries that are due.
+type Dispatcher struct {
+	logger *slog.Logger
+
+	useCase  domain.WebhookUseCase
+	interval time.Duration
+}
+
+type DispatcherOptions func(*Dispatcher)
+
+func DispatcherWithLogger(logger *slog.Logger) DispatcherOptions {
+	return func(d *Dispatcher) {
+		d.logger = logger
+	}
+}
+
+func DispatcherWithUseCase(useCase domain.WebhookUseCase) DispatcherOptions {
+	return func(d *Dispatcher) {
+		d.useCase = useCase
+	}
+}
+
+// DispatcherWithInterval sets the delay between two delivery rounds.
+func DispatcherWithInterval(interval time.Duration) DispatcherOptions {
+	return func(d *Dispatcher) {
+		if interval > 0 {
+			d.interval = interval
+		}
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) error {
+	d.logger.Info("starting webhook dispatcher", "interval", d.interval)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("webhook dispatcher stopping due to context cancellation")
+			return ctx.Err()
+		case <-ticker.C:
+			if err := d.useCase.Deliver(ctx); err != nil {
+				d.logger.Warn("webhook delivery round failed", "error", err)
+			}
+		}
+	}
+}
+
+func (d *Dispatcher) Shutdown(ctx context.Context) error {
+	d.logger.Info("shutting down webhook dispatcher")
+	return nil
+}
+
+func NewDispatcher(opts ...DispatcherOptions) *Dispatcher {
+	d := &Dispatcher{
+		interval: DefaultDeliveryInterval,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"context"
+	"delegator/mocks"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDispatcher_Run(t *testing.T) {
+	t.Parallel()
+
+	mockUseCase := mocks.NewMockWebhookUseCase(t)
+	delivered := make(chan struct{}, 2)
+	mockUseCase.EXPECT().Deliver(mock.Anything).RunAndReturn(func(ctx context.Context) error {
+		delivered <- struct{}{}
+		return errors.New("db down")
+	}).Times(2)
+
+	dispatcher := NewDispatcher(
+		DispatcherWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		DispatcherWithUseCase(mockUseCase),
+		DispatcherWithInterval(10*time.Millisecond),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- dispatcher.Run(ctx)
+	}()
+
+	// A failed round is logged and retried on the next tick.
+	<-delivered
+	<-delivered
+	cancel()
+
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.NoError(t, dispatcher.Shutdown(context.Background()))
+}
@@ -0,0 +1,182 @@
+package webhook
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	logger *slog.Logger
+
+	dbClient *gorm.DB
+}
+
+type RepositoryOptions func(*Repository)
+
+func RepositoryWithLogger(logger *slog.Logger) RepositoryOptions {
+	return func(r *Repository) {
+		r.logger = logger
+	}
+}
+
+func RepositoryWithDBClient(db *gorm.DB) RepositoryOptions {
+	return func(r *Repository) {
+		r.dbClient = db
+	}
+}
+
+func (r *Repository) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	if err := r.dbClient.WithContext(ctx).Create(&webhook).Error; err != nil {
+		r.logger.Warn("error creating webhook", "error", err)
+		return models.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// FindWebhooks returns every webhook that was not deleted, oldest first.
+func (r *Repository) FindWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	var res []models.Webhook
+	if err := r.dbClient.WithContext(ctx).Order("created_at").Find(&res).Error; err != nil {
+		r.logger.Warn("error finding webhooks", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindAPIKeyWebhooks returns the webhooks created with apiKeyID that were not
+// deleted, oldest first.
+func (r *Repository) FindAPIKeyWebhooks(ctx context.Context, apiKeyID uuid.UUID) ([]models.Webhook, error) {
+	var res []models.Webhook
+	if err := r.dbClient.WithContext(ctx).Scopes(ownedBy(apiKeyID)).Order("created_at").Find(&res).Error; err != nil {
+		r.logger.Warn("error finding webhooks", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// FindWebhook returns a webhook created with apiKeyID that was not deleted, or
+// domain.ErrNotFound.
+func (r *Repository) FindWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) (models.Webhook, error) {
+	var res []models.Webhook
+	if err := r.dbClient.WithContext(ctx).Scopes(ownedBy(apiKeyID)).Where("id = ?", id).Limit(1).Find(&res).Error; err != nil {
+		r.logger.Warn("error finding webhook", "error", err, "id", id)
+		return models.Webhook{}, err
+	}
+
+	if len(res) == 0 {
+		return models.Webhook{}, domain.ErrNotFound
+	}
+	return res[0], nil
+}
+
+// DeleteWebhook soft deletes a webhook created with apiKeyID so that its delivery
+// log is kept, or returns domain.ErrNotFound.
+func (r *Repository) DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error {
+	res := r.dbClient.WithContext(ctx).Scopes(ownedBy(apiKeyID)).Where("id = ?", id).Delete(&models.Webhook{})
+	if res.Error != nil {
+		r.logger.Warn("error deleting webhook", "error", res.Error, "id", id)
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// ownedBy restricts a query to the webhooks created with apiKeyID.
+func ownedBy(apiKeyID uuid.UUID) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("api_key_id = ?", apiKeyID)
+	}
+}
+
+// ClaimDueDeliveries returns the pending deliveries of the webhooks that were not
+// deleted whose next attempt is due, the oldest first, with their webhook. The rows
+// are locked with SKIP LOCKED and their next attempt is pushed to leaseUntil in the
+// same transaction, so that concurrent dispatchers claim distinct deliveries and a
+// delivery left by a crashed dispatcher is claimed again once its lease expires.
+func (r *Repository) ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error) {
+	var res []models.WebhookDelivery
+	err := r.dbClient.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			InnerJoins("Webhook").
+			Where("webhook_deliveries.status = ?", string(domain.DeliveryPending)).
+			Where("webhook_deliveries.next_attempt_at <= ?", now).
+			Order("webhook_deliveries.next_attempt_at").
+			Limit(limit).
+			Clauses(clause.Locking{
+				Strength: clause.LockingStrengthUpdate,
+				Table:    clause.Table{Name: "webhook_deliveries"},
+				Options:  clause.LockingOptionsSkipLocked,
+			}).
+			Find(&res).Error
+		if err != nil || len(res) == 0 {
+			return err
+		}
+
+		ids := make([]uuid.UUID, len(res))
+		for i, delivery := range res {
+			ids[i] = delivery.ID
+		}
+		return tx.Model(&models.WebhookDelivery{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", leaseUntil).Error
+	})
+	if err != nil {
+		r.logger.Warn("error claiming due webhook deliveries", "error", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// UpdateDelivery records the outcome of a delivery attempt.
+func (r *Repository) UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	err := r.dbClient.WithContext(ctx).
+		Model(&models.WebhookDelivery{}).
+		Where("id = ?", delivery.ID).
+		Updates(map[string]any{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"delivered_at":     delivery.DeliveredAt,
+		}).Error
+	if err != nil {
+		r.logger.Warn("error updating webhook delivery", "error", err, "id", delivery.ID)
+		return err
+	}
+	return nil
+}
+
+// FindDeliveries returns the latest deliveries of a webhook, the most recent first.
+func (r *Repository) FindDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error) {
+	var res []models.WebhookDelivery
+	err := r.dbClient.WithContext(ctx).
+		Where("webhook_id = ?", webhookID).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&res).Error
+	if err != nil {
+		r.logger.Warn("error finding webhook deliveries", "error", err, "webhook_id", webhookID)
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewRepository(opts ...RepositoryOptions) *Repository {
+	r := &Repository{}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
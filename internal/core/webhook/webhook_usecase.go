@@ -0,0 +1,502 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultMaxAttempts is the number of attempts before a delivery is marked failed
+	// when none is configured.
+	DefaultMaxAttempts = 8
+	// DefaultDeliveriesLimit is the number of deliveries returned when no limit is requested.
+	DefaultDeliveriesLimit = 20
+	// RetryDelay is the delay before the first retry, doubled on every following attempt.
+	RetryDelay = 30 * time.Second
+	// MaxRetryDelay caps the delay between two attempts.
+	MaxRetryDelay = time.Hour
+	// ClaimLease is how long a dispatcher owns the deliveries it claimed, after which
+	// the unfinished ones are claimed again.
+	ClaimLease = 5 * time.Minute
+	// DeliveryConcurrency is the number of deliveries sent at once.
+	DeliveryConcurrency = 16
+	// EndpointConcurrency is the number of deliveries sent at once to the same
+	// endpoint, so that a slow endpoint does not hold every delivery slot.
+	EndpointConcurrency = 4
+	// deliveryBatchSize is the number of due deliveries sent per Deliver call.
+	deliveryBatchSize = 100
+)
+
+// The headers sent with every delivery.
+const (
+	EventHeader     = "X-Delegator-Event"
+	DeliveryHeader  = "X-Delegator-Delivery"
+	SignatureHeader = "X-Delegator-Signature"
+)
+
+// UseCaseImpl represent the use case implementation of the webhooks.
+type UseCaseImpl struct {
+	logger      *slog.Logger
+	repository  domain.WebhookRepository
+	sender      domain.WebhookSender
+	maxAttempts int
+	// allowPrivate accepts endpoints on non-public addresses, for local development.
+	allowPrivate bool
+	lookup       func(ctx context.Context, host string) ([]netip.Addr, error)
+	now          func() time.Time
+}
+
+// UseCaseOption represent the Option function to load option.
+type UseCaseOption func(*UseCaseImpl)
+
+// UseCaseWithLogger inject the logger to the use case.
+func UseCaseWithLogger(logger *slog.Logger) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.logger = logger
+	}
+}
+
+// UseCaseWithRepository inject the repository to the use case.
+func UseCaseWithRepository(repository domain.WebhookRepository) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.repository = repository
+	}
+}
+
+// UseCaseWithSender inject the client the events are posted with.
+func UseCaseWithSender(sender domain.WebhookSender) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.sender = sender
+	}
+}
+
+// UseCaseWithMaxAttempts sets the number of attempts before a delivery is marked failed.
+func UseCaseWithMaxAttempts(maxAttempts int) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		if maxAttempts > 0 {
+			u.maxAttempts = maxAttempts
+		}
+	}
+}
+
+// UseCaseWithPrivateEndpoints accepts webhooks on loopback and private addresses,
+// which are refused by default.
+func UseCaseWithPrivateEndpoints(allowed bool) UseCaseOption {
+	return func(u *UseCaseImpl) {
+		u.allowPrivate = allowed
+	}
+}
+
+// CreateWebhook stores a webhook with a new signing secret, which is only returned here.
+// The endpoint must resolve to public addresses only, or domain.ErrInvalidEndpoint is returned.
+func (uc *UseCaseImpl) CreateWebhook(ctx context.Context, dto domain.CreateWebhookDTO, opts domain.ResponseOptions) (domain.WebhookResponseType, error) {
+	if err := uc.checkEndpoint(ctx, dto.URL); err != nil {
+		return domain.WebhookResponseType{}, err
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		return domain.WebhookResponseType{}, err
+	}
+
+	kinds := make([]string, len(dto.Kinds))
+	for i, kind := range dto.Kinds {
+		kinds[i] = string(kind)
+	}
+
+	webhook, err := uc.repository.CreateWebhook(ctx, models.Webhook{
+		APIKeyID:  dto.APIKeyID,
+		URL:       dto.URL,
+		Secret:    secret,
+		Baker:     addressString(dto.Baker),
+		Delegator: addressString(dto.Delegator),
+		MinAmount: int64(dto.MinAmount),
+		Kinds:     kinds,
+		CreatedAt: uc.now(),
+	})
+	if err != nil {
+		return domain.WebhookResponseType{}, err
+	}
+
+	uc.logger.Info("created webhook", "id", webhook.ID, "url", webhook.URL)
+	res := toWebhookResponse(webhook, opts.Unit())
+	res.Secret = webhook.Secret
+	return res, nil
+}
+
+// checkEndpoint resolves the host of an endpoint and refuses it when any of its
+// addresses is not public. The sender checks the address again when it connects,
+// as the host may resolve differently by then.
+func (uc *UseCaseImpl) checkEndpoint(ctx context.Context, endpoint string) error {
+	if uc.allowPrivate {
+		return nil
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("%w: %w", domain.ErrInvalidEndpoint, err)
+	}
+	host := strings.TrimSuffix(strings.ToLower(parsed.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s is not a public address", domain.ErrInvalidEndpoint, host)
+	}
+
+	addrs, err := uc.lookup(ctx, host)
+	if err != nil || len(addrs) == 0 {
+		return fmt.Errorf("%w: %s does not resolve", domain.ErrInvalidEndpoint, host)
+	}
+	for _, addr := range addrs {
+		if !domain.IsPublicAddr(addr) {
+			return fmt.Errorf("%w: %s resolves to %s, which is not a public address", domain.ErrInvalidEndpoint, host, addr)
+		}
+	}
+	return nil
+}
+
+// GetWebhooks returns the webhooks created with apiKeyID.
+func (uc *UseCaseImpl) GetWebhooks(ctx context.Context, apiKeyID uuid.UUID, opts domain.ResponseOptions) (domain.ApiResponse[domain.WebhookResponseType], error) {
+	webhooks, err := uc.repository.FindAPIKeyWebhooks(ctx, apiKeyID)
+	if err != nil {
+		return domain.ApiResponse[domain.WebhookResponseType]{}, err
+	}
+
+	res := make([]domain.WebhookResponseType, len(webhooks))
+	for i, webhook := range webhooks {
+		res[i] = toWebhookResponse(webhook, opts.Unit())
+	}
+
+	return domain.ApiResponse[domain.WebhookResponseType]{
+		Data:  res,
+		Units: opts.Unit(),
+	}, nil
+}
+
+// GetWebhook return a webhook created with apiKeyID, or domain.ErrNotFound.
+func (uc *UseCaseImpl) GetWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, opts domain.ResponseOptions) (domain.WebhookResponseType, error) {
+	webhook, err := uc.repository.FindWebhook(ctx, apiKeyID, id)
+	if err != nil {
+		return domain.WebhookResponseType{}, err
+	}
+	return toWebhookResponse(webhook, opts.Unit()), nil
+}
+
+// DeleteWebhook stops the deliveries to a webhook created with apiKeyID, its
+// delivery log is kept.
+func (uc *UseCaseImpl) DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error {
+	if err := uc.repository.DeleteWebhook(ctx, apiKeyID, id); err != nil {
+		return err
+	}
+
+	uc.logger.Info("deleted webhook", "id", id)
+	return nil
+}
+
+// GetDeliveries return the latest deliveries of a webhook created with apiKeyID,
+// or domain.ErrNotFound.
+func (uc *UseCaseImpl) GetDeliveries(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, limit int) (domain.ApiResponse[domain.WebhookDeliveryResponseType], error) {
+	if _, err := uc.repository.FindWebhook(ctx, apiKeyID, id); err != nil {
+		return domain.ApiResponse[domain.WebhookDeliveryResponseType]{}, err
+	}
+
+	if limit <= 0 {
+		limit = DefaultDeliveriesLimit
+	}
+
+	deliveries, err := uc.repository.FindDeliveries(ctx, id, limit)
+	if err != nil {
+		return domain.ApiResponse[domain.WebhookDeliveryResponseType]{}, err
+	}
+
+	res := make([]domain.WebhookDeliveryResponseType, len(deliveries))
+	for i, delivery := range deliveries {
+		res[i] = domain.WebhookDeliveryResponseType{
+			ID:             delivery.ID,
+			Event:          delivery.Event,
+			OperationID:    delivery.OperationID,
+			Status:         domain.DeliveryStatus(delivery.Status),
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			LastStatusCode: delivery.LastStatusCode,
+			LastError:      delivery.LastError,
+			CreatedAt:      delivery.CreatedAt,
+			DeliveredAt:    delivery.DeliveredAt,
+		}
+	}
+
+	return domain.ApiResponse[domain.WebhookDeliveryResponseType]{Data: res}, nil
+}
+
+// Deliveries returns a pending delivery for every webhook matching each delegation.
+// Self-delegations register a baker and are not delegation events.
+func (uc *UseCaseImpl) Deliveries(ctx context.Context, delegations []models.Delegation) ([]models.WebhookDelivery, error) {
+	webhooks, err := uc.repository.FindWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks) == 0 {
+		return nil, nil
+	}
+
+	createdAt := uc.now()
+	var deliveries []models.WebhookDelivery
+	for _, delegation := range delegations {
+		if delegation.IsSelfDelegation || delegation.OperationID == nil {
+			continue
+		}
+
+		event := domain.NewDelegationEvent(delegation)
+		for _, webhook := range webhooks {
+			if !matches(webhook, delegation, event.Kind) {
+				continue
+			}
+
+			id := uuid.New()
+			payload, err := json.Marshal(domain.WebhookEvent{
+				ID:         id,
+				Event:      domain.EventName(event.Kind),
+				CreatedAt:  createdAt,
+				Delegation: event,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			deliveries = append(deliveries, models.WebhookDelivery{
+				ID:            id,
+				WebhookID:     webhook.ID,
+				OperationID:   *delegation.OperationID,
+				Event:         domain.EventName(event.Kind),
+				Payload:       string(payload),
+				Status:        string(domain.DeliveryPending),
+				NextAttemptAt: &createdAt,
+				CreatedAt:     createdAt,
+			})
+		}
+	}
+
+	uc.logger.Info("enqueuing webhook deliveries", "count", len(deliveries))
+	return deliveries, nil
+}
+
+// Deliver claims the due deliveries and sends them concurrently, at most
+// DeliveryConcurrency at once and EndpointConcurrency per endpoint, then records
+// the outcome of each attempt. The deliveries not sent before their lease expires
+// are claimed again by the next rounds.
+func (uc *UseCaseImpl) Deliver(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, ClaimLease)
+	defer cancel()
+
+	now := uc.now()
+	deliveries, err := uc.repository.ClaimDueDeliveries(ctx, now, now.Add(ClaimLease), deliveryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	slots := make(chan struct{}, DeliveryConcurrency)
+	endpoints := make(map[string]chan struct{})
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		endpoint, ok := endpoints[delivery.Webhook.URL]
+		if !ok {
+			endpoint = make(chan struct{}, EndpointConcurrency)
+			endpoints[delivery.Webhook.URL] = endpoint
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// The endpoint slot is taken first so that deliveries waiting on a busy
+			// endpoint do not hold the slots of the other endpoints.
+			endpoint <- struct{}{}
+			defer func() { <-endpoint }()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+
+			if ctx.Err() != nil {
+				return
+			}
+			uc.attempt(ctx, delivery)
+			if err := uc.repository.UpdateDelivery(ctx, *delivery); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return errors.Join(errs...)
+}
+
+// attempt posts a delivery to its webhook and updates its status, a delivery that is
+// not acknowledged with a 2xx response is retried with an exponential backoff until
+// it runs out of attempts.
+func (uc *UseCaseImpl) attempt(ctx context.Context, delivery *models.WebhookDelivery) {
+	sentAt := uc.now()
+	body := []byte(delivery.Payload)
+	headers := map[string]string{
+		"Content-Type":  "application/json",
+		EventHeader:     delivery.Event,
+		DeliveryHeader:  delivery.ID.String(),
+		SignatureHeader: Sign(delivery.Webhook.Secret, sentAt, body),
+	}
+
+	statusCode, err := uc.sender.Send(ctx, delivery.Webhook.URL, headers, body)
+	delivery.Attempts++
+	delivery.LastStatusCode = nil
+	delivery.LastError = nil
+
+	switch {
+	case err != nil:
+		message := err.Error()
+		delivery.LastError = &message
+	case statusCode < 200 || statusCode >= 300:
+		message := fmt.Sprintf("unexpected status code %d", statusCode)
+		delivery.LastStatusCode = &statusCode
+		delivery.LastError = &message
+	default:
+		delivery.LastStatusCode = &statusCode
+		delivery.Status = string(domain.DeliveryDelivered)
+		delivery.NextAttemptAt = nil
+		delivery.DeliveredAt = &sentAt
+		return
+	}
+
+	if delivery.Attempts >= uc.maxAttempts {
+		uc.logger.Warn("webhook delivery failed", "id", delivery.ID, "webhook_id", delivery.WebhookID, "attempts", delivery.Attempts, "error", *delivery.LastError)
+		delivery.Status = string(domain.DeliveryFailed)
+		delivery.NextAttemptAt = nil
+		return
+	}
+
+	next := sentAt.Add(retryDelay(delivery.Attempts))
+	delivery.NextAttemptAt = &next
+}
+
+// Sign returns the signature header of a body sent at sentAt: the unix timestamp
+// and the hex HMAC-SHA256 of "<timestamp>.<body>" keyed with the webhook secret.
+func Sign(secret string, sentAt time.Time, body []byte) string {
+	timestamp := strconv.FormatInt(sentAt.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + timestamp + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay returns the delay after the attempts-th failed attempt.
+func retryDelay(attempts int) time.Duration {
+	delay := RetryDelay
+	for range attempts - 1 {
+		delay *= 2
+		if delay >= MaxRetryDelay {
+			return MaxRetryDelay
+		}
+	}
+	return delay
+}
+
+// matches reports whether a webhook is notified of a delegation. The baker filter
+// matches the new baker as well as the previous one, so that departures are notified.
+func matches(webhook models.Webhook, delegation models.Delegation, kind domain.DelegationKind) bool {
+	if webhook.Delegator != nil && *webhook.Delegator != delegation.Delegator {
+		return false
+	}
+	if webhook.Baker != nil && *webhook.Baker != delegation.BakerID &&
+		(delegation.PreviousBaker == nil || *webhook.Baker != *delegation.PreviousBaker) {
+		return false
+	}
+	if delegation.Amount < webhook.MinAmount {
+		return false
+	}
+	if len(webhook.Kinds) > 0 && !slices.Contains(webhook.Kinds, string(kind)) {
+		return false
+	}
+	return true
+}
+
+func toWebhookResponse(webhook models.Webhook, unit domain.Unit) domain.WebhookResponseType {
+	kinds := make([]domain.DelegationKind, len(webhook.Kinds))
+	for i, kind := range webhook.Kinds {
+		kinds[i] = domain.DelegationKind(kind)
+	}
+
+	return domain.WebhookResponseType{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		Baker:     toAddress(webhook.Baker),
+		Delegator: toAddress(webhook.Delegator),
+		MinAmount: domain.NewAmount(webhook.MinAmount, unit),
+		Kinds:     kinds,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+// newSecret returns a random 32 bytes hex encoded signing secret.
+func newSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
+func addressString(address *domain.Address) *string {
+	if address == nil {
+		return nil
+	}
+	res := address.String()
+	return &res
+}
+
+func toAddress(address *string) *domain.Address {
+	if address == nil {
+		return nil
+	}
+	res := domain.Address(*address)
+	return &res
+}
+
+// NewUseCase create a new use case for the webhooks.
+func NewUseCase(opts ...UseCaseOption) *UseCaseImpl {
+	uc := &UseCaseImpl{
+		maxAttempts: DefaultMaxAttempts,
+		lookup: func(ctx context.Context, host string) ([]netip.Addr, error) {
+			return net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+		},
+		now: func() time.Time { return time.Now().UTC() },
+	}
+	for _, opt := range opts {
+		opt(uc)
+	}
+
+	return uc
+}
@@ -0,0 +1,439 @@
+package webhook
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/netip"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUseCase(repo domain.WebhookRepository, sender domain.WebhookSender, now time.Time) *UseCaseImpl {
+	uc := NewUseCase(
+		UseCaseWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		UseCaseWithRepository(repo),
+		UseCaseWithSender(sender),
+		UseCaseWithMaxAttempts(3),
+	)
+	uc.lookup = lookupTestHost
+	uc.now = func() time.Time { return now }
+	return uc
+}
+
+// lookupTestHost resolves the hosts of the tests without a DNS server.
+func lookupTestHost(_ context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr}, nil
+	}
+	hosts := map[string][]netip.Addr{
+		"example.com":          {netip.MustParseAddr("93.184.216.34")},
+		"internal.example.com": {netip.MustParseAddr("93.184.216.34"), netip.MustParseAddr("10.0.0.5")},
+	}
+	addrs, ok := hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func TestUseCaseImpl_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	id := uuid.New()
+	apiKeyID := uuid.New()
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+
+	mockRepo := mocks.NewMockWebhookRepository(t)
+	mockRepo.EXPECT().CreateWebhook(mock.Anything, mock.MatchedBy(func(webhook models.Webhook) bool {
+		return webhook.URL == "https://example.com/hook" && len(webhook.Secret) == 64 &&
+			webhook.APIKeyID == apiKeyID &&
+			*webhook.Baker == baker.String() && webhook.Delegator == nil &&
+			webhook.MinAmount == 1000000 && assert.ObjectsAreEqual([]string{"new"}, webhook.Kinds)
+	})).RunAndReturn(func(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+		webhook.ID = id
+		return webhook, nil
+	}).Once()
+
+	uc := newTestUseCase(mockRepo, nil, now)
+	res, err := uc.CreateWebhook(context.Background(), domain.CreateWebhookDTO{
+		APIKeyID:  apiKeyID,
+		URL:       "https://example.com/hook",
+		Baker:     &baker,
+		MinAmount: 1000000,
+		Kinds:     []domain.DelegationKind{domain.KindNew},
+	}, domain.ResponseOptions{Units: domain.UnitTez})
+
+	assert.NoError(t, err)
+	assert.Equal(t, id, res.ID)
+	assert.Len(t, res.Secret, 64)
+	assert.Equal(t, &baker, res.Baker)
+	assert.Equal(t, "1.000000", res.MinAmount.String())
+	assert.Equal(t, now, res.CreatedAt)
+}
+
+func TestUseCaseImpl_CreateWebhook_Endpoint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantErr      bool
+	}{
+		{name: "Public", url: "https://example.com/hook"},
+		{name: "Public_IP", url: "https://93.184.216.34/hook"},
+		{name: "Loopback", url: "http://127.0.0.1:8080/hook", wantErr: true},
+		{name: "Localhost", url: "http://localhost:8080/hook", wantErr: true},
+		{name: "Metadata", url: "http://169.254.169.254/latest/meta-data", wantErr: true},
+		{name: "Private", url: "http://192.168.1.10/hook", wantErr: true},
+		{name: "Private_IPv6", url: "http://[fd00::1]/hook", wantErr: true},
+		{name: "Resolves_To_Private", url: "https://internal.example.com/hook", wantErr: true},
+		{name: "Unresolved", url: "https://unknown.example.com/hook", wantErr: true},
+		{name: "Private_Allowed", url: "http://127.0.0.1:8080/hook", allowPrivate: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockWebhookRepository(t)
+			if !tt.wantErr {
+				mockRepo.EXPECT().CreateWebhook(mock.Anything, mock.Anything).Return(models.Webhook{URL: tt.url}, nil).Once()
+			}
+
+			uc := newTestUseCase(mockRepo, nil, time.Now())
+			uc.allowPrivate = tt.allowPrivate
+			_, err := uc.CreateWebhook(context.Background(), domain.CreateWebhookDTO{URL: tt.url}, domain.ResponseOptions{})
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidEndpoint)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_GetDeliveries(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	apiKeyID := uuid.New()
+
+	tests := []struct {
+		name       string
+		limit      int
+		setupMocks func(*mocks.MockWebhookRepository)
+		wantLen    int
+		wantErr    error
+	}{
+		{
+			name:  "Default_Limit",
+			limit: 0,
+			setupMocks: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindWebhook(mock.Anything, apiKeyID, id).Return(models.Webhook{ID: id}, nil).Once()
+				repo.EXPECT().FindDeliveries(mock.Anything, id, DefaultDeliveriesLimit).Return([]models.WebhookDelivery{
+					{ID: uuid.New(), WebhookID: id, Status: string(domain.DeliveryDelivered)},
+				}, nil).Once()
+			},
+			wantLen: 1,
+		},
+		{
+			name:  "Webhook_Not_Found",
+			limit: 5,
+			setupMocks: func(repo *mocks.MockWebhookRepository) {
+				repo.EXPECT().FindWebhook(mock.Anything, apiKeyID, id).Return(models.Webhook{}, domain.ErrNotFound).Once()
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockWebhookRepository(t)
+			tt.setupMocks(mockRepo)
+
+			res, err := newTestUseCase(mockRepo, nil, time.Now()).GetDeliveries(context.Background(), apiKeyID, id, tt.limit)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, res.Data, tt.wantLen)
+		})
+	}
+}
+
+func TestUseCaseImpl_Deliveries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	baker := "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6"
+	previousBaker := "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"
+	delegator := "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb"
+	operationID := int64(42)
+	newDelegation := models.Delegation{Delegator: delegator, BakerID: baker, Amount: 5000000, Level: 100, OperationID: &operationID}
+	undelegation := models.Delegation{Delegator: delegator, BakerID: domain.UndelegatedBaker, PreviousBaker: &previousBaker, Amount: 5000000, OperationID: &operationID}
+	selfDelegation := models.Delegation{Delegator: baker, BakerID: baker, IsSelfDelegation: true, OperationID: &operationID}
+
+	tests := []struct {
+		name        string
+		webhooks    []models.Webhook
+		delegations []models.Delegation
+		wantEvents  []string
+	}{
+		{
+			name:        "Unfiltered_Webhook",
+			webhooks:    []models.Webhook{{ID: uuid.New()}},
+			delegations: []models.Delegation{newDelegation},
+			wantEvents:  []string{"delegation.new"},
+		},
+		{
+			name:        "Baker_Matches_Previous_Baker",
+			webhooks:    []models.Webhook{{ID: uuid.New(), Baker: &previousBaker}},
+			delegations: []models.Delegation{newDelegation, undelegation},
+			wantEvents:  []string{"delegation.undelegation"},
+		},
+		{
+			name:        "Below_Min_Amount",
+			webhooks:    []models.Webhook{{ID: uuid.New(), MinAmount: 10000000}},
+			delegations: []models.Delegation{newDelegation},
+		},
+		{
+			name:        "Kind_Filtered",
+			webhooks:    []models.Webhook{{ID: uuid.New(), Kinds: []string{"redelegation"}}},
+			delegations: []models.Delegation{newDelegation, undelegation},
+		},
+		{
+			name:        "Self_Delegation_Skipped",
+			webhooks:    []models.Webhook{{ID: uuid.New()}},
+			delegations: []models.Delegation{selfDelegation},
+		},
+		{
+			name:        "No_Webhooks",
+			delegations: []models.Delegation{newDelegation},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockRepo := mocks.NewMockWebhookRepository(t)
+			mockRepo.EXPECT().FindWebhooks(mock.Anything).Return(tt.webhooks, nil).Once()
+
+			deliveries, err := newTestUseCase(mockRepo, nil, now).Deliveries(context.Background(), tt.delegations)
+			assert.NoError(t, err)
+
+			var events []string
+			for _, delivery := range deliveries {
+				events = append(events, delivery.Event)
+				assert.Equal(t, string(domain.DeliveryPending), delivery.Status)
+				assert.Equal(t, operationID, delivery.OperationID)
+				assert.Equal(t, &now, delivery.NextAttemptAt)
+
+				var event map[string]any
+				assert.NoError(t, json.Unmarshal([]byte(delivery.Payload), &event))
+				assert.Equal(t, delivery.ID.String(), event["id"])
+				assert.Equal(t, delivery.Event, event["event"])
+			}
+			assert.Equal(t, tt.wantEvents, events)
+		})
+	}
+}
+
+func TestUseCaseImpl_Deliver(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	webhook := models.Webhook{ID: uuid.New(), URL: "https://example.com/hook", Secret: "secret"}
+	payload := `{"event":"delegation.new"}`
+
+	tests := []struct {
+		name       string
+		attempts   int
+		statusCode int
+		sendErr    error
+		expected   func(id uuid.UUID) models.WebhookDelivery
+	}{
+		{
+			name:       "Delivered",
+			statusCode: http.StatusNoContent,
+			expected: func(id uuid.UUID) models.WebhookDelivery {
+				statusCode := http.StatusNoContent
+				return models.WebhookDelivery{
+					ID: id, WebhookID: webhook.ID, Event: "delegation.new", Payload: payload, Webhook: webhook,
+					Status: string(domain.DeliveryDelivered), Attempts: 1, LastStatusCode: &statusCode, DeliveredAt: &now,
+				}
+			},
+		},
+		{
+			name:       "Retried_With_Backoff",
+			attempts:   1,
+			statusCode: http.StatusInternalServerError,
+			expected: func(id uuid.UUID) models.WebhookDelivery {
+				statusCode := http.StatusInternalServerError
+				message := "unexpected status code 500"
+				next := now.Add(2 * RetryDelay)
+				return models.WebhookDelivery{
+					ID: id, WebhookID: webhook.ID, Event: "delegation.new", Payload: payload, Webhook: webhook,
+					Status: string(domain.DeliveryPending), Attempts: 2, NextAttemptAt: &next, LastStatusCode: &statusCode, LastError: &message,
+				}
+			},
+		},
+		{
+			name:     "Failed_After_Max_Attempts",
+			attempts: 2,
+			sendErr:  errors.New("connection refused"),
+			expected: func(id uuid.UUID) models.WebhookDelivery {
+				message := "connection refused"
+				return models.WebhookDelivery{
+					ID: id, WebhookID: webhook.ID, Event: "delegation.new", Payload: payload, Webhook: webhook,
+					Status: string(domain.DeliveryFailed), Attempts: 3, LastError: &message,
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id := uuid.New()
+			delivery := models.WebhookDelivery{
+				ID: id, WebhookID: webhook.ID, Event: "delegation.new", Payload: payload, Webhook: webhook,
+				Status: string(domain.DeliveryPending), Attempts: tt.attempts, NextAttemptAt: &now,
+			}
+
+			mockRepo := mocks.NewMockWebhookRepository(t)
+			mockSender := mocks.NewMockWebhookSender(t)
+			mockRepo.EXPECT().ClaimDueDeliveries(mock.Anything, now, now.Add(ClaimLease), deliveryBatchSize).Return([]models.WebhookDelivery{delivery}, nil).Once()
+			mockSender.EXPECT().Send(mock.Anything, webhook.URL, map[string]string{
+				"Content-Type":  "application/json",
+				EventHeader:     "delegation.new",
+				DeliveryHeader:  id.String(),
+				SignatureHeader: Sign("secret", now, []byte(payload)),
+			}, []byte(payload)).Return(tt.statusCode, tt.sendErr).Once()
+			mockRepo.EXPECT().UpdateDelivery(mock.Anything, tt.expected(id)).Return(nil).Once()
+
+			err := newTestUseCase(mockRepo, mockSender, now).Deliver(context.Background())
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUseCaseImpl_Deliver_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	endpoints := []models.Webhook{
+		{ID: uuid.New(), URL: "https://a.example.com/hook", Secret: "secret"},
+		{ID: uuid.New(), URL: "https://b.example.com/hook", Secret: "secret"},
+	}
+
+	var deliveries []models.WebhookDelivery
+	for range 3 * EndpointConcurrency {
+		for _, webhook := range endpoints {
+			deliveries = append(deliveries, models.WebhookDelivery{
+				ID: uuid.New(), WebhookID: webhook.ID, Event: "delegation.new", Payload: "{}", Webhook: webhook,
+				Status: string(domain.DeliveryPending), NextAttemptAt: &now,
+			})
+		}
+	}
+
+	var (
+		mu          sync.Mutex
+		inFlight    = map[string]int{}
+		maxEndpoint int
+		total       int
+		maxTotal    int
+	)
+	mockRepo := mocks.NewMockWebhookRepository(t)
+	mockSender := mocks.NewMockWebhookSender(t)
+	mockRepo.EXPECT().ClaimDueDeliveries(mock.Anything, now, now.Add(ClaimLease), deliveryBatchSize).Return(deliveries, nil).Once()
+	mockSender.EXPECT().Send(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+			mu.Lock()
+			inFlight[url]++
+			total++
+			maxEndpoint = max(maxEndpoint, inFlight[url])
+			maxTotal = max(maxTotal, total)
+			mu.Unlock()
+
+			time.Sleep(20 * time.Millisecond)
+
+			mu.Lock()
+			inFlight[url]--
+			total--
+			mu.Unlock()
+			return http.StatusNoContent, nil
+		}).Times(len(deliveries))
+	mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(delivery models.WebhookDelivery) bool {
+		return delivery.Status == string(domain.DeliveryDelivered)
+	})).Return(nil).Times(len(deliveries))
+
+	err := newTestUseCase(mockRepo, mockSender, now).Deliver(context.Background())
+	assert.NoError(t, err)
+	assert.LessOrEqual(t, maxEndpoint, EndpointConcurrency)
+	assert.Greater(t, maxTotal, EndpointConcurrency)
+}
+
+func TestUseCaseImpl_Deliver_UpdateError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	webhook := models.Webhook{ID: uuid.New(), URL: "https://example.com/hook", Secret: "secret"}
+	deliveries := []models.WebhookDelivery{
+		{ID: uuid.New(), WebhookID: webhook.ID, Payload: "{}", Webhook: webhook, Status: string(domain.DeliveryPending)},
+		{ID: uuid.New(), WebhookID: webhook.ID, Payload: "{}", Webhook: webhook, Status: string(domain.DeliveryPending)},
+	}
+
+	mockRepo := mocks.NewMockWebhookRepository(t)
+	mockSender := mocks.NewMockWebhookSender(t)
+	mockRepo.EXPECT().ClaimDueDeliveries(mock.Anything, now, now.Add(ClaimLease), deliveryBatchSize).Return(deliveries, nil).Once()
+	mockSender.EXPECT().Send(mock.Anything, webhook.URL, mock.Anything, mock.Anything).Return(http.StatusOK, nil).Twice()
+	mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(delivery models.WebhookDelivery) bool {
+		return delivery.ID == deliveries[0].ID
+	})).Return(errors.New("db down")).Once()
+	mockRepo.EXPECT().UpdateDelivery(mock.Anything, mock.MatchedBy(func(delivery models.WebhookDelivery) bool {
+		return delivery.ID == deliveries[1].ID
+	})).Return(nil).Once()
+
+	err := newTestUseCase(mockRepo, mockSender, now).Deliver(context.Background())
+	assert.EqualError(t, err, "db down")
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	sentAt := time.Unix(1717243200, 0)
+	body := []byte(`{"event":"delegation.new"}`)
+
+	signature := Sign("secret", sentAt, body)
+	assert.Regexp(t, `^t=1717243200,v1=[0-9a-f]{64}$`, signature)
+	assert.Equal(t, signature, Sign("secret", sentAt, body))
+	assert.NotEqual(t, signature, Sign("other", sentAt, body))
+	assert.NotEqual(t, signature, Sign("secret", sentAt.Add(time.Second), body))
+}
+
+func TestRetryDelay(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, RetryDelay, retryDelay(1))
+	assert.Equal(t, 4*RetryDelay, retryDelay(3))
+	assert.Equal(t, MaxRetryDelay, retryDelay(20))
+}
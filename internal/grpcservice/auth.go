@@ -0,0 +1,109 @@
+package grpcservice
+
+import (
+	"context"
+	"delegator/internal/httpservice/auth"
+	"delegator/pkg/domain"
+	"errors"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// publicServices are left unauthenticated, so that the probes and grpcurl work
+// when API keys are required.
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// errorCodes maps the authentication errors to their gRPC codes, the others are
+// internal.
+var errorCodes = map[domain.ErrorCode]codes.Code{
+	domain.CodeUnauthenticated:   codes.Unauthenticated,
+	domain.CodeResourceExhausted: codes.ResourceExhausted,
+}
+
+// WithAuthenticator authenticates the calls with the API keys of the HTTP API,
+// sent in the x-api-key or the authorization bearer metadata, and applies their
+// rate limits and daily quotas.
+func WithAuthenticator(authenticator *auth.Authenticator) Options {
+	return func(s *Server) {
+		s.authenticator = authenticator
+	}
+}
+
+func (s *Server) authenticateUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := s.authenticate(ctx, info.FullMethod, func(md metadata.MD) error { return grpc.SetHeader(ctx, md) }); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authenticateStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := s.authenticate(stream.Context(), info.FullMethod, stream.SetHeader); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
+
+// authenticate checks the API key of a call and sends the quota of the key in
+// the x-quota-* header metadata, like the HTTP headers.
+func (s *Server) authenticate(ctx context.Context, method string, setHeader func(metadata.MD) error) error {
+	if s.authenticator == nil || isPublicMethod(method) {
+		return nil
+	}
+
+	_, quota, err := s.authenticator.Check(ctx, extractKey(ctx))
+	if quota.Limit > 0 {
+		_ = setHeader(metadata.Pairs(
+			strings.ToLower(auth.QuotaLimitHeader), strconv.FormatInt(quota.Limit, 10),
+			strings.ToLower(auth.QuotaRemainingHeader), strconv.FormatInt(quota.Remaining(), 10),
+			strings.ToLower(auth.QuotaResetHeader), strconv.FormatInt(quota.Reset.Unix(), 10),
+		))
+	}
+	if err == nil {
+		return nil
+	}
+
+	var apiErr *domain.APIError
+	if !errors.As(err, &apiErr) {
+		return status.Error(codes.Internal, "failed to authenticate api key")
+	}
+	code, ok := errorCodes[apiErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+	return status.Error(code, apiErr.Message)
+}
+
+func isPublicMethod(method string) bool {
+	for _, service := range publicServices {
+		if strings.HasPrefix(method, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// extractKey returns the key of the x-api-key metadata, or of a bearer
+// authorization metadata.
+func extractKey(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(auth.Header); len(keys) > 0 && keys[0] != "" {
+		return keys[0]
+	}
+
+	for _, value := range md.Get("authorization") {
+		scheme, token, ok := strings.Cut(value, " ")
+		if ok && strings.EqualFold(scheme, "Bearer") {
+			return strings.TrimSpace(token)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package grpcservice
+
+import (
+	"context"
+	"delegator/internal/httpservice/auth"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"delegator/pkg/pb/delegatorv1"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	key := domain.APIKey{ID: uuid.New(), Name: "partner", DailyQuota: 100}
+	reset := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		md               metadata.MD
+		setupMocks       func(*mocks.MockAPIKeyUseCase, *mocks.MockUseCase)
+		expectedCode     codes.Code
+		expectedMessage  string
+		expectedMetadata map[string]string
+	}{
+		{
+			name:            "Missing_Key",
+			setupMocks:      func(*mocks.MockAPIKeyUseCase, *mocks.MockUseCase) {},
+			expectedCode:    codes.Unauthenticated,
+			expectedMessage: "missing api key",
+		},
+		{
+			name: "Header_Key",
+			md:   metadata.Pairs("x-api-key", "dlg_key"),
+			setupMocks: func(keys *mocks.MockAPIKeyUseCase, useCase *mocks.MockUseCase) {
+				keys.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(key, nil).Once()
+				keys.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{Limit: 100, Used: 40, Reset: reset}, nil).Once()
+				useCase.EXPECT().GetBakers(mock.Anything, mock.Anything).Return(domain.ApiResponse[domain.BakerResponseType]{}, nil).Once()
+			},
+			expectedMetadata: map[string]string{
+				"x-quota-limit":     "100",
+				"x-quota-remaining": "60",
+				"x-quota-reset":     "1717286400",
+			},
+		},
+		{
+			name: "Bearer_Key",
+			md:   metadata.Pairs("authorization", "Bearer dlg_key"),
+			setupMocks: func(keys *mocks.MockAPIKeyUseCase, useCase *mocks.MockUseCase) {
+				keys.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(domain.APIKey{ID: key.ID}, nil).Once()
+				keys.EXPECT().RecordUsage(mock.Anything, domain.APIKey{ID: key.ID}).Return(domain.APIKeyQuota{}, nil).Once()
+				useCase.EXPECT().GetBakers(mock.Anything, mock.Anything).Return(domain.ApiResponse[domain.BakerResponseType]{}, nil).Once()
+			},
+		},
+		{
+			name: "Invalid_Key",
+			md:   metadata.Pairs("x-api-key", "dlg_revoked"),
+			setupMocks: func(keys *mocks.MockAPIKeyUseCase, _ *mocks.MockUseCase) {
+				keys.EXPECT().Authenticate(mock.Anything, "dlg_revoked").Return(domain.APIKey{}, domain.ErrInvalidAPIKey).Once()
+			},
+			expectedCode:    codes.Unauthenticated,
+			expectedMessage: "invalid api key",
+		},
+		{
+			name: "Quota_Exceeded",
+			md:   metadata.Pairs("x-api-key", "dlg_key"),
+			setupMocks: func(keys *mocks.MockAPIKeyUseCase, _ *mocks.MockUseCase) {
+				keys.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(key, nil).Once()
+				keys.EXPECT().RecordUsage(mock.Anything, key).
+					Return(domain.APIKeyQuota{Limit: 100, Used: 101, Reset: reset}, domain.ErrQuotaExceeded).Once()
+			},
+			expectedCode:    codes.ResourceExhausted,
+			expectedMessage: "daily quota exceeded",
+			expectedMetadata: map[string]string{
+				"x-quota-limit":     "100",
+				"x-quota-remaining": "0",
+			},
+		},
+		{
+			name: "Authenticate_Error",
+			md:   metadata.Pairs("x-api-key", "dlg_key"),
+			setupMocks: func(keys *mocks.MockAPIKeyUseCase, _ *mocks.MockUseCase) {
+				keys.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(domain.APIKey{}, errors.New("db down")).Once()
+			},
+			expectedCode:    codes.Internal,
+			expectedMessage: "failed to authenticate api key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			keys := mocks.NewMockAPIKeyUseCase(t)
+			useCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(keys, useCase)
+			client, _ := newTestClient(t, useCase, WithAuthenticator(newTestAuthenticator(keys)))
+
+			var header metadata.MD
+			ctx := metadata.NewOutgoingContext(context.Background(), tt.md)
+			_, err := client.ListBakers(ctx, &delegatorv1.ListBakersRequest{}, grpc.Header(&header))
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, status.Convert(err).Message())
+			}
+			for name, value := range tt.expectedMetadata {
+				assert.Equal(t, []string{value}, header.Get(name), name)
+			}
+		})
+	}
+}
+
+func TestServer_Authenticate_Stream(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, mocks.NewMockUseCase(t), WithAuthenticator(newTestAuthenticator(mocks.NewMockAPIKeyUseCase(t))))
+
+	stream, err := client.WatchDelegations(context.Background(), &delegatorv1.WatchDelegationsRequest{})
+	require.NoError(t, err)
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServer_Authenticate_Health(t *testing.T) {
+	t.Parallel()
+
+	conn, _ := newTestConn(t,
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithAuthenticator(newTestAuthenticator(mocks.NewMockAPIKeyUseCase(t))),
+	)
+
+	res, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, res.GetStatus())
+}
+
+// newTestAuthenticator requires the API keys of useCase.
+func newTestAuthenticator(useCase domain.APIKeyUseCase) *auth.Authenticator {
+	return auth.NewAuthenticator(
+		auth.WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		auth.WithUseCase(useCase),
+		auth.WithRequired(true),
+	)
+}
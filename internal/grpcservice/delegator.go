@@ -0,0 +1,286 @@
+package grpcservice
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"delegator/pkg/pb/delegatorv1"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DelegatorService serves the delegator queries over gRPC, amounts are
+// rendered in mutez.
+type DelegatorService struct {
+	delegatorv1.UnimplementedDelegatorServiceServer
+
+	logger  *slog.Logger
+	useCase domain.UseCase
+}
+
+func (s *DelegatorService) ListDelegations(ctx context.Context, req *delegatorv1.ListDelegationsRequest) (*delegatorv1.ListDelegationsResponse, error) {
+	filter, err := parseDelegationFilter(req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	opts, err := parseResponseOptions(req.GetCurrency())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts.Expand = req.GetExpand()
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_size: must not be negative")
+	}
+	page := domain.Page{First: int(req.GetPageSize()), After: req.GetPageToken()}
+
+	res, err := s.useCase.GetDelegationsPage(ctx, filter, page, opts)
+	if errors.Is(err, domain.ErrInvalidCursor) {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	if err != nil {
+		s.logger.Warn("failed to get delegations", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get delegations")
+	}
+
+	delegations := make([]*delegatorv1.Delegation, len(res.Data))
+	for i, delegation := range res.Data {
+		delegations[i] = toDelegation(delegation)
+	}
+
+	var next string
+	if res.HasNextPage && len(res.Cursors) > 0 {
+		next = res.Cursors[len(res.Cursors)-1]
+	}
+	return &delegatorv1.ListDelegationsResponse{Delegations: delegations, NextPageToken: next}, nil
+}
+
+func (s *DelegatorService) ListBakers(ctx context.Context, _ *delegatorv1.ListBakersRequest) (*delegatorv1.ListBakersResponse, error) {
+	res, err := s.useCase.GetBakers(ctx, domain.ResponseOptions{Units: domain.UnitMutez})
+	if err != nil {
+		s.logger.Warn("failed to get bakers", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get bakers")
+	}
+
+	bakers := make([]*delegatorv1.Baker, len(res.Data))
+	for i, baker := range res.Data {
+		bakers[i] = toBaker(baker)
+	}
+	return &delegatorv1.ListBakersResponse{Bakers: bakers}, nil
+}
+
+func (s *DelegatorService) GetBaker(ctx context.Context, req *delegatorv1.GetBakerRequest) (*delegatorv1.GetBakerResponse, error) {
+	address, err := domain.ParseAddress(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	res, err := s.useCase.GetBaker(ctx, address, domain.ResponseOptions{Units: domain.UnitMutez})
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "baker not found")
+	}
+	if err != nil {
+		s.logger.Warn("failed to get baker", "error", err, "address", address)
+		return nil, status.Error(codes.Internal, "failed to get baker")
+	}
+
+	return &delegatorv1.GetBakerResponse{Baker: toBaker(res)}, nil
+}
+
+func (s *DelegatorService) WatchDelegations(req *delegatorv1.WatchDelegationsRequest, stream grpc.ServerStreamingServer[delegatorv1.WatchDelegationsResponse]) error {
+	filter, err := parseDelegationFilter(req.GetFilter())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !filter.Status.IsApplied() {
+		return status.Error(codes.InvalidArgument, "invalid status: only applied delegations are streamed")
+	}
+	filter.AfterOperationID = req.AfterOperationId
+
+	opts, err := parseResponseOptions(req.GetCurrency())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.useCase.WatchDelegations(stream.Context(), filter, opts, func(delegation domain.DelegationsResponseType) error {
+		return stream.Send(&delegatorv1.WatchDelegationsResponse{Delegation: toDelegation(delegation)})
+	})
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "delegation stream closed")
+	case errors.Is(err, domain.ErrSubscriptionClosed):
+		s.logger.Info("delegation stream subscriber fell behind, closing the stream")
+		return status.Error(codes.Aborted, "delegation stream subscriber fell behind")
+	case status.Code(err) != codes.Unknown:
+		// Sending failed with the status of the transport, the client is gone.
+		return err
+	default:
+		s.logger.Warn("failed to stream delegations", "error", err)
+		return status.Error(codes.Internal, "failed to stream delegations")
+	}
+}
+
+// parseDelegationFilter validates the delegation filters of a request, a missing
+// filter selects every applied delegation.
+func parseDelegationFilter(req *delegatorv1.DelegationFilter) (domain.DelegationFilter, error) {
+	var filter domain.DelegationFilter
+	if req == nil {
+		return filter, nil
+	}
+
+	if req.Delegator != nil {
+		delegator, err := domain.ParseAddress(req.GetDelegator())
+		if err != nil {
+			return filter, fmt.Errorf("invalid delegator: %w", err)
+		}
+		filter.Delegator = &delegator
+	}
+
+	if req.Baker != nil {
+		baker, err := domain.ParseAddress(req.GetBaker())
+		if err != nil {
+			return filter, fmt.Errorf("invalid baker: %w", err)
+		}
+		filter.Baker = &baker
+	}
+
+	if req.Cycle != nil {
+		if req.GetCycle() < 0 {
+			return filter, fmt.Errorf("invalid cycle: %d", req.GetCycle())
+		}
+		filter.Cycle = req.Cycle
+	}
+
+	s, err := domain.ParseOperationStatus(req.GetStatus())
+	if err != nil {
+		return filter, fmt.Errorf("invalid status: %w", err)
+	}
+	if s != domain.StatusApplied {
+		if filter.Cycle != nil {
+			return filter, errors.New("invalid status: only applied delegations are assigned a cycle")
+		}
+		filter.Status = s
+	}
+
+	return filter, nil
+}
+
+// parseResponseOptions validates the currency of a request, amounts are always in mutez.
+func parseResponseOptions(currency string) (domain.ResponseOptions, error) {
+	opts := domain.ResponseOptions{Units: domain.UnitMutez}
+	if currency == "" {
+		return opts, nil
+	}
+
+	c, err := domain.ParseCurrency(currency)
+	if err != nil {
+		return opts, fmt.Errorf("invalid currency: %w", err)
+	}
+	opts.Currency = c
+	return opts, nil
+}
+
+func toDelegation(delegation domain.DelegationsResponseType) *delegatorv1.Delegation {
+	res := &delegatorv1.Delegation{
+		Timestamp: timestamppb.New(delegation.Timestamp),
+		Amount:    int64(delegation.Amount.Mutez),
+		Delegator: delegation.Delegator.String(),
+		Level:     delegation.Level,
+		Cycle:     delegation.Cycle,
+		Status:    string(delegation.Status),
+		Errors:    delegation.Errors,
+	}
+
+	if delegation.FiatValue != nil {
+		res.FiatValue = &delegatorv1.FiatValue{
+			Currency: string(delegation.FiatValue.Currency),
+			Quote:    delegation.FiatValue.Quote,
+			Value:    delegation.FiatValue.Value,
+		}
+	}
+
+	if metadata := delegation.DelegationMetadata; metadata != nil {
+		res.Metadata = &delegatorv1.DelegationMetadata{
+			OperationId:         metadata.OperationID,
+			OperationHash:       metadata.OperationHash,
+			Block:               metadata.Block,
+			Counter:             metadata.Counter,
+			GasUsed:             toInt64(metadata.GasUsed),
+			Initiator:           toString(metadata.Initiator),
+			InitiatorAlias:      metadata.InitiatorAlias,
+			Nonce:               toInt64(metadata.Nonce),
+			StakingUpdatesCount: toInt64(metadata.StakingUpdatesCount),
+			SelfDelegation:      metadata.SelfDelegation,
+			DelegatorAlias:      metadata.DelegatorAlias,
+			Baker:               toString(metadata.Baker),
+			BakerAlias:          metadata.BakerAlias,
+			PreviousBaker:       toString(metadata.PreviousBaker),
+			PreviousBakerAlias:  metadata.PreviousBakerAlias,
+		}
+		if metadata.BakerFee != nil {
+			fee := int64(metadata.BakerFee.Mutez)
+			res.Metadata.BakerFee = &fee
+		}
+	}
+
+	return res
+}
+
+func toBaker(baker domain.BakerResponseType) *delegatorv1.Baker {
+	return &delegatorv1.Baker{
+		Address:                  baker.Address.String(),
+		Alias:                    baker.Alias,
+		FirstSeen:                timestamppb.New(baker.FirstSeen),
+		LastSeen:                 timestamppb.New(baker.LastSeen),
+		Active:                   baker.Active,
+		RegistrationLevel:        baker.RegistrationLevel,
+		RegistrationTimestamp:    toTimestamp(baker.RegistrationTimestamp),
+		DeactivationLevel:        baker.DeactivationLevel,
+		DeactivationTimestamp:    toTimestamp(baker.DeactivationTimestamp),
+		TotalDelegationsReceived: baker.TotalDelegationsReceived,
+		Delegators:               baker.Delegators,
+		DelegatedAmount:          int64(baker.DelegatedAmount.Mutez),
+		Stakers:                  baker.Stakers,
+		StakedAmount:             int64(baker.StakedAmount.Mutez),
+	}
+}
+
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toString(address *domain.Address) *string {
+	if address == nil {
+		return nil
+	}
+	s := address.String()
+	return &s
+}
+
+func toInt64(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
+
+// RegisterDelegatorService registers the delegator service on a server.
+func RegisterDelegatorService(logger *slog.Logger, useCase domain.UseCase) func(grpc.ServiceRegistrar) {
+	return func(registrar grpc.ServiceRegistrar) {
+		delegatorv1.RegisterDelegatorServiceServer(registrar, &DelegatorService{logger: logger, useCase: useCase})
+	}
+}
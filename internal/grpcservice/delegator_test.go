@@ -0,0 +1,403 @@
+package grpcservice
+
+import (
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"delegator/pkg/pb/delegatorv1"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient serves the delegator service over an in-memory connection.
+func newTestClient(t *testing.T, useCase domain.UseCase, opts ...Options) (delegatorv1.DelegatorServiceClient, *Server) {
+	t.Helper()
+
+	client, s := newTestConn(t, append([]Options{
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithServices(RegisterDelegatorService(slog.New(slog.NewJSONHandler(os.Stdout, nil)), useCase)),
+	}, opts...)...)
+	return delegatorv1.NewDelegatorServiceClient(client), s
+}
+
+// newTestConn serves the server of the options over an in-memory connection.
+func newTestConn(t *testing.T, opts ...Options) (*grpc.ClientConn, *Server) {
+	t.Helper()
+
+	s := NewGRPCServer(opts...)
+
+	listener := bufconn.Listen(1 << 20)
+	go func() { _ = s.server.Serve(listener) }()
+	t.Cleanup(s.server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn, s
+}
+
+func TestDelegatorService_ListDelegations(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	cycle := int64(700)
+	operationID := int64(42)
+	timestamp := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		req          *delegatorv1.ListDelegationsRequest
+		setupMocks   func(*mocks.MockUseCase)
+		expectedCode codes.Code
+		check        func(*testing.T, *delegatorv1.ListDelegationsResponse)
+	}{
+		{
+			name: "Success",
+			req: &delegatorv1.ListDelegationsRequest{
+				Filter:   &delegatorv1.DelegationFilter{Delegator: (*string)(&delegator), Cycle: &cycle},
+				Currency: "eur",
+				Expand:   true,
+			},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything,
+					domain.DelegationFilter{Delegator: &delegator, Cycle: &cycle},
+					domain.Page{},
+					domain.ResponseOptions{Units: domain.UnitMutez, Currency: domain.CurrencyEUR, Expand: true},
+				).Return(domain.PageResponse[domain.DelegationsResponseType]{
+					Data: []domain.DelegationsResponseType{{
+						Timestamp:          timestamp,
+						Amount:             domain.NewAmount(1500000, domain.UnitMutez),
+						Delegator:          delegator,
+						Level:              100,
+						Cycle:              &cycle,
+						FiatValue:          &domain.FiatValue{Currency: domain.CurrencyEUR, Quote: 2, Value: 3},
+						DelegationMetadata: &domain.DelegationMetadata{OperationID: &operationID, Baker: &baker},
+					}},
+					Cursors: []string{"first"},
+				}, nil).Once()
+			},
+			check: func(t *testing.T, res *delegatorv1.ListDelegationsResponse) {
+				require.Len(t, res.GetDelegations(), 1)
+				delegation := res.GetDelegations()[0]
+				assert.Equal(t, int64(1500000), delegation.GetAmount())
+				assert.Equal(t, delegator.String(), delegation.GetDelegator())
+				assert.Equal(t, timestamp, delegation.GetTimestamp().AsTime())
+				assert.Equal(t, cycle, delegation.GetCycle())
+				assert.Equal(t, 3.0, delegation.GetFiatValue().GetValue())
+				assert.Equal(t, operationID, delegation.GetMetadata().GetOperationId())
+				assert.Equal(t, baker.String(), delegation.GetMetadata().GetBaker())
+				assert.Nil(t, delegation.GetMetadata().PreviousBaker)
+				assert.Empty(t, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "Next_Page",
+			req:  &delegatorv1.ListDelegationsRequest{PageSize: 2, PageToken: "first"},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, domain.DelegationFilter{}, domain.Page{First: 2, After: "first"}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{
+						Data:        []domain.DelegationsResponseType{{Level: 90}, {Level: 80}},
+						Cursors:     []string{"second", "third"},
+						HasNextPage: true,
+					}, nil).Once()
+			},
+			check: func(t *testing.T, res *delegatorv1.ListDelegationsResponse) {
+				require.Len(t, res.GetDelegations(), 2)
+				assert.Equal(t, "third", res.GetNextPageToken())
+			},
+		},
+		{
+			name: "Invalid_Page_Token",
+			req:  &delegatorv1.ListDelegationsRequest{PageToken: "nope"},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{}, domain.ErrInvalidCursor).Once()
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Negative_Page_Size",
+			req:          &delegatorv1.ListDelegationsRequest{PageSize: -1},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Failed_Status",
+			req:  &delegatorv1.ListDelegationsRequest{Filter: &delegatorv1.DelegationFilter{Status: "failed"}},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, domain.DelegationFilter{Status: domain.StatusFailed}, domain.Page{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{
+						Data: []domain.DelegationsResponseType{{Status: domain.StatusFailed, Errors: []string{"delegate.unchanged"}}},
+					}, nil).Once()
+			},
+			check: func(t *testing.T, res *delegatorv1.ListDelegationsResponse) {
+				require.Len(t, res.GetDelegations(), 1)
+				assert.Equal(t, "failed", res.GetDelegations()[0].GetStatus())
+				assert.Equal(t, []string{"delegate.unchanged"}, res.GetDelegations()[0].GetErrors())
+				assert.Nil(t, res.GetDelegations()[0].GetMetadata())
+			},
+		},
+		{
+			name:         "Invalid_Baker",
+			req:          &delegatorv1.ListDelegationsRequest{Filter: &delegatorv1.DelegationFilter{Baker: stringPtr("tz1nope")}},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid_Status",
+			req:          &delegatorv1.ListDelegationsRequest{Filter: &delegatorv1.DelegationFilter{Status: "pending"}},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Failed_Status_With_Cycle",
+			req:          &delegatorv1.ListDelegationsRequest{Filter: &delegatorv1.DelegationFilter{Status: "failed", Cycle: &cycle}},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid_Currency",
+			req:          &delegatorv1.ListDelegationsRequest{Currency: "chf"},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Use_Case_Error",
+			req:  &delegatorv1.ListDelegationsRequest{},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			client, _ := newTestClient(t, mockUseCase)
+
+			res, err := client.ListDelegations(context.Background(), tt.req)
+			if tt.expectedCode != codes.OK {
+				assert.Equal(t, tt.expectedCode, status.Code(err))
+				assert.NotContains(t, err.Error(), "db down")
+				return
+			}
+			require.NoError(t, err)
+			tt.check(t, res)
+		})
+	}
+}
+
+func TestDelegatorService_Bakers(t *testing.T) {
+	t.Parallel()
+
+	address := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	registered := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	baker := domain.BakerResponseType{
+		Address:               address,
+		Active:                true,
+		RegistrationTimestamp: &registered,
+		Delegators:            2,
+		DelegatedAmount:       domain.NewAmount(2500000, domain.UnitMutez),
+		StakedAmount:          domain.NewAmount(6000000000, domain.UnitMutez),
+	}
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		mockUseCase := mocks.NewMockUseCase(t)
+		mockUseCase.EXPECT().GetBakers(mock.Anything, domain.ResponseOptions{Units: domain.UnitMutez}).
+			Return(domain.ApiResponse[domain.BakerResponseType]{Data: []domain.BakerResponseType{baker}}, nil).Once()
+		client, _ := newTestClient(t, mockUseCase)
+
+		res, err := client.ListBakers(context.Background(), &delegatorv1.ListBakersRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.GetBakers(), 1)
+		assert.Equal(t, address.String(), res.GetBakers()[0].GetAddress())
+		assert.Equal(t, int64(2500000), res.GetBakers()[0].GetDelegatedAmount())
+		assert.Equal(t, int64(6000000000), res.GetBakers()[0].GetStakedAmount())
+		assert.Equal(t, registered, res.GetBakers()[0].GetRegistrationTimestamp().AsTime())
+		assert.Nil(t, res.GetBakers()[0].GetDeactivationTimestamp())
+	})
+
+	tests := []struct {
+		name         string
+		address      string
+		setupMocks   func(*mocks.MockUseCase)
+		expectedCode codes.Code
+	}{
+		{
+			name:    "Get",
+			address: address.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBaker(mock.Anything, address, domain.ResponseOptions{Units: domain.UnitMutez}).Return(baker, nil).Once()
+			},
+		},
+		{
+			name:    "Get_Not_Found",
+			address: address.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBaker(mock.Anything, address, mock.Anything).Return(domain.BakerResponseType{}, domain.ErrNotFound).Once()
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:         "Get_Invalid_Address",
+			address:      "tz1nope",
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:    "Get_Error",
+			address: address.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBaker(mock.Anything, address, mock.Anything).Return(domain.BakerResponseType{}, errors.New("db down")).Once()
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			client, _ := newTestClient(t, mockUseCase)
+
+			res, err := client.GetBaker(context.Background(), &delegatorv1.GetBakerRequest{Address: tt.address})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, address.String(), res.GetBaker().GetAddress())
+			}
+		})
+	}
+}
+
+func TestDelegatorService_WatchDelegations(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	after := int64(41)
+	operationID := int64(42)
+
+	tests := []struct {
+		name         string
+		req          *delegatorv1.WatchDelegationsRequest
+		setupMocks   func(*mocks.MockUseCase)
+		expectedIDs  []int64
+		expectedCode codes.Code
+	}{
+		{
+			name: "Resumes_After_Operation",
+			req: &delegatorv1.WatchDelegationsRequest{
+				Filter:           &delegatorv1.DelegationFilter{Baker: (*string)(&baker)},
+				AfterOperationId: &after,
+			},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().WatchDelegations(mock.Anything, domain.DelegationFilter{Baker: &baker, AfterOperationID: &after}, domain.ResponseOptions{Units: domain.UnitMutez}, mock.Anything).
+					RunAndReturn(func(ctx context.Context, _ domain.DelegationFilter, _ domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+						if err := fn(domain.DelegationsResponseType{DelegationMetadata: &domain.DelegationMetadata{OperationID: &operationID}}); err != nil {
+							return err
+						}
+						return domain.ErrSubscriptionClosed
+					}).Once()
+			},
+			expectedIDs:  []int64{42},
+			expectedCode: codes.Aborted,
+		},
+		{
+			name:         "Failed_Status",
+			req:          &delegatorv1.WatchDelegationsRequest{Filter: &delegatorv1.DelegationFilter{Status: "failed"}},
+			setupMocks:   func(m *mocks.MockUseCase) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "Use_Case_Error",
+			req:  &delegatorv1.WatchDelegationsRequest{},
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().WatchDelegations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db down")).Once()
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			client, _ := newTestClient(t, mockUseCase)
+
+			stream, err := client.WatchDelegations(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			var ids []int64
+			for {
+				res, err := stream.Recv()
+				if err != nil {
+					assert.NotErrorIs(t, err, io.EOF)
+					assert.Equal(t, tt.expectedCode, status.Code(err))
+					break
+				}
+				ids = append(ids, res.GetDelegation().GetMetadata().GetOperationId())
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
+func TestServer_Shutdown_EndsStreams(t *testing.T) {
+	t.Parallel()
+
+	mockUseCase := mocks.NewMockUseCase(t)
+	watching := make(chan struct{})
+	mockUseCase.EXPECT().WatchDelegations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, _ domain.DelegationFilter, _ domain.ResponseOptions, _ func(domain.DelegationsResponseType) error) error {
+			close(watching)
+			<-ctx.Done()
+			return ctx.Err()
+		}).Once()
+	client, server := newTestClient(t, mockUseCase)
+
+	stream, err := client.WatchDelegations(context.Background(), &delegatorv1.WatchDelegationsRequest{})
+	require.NoError(t, err)
+	<-watching
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, server.Shutdown(ctx))
+
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Canceled, status.Code(err))
+}
+
+func stringPtr(v string) *string {
+	return &v
+}
@@ -0,0 +1,148 @@
+package grpcservice
+
+import (
+	"context"
+	"delegator/conf"
+	"delegator/internal/httpservice/auth"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// Server serves the gRPC services next to the HTTP server.
+type Server struct {
+	logger *slog.Logger
+
+	addr          string
+	server        *grpc.Server
+	health        *health.Server
+	reflection    bool
+	authenticator *auth.Authenticator
+	services      []func(grpc.ServiceRegistrar)
+
+	// stopping is closed on shutdown so that the open streams end, a graceful
+	// stop would wait for them otherwise.
+	stopping chan struct{}
+	stopOnce sync.Once
+}
+
+type Options func(*Server)
+
+func WithLogger(logger *slog.Logger) Options {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithGRPCServer listens on the configured gRPC port, and serves reflection
+// when it is enabled.
+func WithGRPCServer(conf *conf.DelegatorConfig) Options {
+	return func(s *Server) {
+		s.addr = ":" + strconv.Itoa(conf.GRPC.Port)
+		s.reflection = conf.GRPC.Reflection
+	}
+}
+
+// WithServices registers services on the server.
+func WithServices(register func(grpc.ServiceRegistrar)) Options {
+	return func(s *Server) {
+		s.services = append(s.services, register)
+	}
+}
+
+func (s *Server) Run(ctx context.Context) error {
+	listener, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.addr, err)
+	}
+
+	s.logger.Info("starting grpc server", "addr", listener.Addr().String())
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil {
+			s.logger.Warn("grpc server stopped", "error", err)
+		}
+	}()
+
+	return nil
+}
+
+// Shutdown ends the open streams and waits for the pending calls, until ctx
+// is done.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.health.Shutdown()
+	s.stopOnce.Do(func() { close(s.stopping) })
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		s.logger.Warn("grpc server failed to shutdown gracefully", "error", ctx.Err())
+		return ctx.Err()
+	}
+}
+
+// endOnShutdown cancels the context of the streams when the server shuts down.
+func (s *Server) endOnShutdown(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-s.stopping:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// NewGRPCServer creates the server with the health service, the reflection
+// service when it is enabled and the services of the options.
+func NewGRPCServer(opts ...Options) *Server {
+	s := &Server{
+		health:   health.NewServer(),
+		stopping: make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.authenticateUnary),
+		grpc.ChainStreamInterceptor(s.authenticateStream, s.endOnShutdown),
+	)
+	healthpb.RegisterHealthServer(s.server, s.health)
+	if s.reflection {
+		reflection.Register(s.server)
+	}
+	for _, register := range s.services {
+		register(s.server)
+	}
+
+	return s
+}
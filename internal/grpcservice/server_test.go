@@ -0,0 +1,41 @@
+package grpcservice
+
+import (
+	"delegator/conf"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGRPCServer_Reflection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		reflection bool
+	}{
+		{name: "Disabled"},
+		{name: "Enabled", reflection: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var config conf.DelegatorConfig
+			config.GRPC.Reflection = tt.reflection
+
+			s := NewGRPCServer(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithGRPCServer(&config),
+			)
+
+			services := s.server.GetServiceInfo()
+			assert.Contains(t, services, "grpc.health.v1.Health")
+			_, ok := services["grpc.reflection.v1.ServerReflection"]
+			assert.Equal(t, tt.reflection, ok)
+		})
+	}
+}
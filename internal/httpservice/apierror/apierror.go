@@ -0,0 +1,86 @@
+// Package apierror renders the errors of the HTTP API in a single envelope:
+//
+//	{"error": {"code": "not_found", "message": "baker not found", "request_id": "...", "details": {...}}}
+package apierror
+
+import (
+	"delegator/pkg/domain"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// requestIDHeader is the response header middleware.RequestID sets the request id in.
+const requestIDHeader = "X-Request-ID"
+
+// Response is the body of every error response.
+type Response struct {
+	Error Body `json:"error"`
+}
+
+// Body describes the error, Details is set by the codes that have any.
+type Body struct {
+	Code      domain.ErrorCode `json:"code"`
+	Message   string           `json:"message"`
+	RequestID string           `json:"request_id,omitempty"`
+	Details   map[string]any   `json:"details,omitempty"`
+}
+
+// statuses maps the error codes to their HTTP status.
+var statuses = map[domain.ErrorCode]int{
+	domain.CodeInvalidArgument:   http.StatusBadRequest,
+	domain.CodeUnauthenticated:   http.StatusUnauthorized,
+	domain.CodePermissionDenied:  http.StatusForbidden,
+	domain.CodeNotFound:          http.StatusNotFound,
+	domain.CodeMethodNotAllowed:  http.StatusMethodNotAllowed,
+	domain.CodeNotAcceptable:     http.StatusNotAcceptable,
+	domain.CodePayloadTooLarge:   http.StatusRequestEntityTooLarge,
+	domain.CodeResourceExhausted: http.StatusTooManyRequests,
+	domain.CodeInternal:          http.StatusInternalServerError,
+	domain.CodeUnavailable:       http.StatusServiceUnavailable,
+}
+
+// Status returns the HTTP status of a code, 500 for unknown codes.
+func Status(code domain.ErrorCode) int {
+	if status, ok := statuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// Abort answers the request with err and stops the handler chain. An error that
+// is not a *domain.APIError is an internal error whose message is not returned.
+// The error is attached to the context, so that the access log reports its cause.
+func Abort(c *gin.Context, err error) {
+	var apiErr *domain.APIError
+	if !errors.As(err, &apiErr) {
+		apiErr = domain.Internal("internal error", err)
+	}
+	_ = c.Error(err)
+
+	c.AbortWithStatusJSON(Status(apiErr.Code), New(c, apiErr))
+}
+
+// New returns the envelope of err for the request.
+func New(c *gin.Context, err *domain.APIError) Response {
+	return Response{Error: Body{
+		Code:      err.Code,
+		Message:   err.Message,
+		RequestID: c.Writer.Header().Get(requestIDHeader),
+		Details:   err.Details,
+	}}
+}
+
+// NoRoute answers the requests matching no route, instead of the plain text 404 of gin.
+func NoRoute(c *gin.Context) {
+	Abort(c, domain.NewError(domain.CodeNotFound, "route not found").WithDetail("path", c.Request.URL.Path))
+}
+
+// NoMethod answers the requests to a route that does not serve their method,
+// gin sets the Allow header beforehand.
+func NoMethod(c *gin.Context) {
+	Abort(c, domain.NewError(domain.CodeMethodNotAllowed, "method not allowed").
+		WithDetail("method", c.Request.Method).
+		WithDetail("path", c.Request.URL.Path))
+}
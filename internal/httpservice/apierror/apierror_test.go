@@ -0,0 +1,109 @@
+package apierror
+
+import (
+	"delegator/pkg/domain"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAbort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Invalid_Argument",
+			err:            domain.InvalidArgument(errors.New("invalid limit")),
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"invalid_argument","message":"invalid limit","request_id":"req-1"}}`,
+		},
+		{
+			name:           "Not_Found_With_Details",
+			err:            domain.NotFound("baker not found").WithDetail("address", "tz1abc"),
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"error":{"code":"not_found","message":"baker not found","request_id":"req-1","details":{"address":"tz1abc"}}}`,
+		},
+		{
+			name:           "Unavailable",
+			err:            domain.Internal("failed to get delegations", &timeoutError{}),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   `{"error":{"code":"unavailable","message":"failed to get delegations","request_id":"req-1"}}`,
+		},
+		{
+			name:           "Cause_Hidden",
+			err:            errors.New("pq: password authentication failed"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"internal","message":"internal error","request_id":"req-1"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/xtz/delegations", nil)
+			c.Header(requestIDHeader, "req-1")
+
+			Abort(c, tt.err)
+
+			assert.True(t, c.IsAborted())
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.JSONEq(t, tt.expectedBody, w.Body.String())
+			assert.Len(t, c.Errors, 1)
+		})
+	}
+}
+
+func TestNoRoute(t *testing.T) {
+	t.Parallel()
+
+	engine := gin.New()
+	engine.NoRoute(NoRoute)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/xtz/unknown", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.JSONEq(t, `{"error":{"code":"not_found","message":"route not found","details":{"path":"/xtz/unknown"}}}`, w.Body.String())
+}
+
+func TestNoMethod(t *testing.T) {
+	t.Parallel()
+
+	engine := gin.New()
+	engine.HandleMethodNotAllowed = true
+	engine.NoMethod(NoMethod)
+	engine.GET("/xtz/delegations", func(c *gin.Context) {})
+	engine.POST("/xtz/delegations", func(c *gin.Context) {})
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/xtz/delegations", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "GET, POST", w.Header().Get("Allow"))
+	assert.JSONEq(t, `{"error":{"code":"method_not_allowed","message":"method not allowed","details":{"method":"DELETE","path":"/xtz/delegations"}}}`, w.Body.String())
+}
+
+func TestStatus_Unknown(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, http.StatusInternalServerError, Status("unknown"))
+}
+
+// timeoutError is a net.Error, as returned by a dropped database connection.
+type timeoutError struct{}
+
+func (*timeoutError) Error() string   { return "i/o timeout" }
+func (*timeoutError) Timeout() bool   { return true }
+func (*timeoutError) Temporary() bool { return true }
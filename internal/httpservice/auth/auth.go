@@ -0,0 +1,252 @@
+// Package auth authenticates the API requests with the issued API keys and
+// enforces their rate limits and daily quotas.
+package auth
+
+import (
+	"context"
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/time/rate"
+)
+
+// Header is the header API keys are sent in, an Authorization bearer token is
+// accepted as well.
+const Header = "X-API-Key"
+
+// The headers describing the daily quota of the key, Reset is a Unix time.
+const (
+	QuotaLimitHeader     = "X-Quota-Limit"
+	QuotaRemainingHeader = "X-Quota-Remaining"
+	QuotaResetHeader     = "X-Quota-Reset"
+)
+
+// contextKey holds the domain.APIKey of an authenticated request.
+const contextKey = "api_key"
+
+// pruneInterval is how often the full token buckets are dropped. A full bucket
+// is the same as a new one, so the keys that are no longer used, revoked ones
+// included, do not keep a limiter.
+const pruneInterval = time.Minute
+
+// Authenticator authenticates the requests made with an API key. Requests
+// without a key are anonymous unless keys are required.
+type Authenticator struct {
+	logger   *slog.Logger
+	useCase  domain.APIKeyUseCase
+	required bool
+	public   []string
+
+	now func() time.Time
+
+	mu         sync.Mutex
+	limiters   map[uuid.UUID]keyLimiter
+	lastPruned time.Time
+}
+
+// keyLimiter is the token bucket of a key, along with the limits it was built
+// with.
+type keyLimiter struct {
+	*rate.Limiter
+	rateLimit float64
+	rateBurst int
+}
+
+type Options func(*Authenticator)
+
+func WithLogger(logger *slog.Logger) Options {
+	return func(a *Authenticator) {
+		a.logger = logger
+	}
+}
+
+func WithUseCase(useCase domain.APIKeyUseCase) Options {
+	return func(a *Authenticator) {
+		a.useCase = useCase
+	}
+}
+
+// WithRequired rejects the requests without an API key.
+func WithRequired(required bool) Options {
+	return func(a *Authenticator) {
+		a.required = required
+	}
+}
+
+// WithPublicRoutes leaves the routes under the paths unauthenticated, e.g.
+// /admin covers /admin/api-keys/:id.
+func WithPublicRoutes(paths ...string) Options {
+	return func(a *Authenticator) {
+		a.public = append(a.public, paths...)
+	}
+}
+
+// Middleware authenticates the request, then applies the rate limit and the
+// daily quota of its key. Throttled requests do not count toward the quota.
+func (a *Authenticator) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// Unmatched requests are left to NoRoute and NoMethod, so that an unknown
+		// path answers 404 or 405 rather than 401.
+		if c.FullPath() == "" || a.isPublic(c.FullPath()) {
+			c.Next()
+			return
+		}
+
+		key, quota, err := a.Check(c, extractKey(c.Request))
+		if quota.Limit > 0 {
+			c.Header(QuotaLimitHeader, strconv.FormatInt(quota.Limit, 10))
+			c.Header(QuotaRemainingHeader, strconv.FormatInt(quota.Remaining(), 10))
+			c.Header(QuotaResetHeader, strconv.FormatInt(quota.Reset.Unix(), 10))
+		}
+		if err != nil {
+			var apiErr *domain.APIError
+			if errors.As(err, &apiErr) && apiErr.Details["retry_after"] != nil {
+				c.Header("Retry-After", fmt.Sprint(apiErr.Details["retry_after"]))
+			}
+			apierror.Abort(c, err)
+			return
+		}
+
+		if key.ID != uuid.Nil {
+			c.Set(contextKey, key)
+		}
+		c.Next()
+	}
+}
+
+// Check authenticates a raw API key, then applies the rate limit and the daily
+// quota of the key, so that every transport enforces them alike. An empty raw
+// key is anonymous, a zero key is returned unless keys are required. The quota
+// is returned along with the error of an exceeded quota, errors are
+// *domain.APIError.
+func (a *Authenticator) Check(ctx context.Context, raw string) (domain.APIKey, domain.APIKeyQuota, error) {
+	if raw == "" {
+		if a.required {
+			return domain.APIKey{}, domain.APIKeyQuota{}, domain.NewError(domain.CodeUnauthenticated, "missing api key")
+		}
+		return domain.APIKey{}, domain.APIKeyQuota{}, nil
+	}
+
+	key, err := a.useCase.Authenticate(ctx, raw)
+	if errors.Is(err, domain.ErrInvalidAPIKey) {
+		return domain.APIKey{}, domain.APIKeyQuota{}, domain.NewError(domain.CodeUnauthenticated, "invalid api key")
+	}
+	if err != nil {
+		a.logger.Warn("failed to authenticate api key", "error", err)
+		return domain.APIKey{}, domain.APIKeyQuota{}, domain.Internal("failed to authenticate api key", err)
+	}
+
+	if !a.allow(key) {
+		return domain.APIKey{}, domain.APIKeyQuota{}, domain.NewError(domain.CodeResourceExhausted, "rate limit exceeded").
+			WithDetail("retry_after", 1)
+	}
+
+	quota, err := a.useCase.RecordUsage(ctx, key)
+	if errors.Is(err, domain.ErrQuotaExceeded) {
+		return domain.APIKey{}, quota, domain.NewError(domain.CodeResourceExhausted, "daily quota exceeded").
+			WithDetail("limit", quota.Limit).
+			WithDetail("reset", quota.Reset.Unix())
+	}
+	if err != nil {
+		a.logger.Warn("failed to record api key usage", "error", err, "id", key.ID)
+		return domain.APIKey{}, domain.APIKeyQuota{}, domain.Internal("failed to record api key usage", err)
+	}
+
+	return key, quota, nil
+}
+
+// FromContext returns the API key the request was authenticated with.
+func FromContext(c *gin.Context) (domain.APIKey, bool) {
+	value, ok := c.Get(contextKey)
+	if !ok {
+		return domain.APIKey{}, false
+	}
+	key, ok := value.(domain.APIKey)
+	return key, ok
+}
+
+func (a *Authenticator) isPublic(route string) bool {
+	for _, path := range a.public {
+		if route == path || strings.HasPrefix(route, path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// allow takes a token from the bucket of the key, keys without a rate limit are
+// always allowed. The bucket is rebuilt when the limits of the key change.
+func (a *Authenticator) allow(key domain.APIKey) bool {
+	now := a.now()
+
+	a.mu.Lock()
+	a.prune(now)
+	if key.RateLimit <= 0 {
+		delete(a.limiters, key.ID)
+		a.mu.Unlock()
+		return true
+	}
+
+	limiter, ok := a.limiters[key.ID]
+	if !ok || limiter.rateLimit != key.RateLimit || limiter.rateBurst != key.RateBurst {
+		limiter = keyLimiter{
+			Limiter:   rate.NewLimiter(rate.Limit(key.RateLimit), max(key.RateBurst, 1)),
+			rateLimit: key.RateLimit,
+			rateBurst: key.RateBurst,
+		}
+		a.limiters[key.ID] = limiter
+	}
+	a.mu.Unlock()
+
+	return limiter.AllowN(now, 1)
+}
+
+// prune drops the full buckets every pruneInterval, a.mu must be held.
+func (a *Authenticator) prune(now time.Time) {
+	if now.Sub(a.lastPruned) < pruneInterval {
+		return
+	}
+	a.lastPruned = now
+
+	for id, limiter := range a.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(a.limiters, id)
+		}
+	}
+}
+
+// extractKey returns the key of the X-API-Key header, or of a bearer
+// Authorization header.
+func extractKey(r *http.Request) string {
+	if key := r.Header.Get(Header); key != "" {
+		return key
+	}
+
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if ok && strings.EqualFold(scheme, "Bearer") {
+		return strings.TrimSpace(token)
+	}
+	return ""
+}
+
+func NewAuthenticator(opts ...Options) *Authenticator {
+	a := &Authenticator{
+		now:      time.Now,
+		limiters: make(map[uuid.UUID]keyLimiter),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
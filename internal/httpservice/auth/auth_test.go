@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	t.Parallel()
+
+	key := domain.APIKey{ID: uuid.New(), Name: "partner", DailyQuota: 100}
+	reset := time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		required        bool
+		path            string
+		headers         map[string]string
+		setupMocks      func(*mocks.MockAPIKeyUseCase)
+		expectedStatus  int
+		expectedBody    string
+		expectedHeaders map[string]string
+	}{
+		{
+			name:           "Anonymous",
+			path:           "/xtz/delegations",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "anonymous",
+		},
+		{
+			name:           "Anonymous_Rejected_When_Required",
+			required:       true,
+			path:           "/xtz/delegations",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"unauthenticated","message":"missing api key"}}`,
+		},
+		{
+			name:           "Public_Route",
+			required:       true,
+			path:           "/health",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "Header_Key",
+			required: true,
+			path:     "/xtz/delegations",
+			headers:  map[string]string{Header: "dlg_key"},
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(key, nil).Once()
+				m.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{Limit: 100, Used: 40, Reset: reset}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "partner",
+			expectedHeaders: map[string]string{
+				QuotaLimitHeader:     "100",
+				QuotaRemainingHeader: "60",
+				QuotaResetHeader:     "1717286400",
+			},
+		},
+		{
+			name:    "Bearer_Key",
+			path:    "/xtz/delegations",
+			headers: map[string]string{"Authorization": "Bearer dlg_key"},
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(domain.APIKey{ID: key.ID, Name: "partner"}, nil).Once()
+				m.EXPECT().RecordUsage(mock.Anything, mock.Anything).Return(domain.APIKeyQuota{Used: 1, Reset: reset}, nil).Once()
+			},
+			expectedStatus:  http.StatusOK,
+			expectedBody:    "partner",
+			expectedHeaders: map[string]string{QuotaLimitHeader: ""},
+		},
+		{
+			name:    "Invalid_Key_Rejected_When_Optional",
+			path:    "/xtz/delegations",
+			headers: map[string]string{Header: "dlg_revoked"},
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().Authenticate(mock.Anything, "dlg_revoked").Return(domain.APIKey{}, domain.ErrInvalidAPIKey).Once()
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   `{"error":{"code":"unauthenticated","message":"invalid api key"}}`,
+		},
+		{
+			name:    "Quota_Exceeded",
+			path:    "/xtz/delegations",
+			headers: map[string]string{Header: "dlg_key"},
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(key, nil).Once()
+				m.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{Limit: 100, Used: 101, Reset: reset}, domain.ErrQuotaExceeded).Once()
+			},
+			expectedStatus:  http.StatusTooManyRequests,
+			expectedBody:    `{"error":{"code":"resource_exhausted","message":"daily quota exceeded"`,
+			expectedHeaders: map[string]string{QuotaRemainingHeader: "0"},
+		},
+		{
+			name:    "Usage_Error",
+			path:    "/xtz/delegations",
+			headers: map[string]string{Header: "dlg_key"},
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().Authenticate(mock.Anything, "dlg_key").Return(key, nil).Once()
+				m.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"internal","message":"failed to record api key usage"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockAPIKeyUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			router := newTestRouter(NewAuthenticator(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithUseCase(mockUseCase),
+				WithRequired(tt.required),
+				WithPublicRoutes("/health"),
+			))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			for name, value := range tt.headers {
+				req.Header.Set(name, value)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+			for name, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(name), name)
+			}
+		})
+	}
+}
+
+func TestAuthenticator_Middleware_Unmatched(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "Unknown_Route",
+			method:         http.MethodGet,
+			path:           "/xtz/unknown",
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Unknown_Method",
+			method:         http.MethodPost,
+			path:           "/xtz/delegations",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := newTestRouter(NewAuthenticator(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithUseCase(mocks.NewMockAPIKeyUseCase(t)),
+				WithRequired(true),
+			))
+			router.HandleMethodNotAllowed = true
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestAuthenticator_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	limited := domain.APIKey{ID: uuid.New(), RateLimit: 0.001, RateBurst: 2}
+	other := domain.APIKey{ID: uuid.New(), RateLimit: 0.001, RateBurst: 1}
+
+	mockUseCase := mocks.NewMockAPIKeyUseCase(t)
+	mockUseCase.EXPECT().Authenticate(mock.Anything, "dlg_limited").Return(limited, nil).Times(3)
+	mockUseCase.EXPECT().Authenticate(mock.Anything, "dlg_other").Return(other, nil).Once()
+	// The throttled request is not counted toward the quota.
+	mockUseCase.EXPECT().RecordUsage(mock.Anything, limited).Return(domain.APIKeyQuota{}, nil).Twice()
+	mockUseCase.EXPECT().RecordUsage(mock.Anything, other).Return(domain.APIKeyQuota{}, nil).Once()
+
+	router := newTestRouter(NewAuthenticator(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithUseCase(mockUseCase),
+	))
+
+	var statuses []int
+	for _, key := range []string{"dlg_limited", "dlg_limited", "dlg_limited", "dlg_other"} {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/xtz/delegations", nil)
+		req.Header.Set(Header, key)
+		router.ServeHTTP(w, req)
+		statuses = append(statuses, w.Code)
+	}
+
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests, http.StatusOK}, statuses)
+}
+
+func TestAuthenticator_Limiters(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	a := NewAuthenticator()
+	a.now = func() time.Time { return now }
+
+	key := domain.APIKey{ID: uuid.New(), RateLimit: 1, RateBurst: 1}
+	assert.True(t, a.allow(key))
+	assert.False(t, a.allow(key))
+
+	// Raising the burst of the key rebuilds its bucket.
+	key.RateBurst = 2
+	assert.True(t, a.allow(key))
+	assert.True(t, a.allow(key))
+	assert.False(t, a.allow(key))
+
+	// A key that is no longer used, e.g. revoked, is dropped once its bucket is full.
+	now = now.Add(pruneInterval)
+	other := domain.APIKey{ID: uuid.New(), RateLimit: 1, RateBurst: 1}
+	assert.True(t, a.allow(other))
+	assert.NotContains(t, a.limiters, key.ID)
+	assert.Contains(t, a.limiters, other.ID)
+
+	// A key without a rate limit has no bucket.
+	other.RateLimit = 0
+	assert.True(t, a.allow(other))
+	assert.Empty(t, a.limiters)
+}
+
+// newTestRouter serves the name of the API key of the requests on a data route
+// and /health.
+func newTestRouter(a *Authenticator) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(a.Middleware())
+
+	router.GET("/xtz/delegations", func(c *gin.Context) {
+		key, ok := FromContext(c)
+		if !ok {
+			c.String(http.StatusOK, "anonymous")
+			return
+		}
+		c.String(http.StatusOK, key.Name)
+	})
+	router.GET("/health", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return router
+}
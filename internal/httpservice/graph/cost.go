@@ -0,0 +1,73 @@
+package graph
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// maxCost bounds the number of fields a query resolves. Every selected field
+// costs one per parent, and the fields below the edges of a connection are
+// resolved for each node of its page, e.g. the history of the delegators of a
+// page of 100 delegations costs 100 times the fields of a history.
+const maxCost = 10_000
+
+type costKey struct{}
+
+// withCost sets the cost of a query, which its root fields add to.
+func withCost(ctx context.Context) context.Context {
+	return context.WithValue(ctx, costKey{}, new(atomic.Int64))
+}
+
+// charge adds the cost of the current root field to the query, size being its
+// page size or zero when it is not a connection, and fails once the query
+// exceeds maxCost.
+func charge(ctx context.Context, size int) error {
+	total := ctx.Value(costKey{}).(*atomic.Int64).Add(int64(fieldCost(ctx, size)))
+	if total > maxCost {
+		return fmt.Errorf("query too complex: cost %d exceeds %d", total, maxCost)
+	}
+	return nil
+}
+
+// fieldCost estimates the number of fields resolved for the current field from
+// its selection, which lists the parents before their children.
+func fieldCost(ctx context.Context, size int) int {
+	names := graphql.SelectedFieldNames(ctx)
+
+	// The page size of each connection, by the prefix of the fields of its nodes.
+	connections := make(map[string]int)
+	if size > 0 {
+		connections["edges."] = size
+	}
+
+	cost := 1
+	for _, name := range names {
+		multiplier := 1
+		for prefix, size := range connections {
+			if strings.HasPrefix(name, prefix) {
+				multiplier *= size
+			}
+		}
+		cost += multiplier
+
+		if slices.Contains(names, name+".edges") {
+			connections[name+".edges."] = pageSize(ctx, name)
+		}
+	}
+	return cost
+}
+
+// pageSize returns the page size of the connection at path.
+func pageSize(ctx context.Context, path string) int {
+	var args pageArgs
+	if ok, err := graphql.DecodeSelectedFieldArgs(ctx, path, &args); !ok || err != nil {
+		return domain.DefaultPageSize
+	}
+	return args.page().Size()
+}
@@ -0,0 +1,49 @@
+// Package graph serves the delegations, bakers and delegators over GraphQL.
+package graph
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	_ "embed"
+	"log/slog"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+const (
+	// maxDepth bounds the nesting of a query, e.g. baker > delegators > history
+	// is about ten levels deep with the connection fields.
+	maxDepth = 15
+	// maxQueryLength bounds the size of a query, in bytes.
+	maxQueryLength = 10_000
+)
+
+//go:embed schema.graphql
+var schema string
+
+// Executor runs the GraphQL queries against the use case.
+type Executor struct {
+	schema  *graphql.Schema
+	useCase domain.UseCase
+}
+
+// Exec runs a query with loaders of its own, so that lookups are only batched
+// and cached within a request, and rejects the root fields past maxCost.
+func (e *Executor) Exec(ctx context.Context, query, operationName string, variables map[string]any) *graphql.Response {
+	ctx = withLoaders(ctx, newLoaders(e.useCase))
+	ctx = withCost(ctx)
+	return e.schema.Exec(ctx, query, operationName, variables)
+}
+
+// NewExecutor parses the schema, it panics when the resolvers do not match it.
+func NewExecutor(logger *slog.Logger, useCase domain.UseCase) *Executor {
+	return &Executor{
+		schema: graphql.MustParseSchema(schema, &Resolver{logger: logger, useCase: useCase},
+			// The edges of a page are resolved concurrently, so that their loads land in one batch.
+			graphql.MaxParallelism(domain.MaxPageSize),
+			graphql.MaxDepth(maxDepth),
+			graphql.MaxQueryLength(maxQueryLength),
+		),
+		useCase: useCase,
+	}
+}
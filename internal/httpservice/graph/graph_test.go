@@ -0,0 +1,120 @@
+package graph
+
+import (
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestExecutor_Exec_BatchesNestedPages(t *testing.T) {
+	t.Parallel()
+
+	delegators := []domain.Address{
+		"tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL",
+		"tz1b5y8fXzW5u3Ax3TDyNmdjx1WRBKEuNLjs",
+		"tz1gjaF81ZRRvdzjobyfVNsAeSC6PScjfQwN",
+	}
+	page := domain.PageResponse[domain.DelegationsResponseType]{}
+	pages := make(map[domain.Address]domain.PageResponse[domain.DelegationsResponseType])
+	for i, delegator := range delegators {
+		page.Data = append(page.Data, domain.DelegationsResponseType{Delegator: delegator, Level: int64(100 + i)})
+		page.Cursors = append(page.Cursors, delegator.String())
+		pages[delegator] = domain.PageResponse[domain.DelegationsResponseType]{
+			Data:    []domain.DelegationsResponseType{{Delegator: delegator, Level: int64(i)}},
+			Cursors: []string{"c"},
+		}
+	}
+
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.EXPECT().GetDelegationsPage(mock.Anything, domain.DelegationFilter{}, domain.Page{First: 3}, responseOptions).Return(page, nil).Once()
+	mockUseCase.EXPECT().GetDelegationsPages(mock.Anything, domain.GroupByDelegator, mock.MatchedBy(func(addresses []domain.Address) bool {
+		return assert.ElementsMatch(t, delegators, addresses)
+	}), domain.Page{First: 2}, responseOptions).Return(pages, nil).Once()
+
+	executor := NewExecutor(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)
+	res := executor.Exec(context.Background(), `{
+		delegations(first: 3) { edges { node { delegator { history(first: 2) { edges { node { level } } } } } } }
+	}`, "", nil)
+
+	assert.Empty(t, res.Errors)
+	var data struct {
+		Delegations struct {
+			Edges []struct {
+				Node struct {
+					Delegator struct {
+						History struct {
+							Edges []struct {
+								Node struct{ Level int }
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	assert.NoError(t, json.Unmarshal(res.Data, &data))
+	assert.Len(t, data.Delegations.Edges, 3)
+	for i, edge := range data.Delegations.Edges {
+		assert.Equal(t, i, edge.Node.Delegator.History.Edges[0].Node.Level)
+	}
+}
+
+func TestExecutor_Exec_MaxCost(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{
+			name:  "Single_Page",
+			query: `{ bakers(first: 100) { edges { node { address delegatorCount } } } }`,
+		},
+		{
+			name:    "Nested_Pages",
+			query:   `{ bakers(first: 100) { edges { node { delegations(first: 100) { edges { node { level amount } } } } } } }`,
+			wantErr: true,
+		},
+		{
+			name:    "Default_Page_Sizes",
+			query:   `{ delegations { edges { node { delegator { history { edges { node { delegator { history { edges { node { level } } } } } } } } } } } }`,
+			wantErr: true,
+		},
+		{
+			name: "Variables",
+			query: `query($first: Int) {
+				bakers(first: $first) { edges { node { delegations(first: $first) { edges { node { level amount } } } } } }
+			}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockUseCase(t)
+			if !tt.wantErr {
+				mockUseCase.EXPECT().GetBakersPage(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.PageResponse[domain.BakerResponseType]{}, nil).Once()
+			}
+
+			executor := NewExecutor(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)
+			res := executor.Exec(context.Background(), tt.query, "", map[string]any{"first": 100})
+			if tt.wantErr {
+				assert.NotEmpty(t, res.Errors)
+				assert.Contains(t, res.Errors[0].Message, "query too complex")
+				return
+			}
+			assert.Empty(t, res.Errors)
+		})
+	}
+}
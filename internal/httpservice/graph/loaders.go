@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"time"
+
+	"github.com/graph-gophers/dataloader/v7"
+)
+
+// loaderWait is the time a loader collects keys before querying them in a single
+// batch. The sibling fields of a list are resolved concurrently and request
+// their keys well within it.
+const loaderWait = 2 * time.Millisecond
+
+// loaders batch and cache the lookups of a single request, so that the bakers,
+// delegators and nested delegation pages of a page are fetched with one query each.
+type loaders struct {
+	bakers     *dataloader.Loader[domain.Address, *domain.BakerResponseType]
+	delegators *dataloader.Loader[domain.Address, *domain.DelegationsResponseType]
+	pages      *dataloader.Loader[pageKey, *domain.PageResponse[domain.DelegationsResponseType]]
+}
+
+// pageKey is a page of the delegations of a delegator or of a baker.
+type pageKey struct {
+	group   domain.DelegationGroup
+	address domain.Address
+	page    domain.Page
+}
+
+type loadersKey struct{}
+
+func newLoaders(useCase domain.UseCase) *loaders {
+	return &loaders{
+		bakers: dataloader.NewBatchedLoader(
+			func(ctx context.Context, addresses []domain.Address) []*dataloader.Result[*domain.BakerResponseType] {
+				bakers, err := useCase.GetBakersByAddress(ctx, addresses, responseOptions)
+				return results(addresses, bakers, err)
+			},
+			dataloader.WithWait[domain.Address, *domain.BakerResponseType](loaderWait),
+			dataloader.WithBatchCapacity[domain.Address, *domain.BakerResponseType](domain.MaxPageSize),
+		),
+		delegators: dataloader.NewBatchedLoader(
+			func(ctx context.Context, addresses []domain.Address) []*dataloader.Result[*domain.DelegationsResponseType] {
+				delegations, err := useCase.GetLatestDelegations(ctx, addresses, responseOptions)
+				return results(addresses, delegations, err)
+			},
+			dataloader.WithWait[domain.Address, *domain.DelegationsResponseType](loaderWait),
+			dataloader.WithBatchCapacity[domain.Address, *domain.DelegationsResponseType](domain.MaxPageSize),
+		),
+		pages: dataloader.NewBatchedLoader(
+			func(ctx context.Context, keys []pageKey) []*dataloader.Result[*domain.PageResponse[domain.DelegationsResponseType]] {
+				return loadPages(ctx, useCase, keys)
+			},
+			dataloader.WithWait[pageKey, *domain.PageResponse[domain.DelegationsResponseType]](loaderWait),
+			dataloader.WithBatchCapacity[pageKey, *domain.PageResponse[domain.DelegationsResponseType]](domain.MaxPageSize),
+		),
+	}
+}
+
+// loadPages fetches the pages of a batch with one query per group and page
+// arguments, which the nodes of a list share.
+func loadPages(ctx context.Context, useCase domain.UseCase, keys []pageKey) []*dataloader.Result[*domain.PageResponse[domain.DelegationsResponseType]] {
+	type query struct {
+		group domain.DelegationGroup
+		page  domain.Page
+	}
+	var queries []query
+	addresses := make(map[query][]domain.Address)
+	for _, key := range keys {
+		q := query{group: key.group, page: key.page}
+		if _, ok := addresses[q]; !ok {
+			queries = append(queries, q)
+		}
+		addresses[q] = append(addresses[q], key.address)
+	}
+
+	pages := make(map[query]map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], len(queries))
+	errs := make(map[query]error)
+	for _, q := range queries {
+		pages[q], errs[q] = useCase.GetDelegationsPages(ctx, q.group, addresses[q], q.page, responseOptions)
+	}
+
+	res := make([]*dataloader.Result[*domain.PageResponse[domain.DelegationsResponseType]], len(keys))
+	for i, key := range keys {
+		q := query{group: key.group, page: key.page}
+		if err := errs[q]; err != nil {
+			res[i] = &dataloader.Result[*domain.PageResponse[domain.DelegationsResponseType]]{Error: err}
+			continue
+		}
+		page := pages[q][key.address]
+		res[i] = &dataloader.Result[*domain.PageResponse[domain.DelegationsResponseType]]{Data: &page}
+	}
+	return res
+}
+
+// results orders the values found for a batch as its keys, missing keys resolve to nil.
+func results[V any](keys []domain.Address, values map[domain.Address]V, err error) []*dataloader.Result[*V] {
+	res := make([]*dataloader.Result[*V], len(keys))
+	for i, key := range keys {
+		if err != nil {
+			res[i] = &dataloader.Result[*V]{Error: err}
+			continue
+		}
+
+		res[i] = &dataloader.Result[*V]{}
+		if value, ok := values[key]; ok {
+			res[i].Data = &value
+		}
+	}
+	return res
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// baker returns the baker at address, nil when there is none.
+func (l *loaders) baker(ctx context.Context, address domain.Address) (*domain.BakerResponseType, error) {
+	return l.bakers.Load(ctx, address)()
+}
+
+// latestDelegation returns the latest delegation of delegator, nil when there is none.
+func (l *loaders) latestDelegation(ctx context.Context, delegator domain.Address) (*domain.DelegationsResponseType, error) {
+	return l.delegators.Load(ctx, delegator)()
+}
+
+// primeLatestDelegation caches a latest delegation that was fetched with a page.
+func (l *loaders) primeLatestDelegation(ctx context.Context, delegation domain.DelegationsResponseType) {
+	l.delegators.Prime(ctx, delegation.Delegator, &delegation)
+}
+
+// delegationsPage returns a page of the delegations of a delegator or of a baker.
+func (l *loaders) delegationsPage(ctx context.Context, group domain.DelegationGroup, address domain.Address, page domain.Page) (*domain.PageResponse[domain.DelegationsResponseType], error) {
+	return l.pages.Load(ctx, pageKey{group: group, address: address, page: page})()
+}
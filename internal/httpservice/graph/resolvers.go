@@ -0,0 +1,402 @@
+package graph
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+// responseOptions renders the amounts in mutez with the operation metadata, the
+// units are picked per field.
+var responseOptions = domain.ResponseOptions{Units: domain.UnitMutez, Expand: true}
+
+// Resolver is the root query resolver.
+type Resolver struct {
+	logger  *slog.Logger
+	useCase domain.UseCase
+}
+
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+func (a pageArgs) page() domain.Page {
+	page := domain.Page{First: int(a.First)}
+	if a.After != nil {
+		page.After = *a.After
+	}
+	return page
+}
+
+type unitsArgs struct {
+	Units string
+}
+
+func (a unitsArgs) unit() domain.Unit {
+	return domain.Unit(strings.ToLower(a.Units))
+}
+
+func (r *Resolver) Delegations(ctx context.Context, args struct {
+	Delegator *string
+	Baker     *string
+	Cycle     *int32
+	pageArgs
+}) (*connection[*delegationResolver], error) {
+	var filter domain.DelegationFilter
+	if args.Delegator != nil {
+		delegator, err := domain.ParseAddress(*args.Delegator)
+		if err != nil {
+			return nil, fmt.Errorf("invalid delegator: %w", err)
+		}
+		filter.Delegator = &delegator
+	}
+	if args.Baker != nil {
+		baker, err := domain.ParseAddress(*args.Baker)
+		if err != nil {
+			return nil, fmt.Errorf("invalid baker: %w", err)
+		}
+		filter.Baker = &baker
+	}
+	if args.Cycle != nil {
+		cycle := int64(*args.Cycle)
+		filter.Cycle = &cycle
+	}
+	if err := charge(ctx, args.page().Size()); err != nil {
+		return nil, err
+	}
+
+	return r.delegations(ctx, filter, args.page())
+}
+
+func (r *Resolver) Bakers(ctx context.Context, args pageArgs) (*connection[*bakerResolver], error) {
+	if err := charge(ctx, args.page().Size()); err != nil {
+		return nil, err
+	}
+
+	res, err := r.useCase.GetBakersPage(ctx, args.page(), responseOptions)
+	if err != nil {
+		return nil, r.failed(err, "failed to get bakers")
+	}
+
+	return newConnection(res, func(baker domain.BakerResponseType) *bakerResolver {
+		return &bakerResolver{root: r, baker: baker}
+	}), nil
+}
+
+func (r *Resolver) Baker(ctx context.Context, args struct{ Address string }) (*bakerResolver, error) {
+	address, err := domain.ParseAddress(args.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if err := charge(ctx, 0); err != nil {
+		return nil, err
+	}
+	return r.baker(ctx, address)
+}
+
+func (r *Resolver) Delegator(ctx context.Context, args struct{ Address string }) (*delegatorResolver, error) {
+	address, err := domain.ParseAddress(args.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if err := charge(ctx, 0); err != nil {
+		return nil, err
+	}
+
+	latest, err := loadersFrom(ctx).latestDelegation(ctx, address)
+	if err != nil {
+		return nil, r.failed(err, "failed to get delegator")
+	}
+	if latest == nil {
+		return nil, nil
+	}
+	return &delegatorResolver{root: r, address: address}, nil
+}
+
+func (r *Resolver) delegations(ctx context.Context, filter domain.DelegationFilter, page domain.Page) (*connection[*delegationResolver], error) {
+	res, err := r.useCase.GetDelegationsPage(ctx, filter, page, responseOptions)
+	if err != nil {
+		return nil, r.failed(err, "failed to get delegations")
+	}
+
+	return newConnection(res, func(delegation domain.DelegationsResponseType) *delegationResolver {
+		return &delegationResolver{root: r, delegation: delegation}
+	}), nil
+}
+
+// delegationsOf resolves a page of the delegations of a delegator or of a baker
+// through the request loader, so that the pages nested in a list are batched.
+func (r *Resolver) delegationsOf(ctx context.Context, group domain.DelegationGroup, address domain.Address, page domain.Page) (*connection[*delegationResolver], error) {
+	res, err := loadersFrom(ctx).delegationsPage(ctx, group, address, page)
+	if err != nil {
+		return nil, r.failed(err, "failed to get delegations")
+	}
+
+	return newConnection(*res, func(delegation domain.DelegationsResponseType) *delegationResolver {
+		return &delegationResolver{root: r, delegation: delegation}
+	}), nil
+}
+
+// baker resolves the baker at address through the request loader, nil when there is none.
+func (r *Resolver) baker(ctx context.Context, address domain.Address) (*bakerResolver, error) {
+	baker, err := loadersFrom(ctx).baker(ctx, address)
+	if err != nil {
+		return nil, r.failed(err, "failed to get baker")
+	}
+	if baker == nil {
+		return nil, nil
+	}
+	return &bakerResolver{root: r, baker: *baker}, nil
+}
+
+// failed returns the error reported to the client, invalid cursors are reported
+// as is and any other error is logged and hidden behind message.
+func (r *Resolver) failed(err error, message string) error {
+	if errors.Is(err, domain.ErrInvalidCursor) {
+		return err
+	}
+	r.logger.Warn(message, "error", err)
+	return errors.New(message)
+}
+
+type delegationResolver struct {
+	root       *Resolver
+	delegation domain.DelegationsResponseType
+}
+
+func (d *delegationResolver) metadata() *domain.DelegationMetadata {
+	if d.delegation.DelegationMetadata == nil {
+		return &domain.DelegationMetadata{}
+	}
+	return d.delegation.DelegationMetadata
+}
+
+func (d *delegationResolver) OperationId() *string {
+	if d.metadata().OperationID == nil {
+		return nil
+	}
+	id := strconv.FormatInt(*d.metadata().OperationID, 10)
+	return &id
+}
+
+func (d *delegationResolver) OperationHash() *string {
+	return d.metadata().OperationHash
+}
+
+func (d *delegationResolver) Timestamp() graphql.Time {
+	return graphql.Time{Time: d.delegation.Timestamp}
+}
+
+func (d *delegationResolver) Level() int32 {
+	return int32(d.delegation.Level)
+}
+
+func (d *delegationResolver) Cycle() *int32 {
+	if d.delegation.Cycle == nil {
+		return nil
+	}
+	cycle := int32(*d.delegation.Cycle)
+	return &cycle
+}
+
+func (d *delegationResolver) Kind() string {
+	switch {
+	case d.metadata().Baker == nil:
+		return strings.ToUpper(string(domain.KindUndelegation))
+	case d.metadata().PreviousBaker != nil:
+		return strings.ToUpper(string(domain.KindRedelegation))
+	default:
+		return strings.ToUpper(string(domain.KindNew))
+	}
+}
+
+func (d *delegationResolver) Amount(args unitsArgs) string {
+	return domain.NewAmount(int64(d.delegation.Amount.Mutez), args.unit()).String()
+}
+
+func (d *delegationResolver) Delegator() *delegatorResolver {
+	return &delegatorResolver{root: d.root, address: d.delegation.Delegator}
+}
+
+func (d *delegationResolver) Baker(ctx context.Context) (*bakerResolver, error) {
+	if d.metadata().Baker == nil {
+		return nil, nil
+	}
+	return d.root.baker(ctx, *d.metadata().Baker)
+}
+
+func (d *delegationResolver) PreviousBaker(ctx context.Context) (*bakerResolver, error) {
+	if d.metadata().PreviousBaker == nil {
+		return nil, nil
+	}
+	return d.root.baker(ctx, *d.metadata().PreviousBaker)
+}
+
+type bakerResolver struct {
+	root  *Resolver
+	baker domain.BakerResponseType
+}
+
+func (b *bakerResolver) Address() string {
+	return b.baker.Address.String()
+}
+
+func (b *bakerResolver) Alias() *string {
+	return b.baker.Alias
+}
+
+func (b *bakerResolver) Active() bool {
+	return b.baker.Active
+}
+
+func (b *bakerResolver) FirstSeen() graphql.Time {
+	return graphql.Time{Time: b.baker.FirstSeen}
+}
+
+func (b *bakerResolver) LastSeen() graphql.Time {
+	return graphql.Time{Time: b.baker.LastSeen}
+}
+
+func (b *bakerResolver) TotalDelegationsReceived() int32 {
+	return int32(b.baker.TotalDelegationsReceived)
+}
+
+func (b *bakerResolver) DelegatorCount() int32 {
+	return int32(b.baker.Delegators)
+}
+
+func (b *bakerResolver) DelegatedAmount(args unitsArgs) string {
+	return domain.NewAmount(int64(b.baker.DelegatedAmount.Mutez), args.unit()).String()
+}
+
+func (b *bakerResolver) StakerCount() int32 {
+	return int32(b.baker.Stakers)
+}
+
+func (b *bakerResolver) StakedAmount(args unitsArgs) string {
+	return domain.NewAmount(int64(b.baker.StakedAmount.Mutez), args.unit()).String()
+}
+
+func (b *bakerResolver) Delegators(ctx context.Context, args pageArgs) (*connection[*delegatorResolver], error) {
+	res, err := b.root.useCase.GetCurrentDelegations(ctx, b.baker.Address, args.page(), responseOptions)
+	if err != nil {
+		return nil, b.root.failed(err, "failed to get delegators")
+	}
+
+	l := loadersFrom(ctx)
+	return newConnection(res, func(delegation domain.DelegationsResponseType) *delegatorResolver {
+		// The page holds the latest delegation of each delegator, nested fields need not fetch it again.
+		l.primeLatestDelegation(ctx, delegation)
+		return &delegatorResolver{root: b.root, address: delegation.Delegator}
+	}), nil
+}
+
+func (b *bakerResolver) Delegations(ctx context.Context, args pageArgs) (*connection[*delegationResolver], error) {
+	return b.root.delegationsOf(ctx, domain.GroupByBaker, b.baker.Address, args.page())
+}
+
+type delegatorResolver struct {
+	root    *Resolver
+	address domain.Address
+}
+
+func (d *delegatorResolver) Address() string {
+	return d.address.String()
+}
+
+func (d *delegatorResolver) latest(ctx context.Context) (*domain.DelegationsResponseType, error) {
+	latest, err := loadersFrom(ctx).latestDelegation(ctx, d.address)
+	if err != nil {
+		return nil, d.root.failed(err, "failed to get delegator")
+	}
+	return latest, nil
+}
+
+func (d *delegatorResolver) Alias(ctx context.Context) (*string, error) {
+	latest, err := d.latest(ctx)
+	if err != nil || latest == nil || latest.DelegationMetadata == nil {
+		return nil, err
+	}
+	return latest.DelegatorAlias, nil
+}
+
+func (d *delegatorResolver) CurrentDelegation(ctx context.Context) (*delegationResolver, error) {
+	latest, err := d.latest(ctx)
+	if err != nil || latest == nil {
+		return nil, err
+	}
+	return &delegationResolver{root: d.root, delegation: *latest}, nil
+}
+
+func (d *delegatorResolver) Baker(ctx context.Context) (*bakerResolver, error) {
+	latest, err := d.latest(ctx)
+	if err != nil || latest == nil || latest.DelegationMetadata == nil || latest.Baker == nil {
+		return nil, err
+	}
+	return d.root.baker(ctx, *latest.Baker)
+}
+
+func (d *delegatorResolver) History(ctx context.Context, args pageArgs) (*connection[*delegationResolver], error) {
+	return d.root.delegationsOf(ctx, domain.GroupByDelegator, d.address, args.page())
+}
+
+// connection is a Relay connection over a page.
+type connection[T any] struct {
+	edges    []*edge[T]
+	pageInfo *pageInfo
+}
+
+func newConnection[V, T any](page domain.PageResponse[V], node func(V) T) *connection[T] {
+	res := &connection[T]{
+		edges:    make([]*edge[T], len(page.Data)),
+		pageInfo: &pageInfo{hasNextPage: page.HasNextPage},
+	}
+	for i, value := range page.Data {
+		res.edges[i] = &edge[T]{cursor: page.Cursors[i], node: node(value)}
+	}
+	if len(page.Cursors) > 0 {
+		res.pageInfo.endCursor = &page.Cursors[len(page.Cursors)-1]
+	}
+	return res
+}
+
+func (c *connection[T]) Edges() []*edge[T] {
+	return c.edges
+}
+
+func (c *connection[T]) PageInfo() *pageInfo {
+	return c.pageInfo
+}
+
+type edge[T any] struct {
+	cursor string
+	node   T
+}
+
+func (e *edge[T]) Cursor() string {
+	return e.cursor
+}
+
+func (e *edge[T]) Node() T {
+	return e.node
+}
+
+type pageInfo struct {
+	hasNextPage bool
+	endCursor   *string
+}
+
+func (p *pageInfo) HasNextPage() bool {
+	return p.hasNextPage
+}
+
+func (p *pageInfo) EndCursor() *string {
+	return p.endCursor
+}
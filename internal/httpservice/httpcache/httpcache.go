@@ -0,0 +1,288 @@
+// Package httpcache answers the conditional requests of the routes built from the
+// indexed delegations, and keeps their rendered responses in an optional store.
+package httpcache
+
+import (
+	"crypto/sha256"
+	"delegator/pkg/domain"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// DefaultMaxEntrySize is the largest response stored when none is configured.
+	DefaultMaxEntrySize = 1 << 20
+	// DefaultFinalMaxAge is how long the final responses can be cached by the
+	// clients when nothing is configured.
+	DefaultFinalMaxAge = 24 * time.Hour
+	// FinalityDepth is the number of blocks after which an indexed level is no
+	// longer expected to be backtracked.
+	FinalityDepth = 2
+)
+
+// FinalFunc reports whether the response to a request can no longer change once
+// the delegations are indexed up to head.
+type FinalFunc func(c *gin.Context, head domain.IndexHead) bool
+
+// Cache versions the responses of its routes by the indexed head and the request.
+// The responses of a route whose FinalFunc reports them final are versioned by
+// the request alone, as later commits cannot change them.
+type Cache struct {
+	logger       *slog.Logger
+	enabled      bool
+	head         domain.IndexHeadTracker
+	store        domain.ResponseStore
+	maxEntrySize int
+	maxAge       time.Duration
+	finalMaxAge  time.Duration
+	private      bool
+	routes       map[string]FinalFunc
+	now          func() time.Time
+}
+
+type Options func(*Cache)
+
+func WithLogger(logger *slog.Logger) Options {
+	return func(h *Cache) {
+		h.logger = logger
+	}
+}
+
+// WithEnabled turns the caching on, the middleware does nothing otherwise.
+func WithEnabled(enabled bool) Options {
+	return func(h *Cache) {
+		h.enabled = enabled
+	}
+}
+
+func WithHead(head domain.IndexHeadTracker) Options {
+	return func(h *Cache) {
+		h.head = head
+	}
+}
+
+// WithStore keeps the JSON responses in store, nil only answers the conditional requests.
+func WithStore(store domain.ResponseStore) Options {
+	return func(h *Cache) {
+		h.store = store
+	}
+}
+
+// WithMaxEntrySize sets the largest response stored, in bytes. Non-positive sizes
+// keep DefaultMaxEntrySize.
+func WithMaxEntrySize(size int) Options {
+	return func(h *Cache) {
+		if size > 0 {
+			h.maxEntrySize = size
+		}
+	}
+}
+
+// WithMaxAge sets the Cache-Control max-age of the responses that can still change,
+// zero makes the clients revalidate them on every use, and of the final ones,
+// which are also stored for finalMaxAge at most. A non-positive finalMaxAge keeps
+// DefaultFinalMaxAge.
+func WithMaxAge(maxAge, finalMaxAge time.Duration) Options {
+	return func(h *Cache) {
+		h.maxAge = max(maxAge, 0)
+		if finalMaxAge > 0 {
+			h.finalMaxAge = finalMaxAge
+		}
+	}
+}
+
+// WithPrivate keeps the responses out of the shared caches, for when API keys
+// are required and a proxy must not serve one client the response of another.
+func WithPrivate(private bool) Options {
+	return func(h *Cache) {
+		h.private = private
+	}
+}
+
+// WithRoute caches the GET responses of route, a gin path such as
+// /v1/cycles/:cycle/delegations. A nil final never reports them final.
+func WithRoute(route string, final FinalFunc) Options {
+	return func(h *Cache) {
+		h.routes[route] = final
+	}
+}
+
+// FinalCycle reports the responses of the routes with a :cycle parameter final
+// once the cycle ended FinalityDepth blocks before the head.
+func FinalCycle(mapper domain.CycleMapper) FinalFunc {
+	return func(c *gin.Context, head domain.IndexHead) bool {
+		cycle, err := strconv.ParseInt(c.Param("cycle"), 10, 64)
+		if err != nil {
+			return false
+		}
+		headCycle, ok := mapper.CycleOf(head.Level - FinalityDepth)
+		return ok && cycle < headCycle
+	}
+}
+
+// Middleware sets the ETag, Last-Modified and Cache-Control headers of the cached
+// routes, answers the matching conditional requests with 304 Not Modified and
+// serves the stored responses. It must be installed after the authentication,
+// so that stored responses are only served to the clients allowed to see them.
+func (h *Cache) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		final, ok := h.routes[c.FullPath()]
+		if !h.enabled || !ok || c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		head, err := h.head.Current(c)
+		if err != nil {
+			h.logger.Warn("failed to get index head", "error", err)
+			c.Next()
+			return
+		}
+		// Nothing is indexed yet, the responses are not versioned.
+		if head.Level == 0 {
+			c.Next()
+			return
+		}
+
+		isFinal := final != nil && final(c, head)
+		key := h.key(c, head, isFinal)
+		h.setHeaders(c, head, key, isFinal)
+
+		if notModified(c.Request, key, head, isFinal) {
+			c.AbortWithStatus(http.StatusNotModified)
+			return
+		}
+
+		if h.store == nil {
+			h.next(c)
+			return
+		}
+
+		res, found, err := h.store.Get(c, key)
+		if err != nil {
+			h.logger.Warn("failed to get cached response", "error", err)
+		}
+		if found && !res.Expired(h.now()) {
+			c.Header("X-Cache", "HIT")
+			c.Data(http.StatusOK, res.ContentType, res.Body)
+			c.Abort()
+			return
+		}
+
+		c.Header("X-Cache", "MISS")
+		w := h.next(c)
+		if !w.storable() {
+			return
+		}
+
+		res = domain.CachedResponse{
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		}
+		// The final responses are not invalidated by the head, they expire in
+		// case the cycle was still being indexed.
+		if isFinal {
+			res.Expires = h.now().Add(h.finalMaxAge)
+		} else {
+			res.Level = head.Level
+		}
+		if err := h.store.Set(c, key, res); err != nil {
+			h.logger.Warn("failed to store cached response", "error", err)
+		}
+	}
+}
+
+// next runs the handlers, recording their response.
+func (h *Cache) next(c *gin.Context) *recorder {
+	w := &recorder{ResponseWriter: c.Writer, limit: h.maxEntrySize}
+	c.Writer = w
+	defer func() { c.Writer = w.ResponseWriter }()
+
+	c.Next()
+	return w
+}
+
+// key hashes what the response depends on: the path, the query string and the
+// Accept header which may select the format, and the head unless it is final.
+func (h *Cache) key(c *gin.Context, head domain.IndexHead, final bool) string {
+	level := "final"
+	if !final {
+		level = strconv.FormatInt(head.Level, 10)
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		c.Request.URL.Path,
+		c.Request.URL.Query().Encode(),
+		c.GetHeader("Accept"),
+		level,
+	}, "\n")))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (h *Cache) setHeaders(c *gin.Context, head domain.IndexHead, key string, final bool) {
+	visibility := "public"
+	if h.private {
+		visibility = "private"
+	}
+
+	c.Header("ETag", etag(key))
+	c.Writer.Header().Add("Vary", "Accept")
+	switch {
+	case final:
+		c.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", visibility, int(h.finalMaxAge.Seconds())))
+	case h.maxAge > 0:
+		c.Header("Cache-Control", fmt.Sprintf("%s, max-age=%d", visibility, int(h.maxAge.Seconds())))
+	default:
+		c.Header("Cache-Control", visibility+", no-cache")
+	}
+	// The final responses do not change with the head, their ETag is enough.
+	if !final {
+		c.Header("Last-Modified", head.Timestamp.UTC().Format(http.TimeFormat))
+	}
+}
+
+// etag returns the weak entity tag of key, the responses are equivalent rather
+// than byte for byte identical across the instances.
+func etag(key string) string {
+	return `W/"` + key + `"`
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when there is none.
+func notModified(r *http.Request, key string, head domain.IndexHead, final bool) bool {
+	if value := r.Header.Get("If-None-Match"); value != "" {
+		for tag := range strings.SplitSeq(value, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == `"`+key+`"` {
+				return true
+			}
+		}
+		return false
+	}
+
+	if value := r.Header.Get("If-Modified-Since"); value != "" && !final {
+		since, err := http.ParseTime(value)
+		return err == nil && !head.Timestamp.Truncate(time.Second).After(since)
+	}
+	return false
+}
+
+func NewCache(opts ...Options) *Cache {
+	h := &Cache{
+		logger:       slog.Default(),
+		maxEntrySize: DefaultMaxEntrySize,
+		finalMaxAge:  DefaultFinalMaxAge,
+		routes:       make(map[string]FinalFunc),
+		now:          time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
@@ -0,0 +1,376 @@
+package httpcache
+
+import (
+	"context"
+	"delegator/internal/core/cache"
+	"delegator/internal/httpservice/middleware"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHead is an index head set by the tests.
+type testHead struct {
+	head domain.IndexHead
+	err  error
+}
+
+func (h *testHead) Current(ctx context.Context) (domain.IndexHead, error) {
+	return h.head, h.err
+}
+
+func (h *testHead) Advance(ctx context.Context, head domain.IndexHead) {
+	h.head = head
+}
+
+var headTimestamp = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestEngine(calls *atomic.Int32, opts ...Options) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+
+	opts = append([]Options{
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithEnabled(true),
+		WithRoute("/xtz/delegations", nil),
+	}, opts...)
+	engine.Use(NewCache(opts...).Middleware())
+
+	engine.GET("/xtz/delegations", func(c *gin.Context) {
+		calls.Add(1)
+		if c.Query("fail") != "" {
+			c.JSON(http.StatusInternalServerError, gin.H{"msg": "failed to get delegations"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"data": []string{}, "units": c.Query("units")})
+	})
+	engine.GET("/xtz/cycles/:cycle/delegations", func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"data": []string{}})
+	})
+	engine.GET("/xtz/bakers", func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"data": []string{}})
+	})
+
+	return engine
+}
+
+func get(engine *gin.Engine, path string, header http.Header) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestCache_Middleware(t *testing.T) {
+	t.Parallel()
+
+	head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+	var calls atomic.Int32
+	engine := newTestEngine(&calls, WithHead(head))
+
+	w := get(engine, "/xtz/delegations?units=tez", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	tag := w.Header().Get("ETag")
+	assert.Regexp(t, `^W/"[0-9a-f]{32}"$`, tag)
+	assert.Equal(t, "Sat, 01 Jun 2024 12:00:00 GMT", w.Header().Get("Last-Modified"))
+	assert.Equal(t, "public, no-cache", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "Accept", w.Header().Get("Vary"))
+
+	tests := []struct {
+		name           string
+		path           string
+		header         http.Header
+		expectedStatus int
+	}{
+		{
+			name:           "If_None_Match",
+			path:           "/xtz/delegations?units=tez",
+			header:         http.Header{"If-None-Match": {`W/"other", ` + tag}},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "If_None_Match_Strong",
+			path:           "/xtz/delegations?units=tez",
+			header:         http.Header{"If-None-Match": {tag[2:]}},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "If_None_Match_Other_Query",
+			path:           "/xtz/delegations?units=mutez",
+			header:         http.Header{"If-None-Match": {tag}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "If_None_Match_Other_Accept",
+			path:           "/xtz/delegations?units=tez",
+			header:         http.Header{"If-None-Match": {tag}, "Accept": {"text/csv"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "If_Modified_Since",
+			path:           "/xtz/delegations",
+			header:         http.Header{"If-Modified-Since": {"Sat, 01 Jun 2024 12:00:00 GMT"}},
+			expectedStatus: http.StatusNotModified,
+		},
+		{
+			name:           "If_Modified_Since_Older",
+			path:           "/xtz/delegations",
+			header:         http.Header{"If-Modified-Since": {"Sat, 01 Jun 2024 11:59:59 GMT"}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			// If-None-Match takes precedence over If-Modified-Since.
+			name: "If_None_Match_Mismatch_Wins",
+			path: "/xtz/delegations",
+			header: http.Header{
+				"If-None-Match":     {`W/"other"`},
+				"If-Modified-Since": {"Sat, 01 Jun 2024 12:00:00 GMT"},
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := get(engine, tt.path, tt.header)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.NotEmpty(t, w.Header().Get("ETag"))
+			if tt.expectedStatus == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestCache_Middleware_Head(t *testing.T) {
+	t.Parallel()
+
+	head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+	var calls atomic.Int32
+	engine := newTestEngine(&calls, WithHead(head))
+
+	tag := get(engine, "/xtz/delegations", nil).Header().Get("ETag")
+
+	// A commit changes the ETag of the responses that can still change.
+	head.Advance(context.Background(), domain.IndexHead{Level: 1001, Timestamp: headTimestamp.Add(30 * time.Second)})
+	w := get(engine, "/xtz/delegations", http.Header{"If-None-Match": {tag}})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEqual(t, tag, w.Header().Get("ETag"))
+
+	// Without a head the responses are served without validators.
+	head.err = errors.New("db down")
+	w = get(engine, "/xtz/delegations", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+
+	head.err = nil
+	head.head = domain.IndexHead{}
+	w = get(engine, "/xtz/delegations", nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("ETag"))
+}
+
+func TestCache_Middleware_Vary(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(
+		middleware.CORS(middleware.CORSConfig{AllowedOrigins: []string{"https://dashboard.example.com"}}),
+		NewCache(
+			WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+			WithEnabled(true),
+			WithRoute("/xtz/delegations", nil),
+			WithHead(&testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}),
+		).Middleware(),
+	)
+	engine.GET("/xtz/delegations", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": []string{}})
+	})
+
+	w := get(engine, "/xtz/delegations", http.Header{"Origin": {"https://dashboard.example.com"}})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, []string{"Origin", "Accept"}, w.Header().Values("Vary"))
+}
+
+func TestCache_Middleware_Uncached(t *testing.T) {
+	t.Parallel()
+
+	head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+
+	tests := []struct {
+		name string
+		path string
+		opts []Options
+	}{
+		{name: "Other_Route", path: "/xtz/bakers", opts: []Options{WithHead(head)}},
+		{name: "Disabled", path: "/xtz/delegations", opts: []Options{WithHead(head), WithEnabled(false)}},
+		{name: "Error_Response", path: "/xtz/delegations?fail=1", opts: []Options{WithHead(head), WithStore(cache.NewMemoryStore())}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			engine := newTestEngine(&calls, tt.opts...)
+
+			for range 2 {
+				w := get(engine, tt.path, nil)
+				assert.Empty(t, w.Header().Get("ETag"))
+				assert.Empty(t, w.Header().Get("Last-Modified"))
+				assert.Empty(t, w.Header().Get("X-Cache"))
+			}
+			assert.Equal(t, int32(2), calls.Load())
+		})
+	}
+}
+
+func TestCache_Middleware_Store(t *testing.T) {
+	t.Parallel()
+
+	head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+	store := cache.NewMemoryStore()
+	var calls atomic.Int32
+	engine := newTestEngine(&calls, WithHead(head), WithStore(store))
+
+	first := get(engine, "/xtz/delegations?units=tez", nil)
+	assert.Equal(t, "MISS", first.Header().Get("X-Cache"))
+
+	second := get(engine, "/xtz/delegations?units=tez", nil)
+	assert.Equal(t, http.StatusOK, second.Code)
+	assert.Equal(t, "HIT", second.Header().Get("X-Cache"))
+	assert.Equal(t, first.Body.String(), second.Body.String())
+	assert.Equal(t, first.Header().Get("Content-Type"), second.Header().Get("Content-Type"))
+	assert.Equal(t, first.Header().Get("ETag"), second.Header().Get("ETag"))
+	assert.Equal(t, int32(1), calls.Load())
+
+	// Responses larger than the limit are not stored.
+	var bigCalls atomic.Int32
+	engine = newTestEngine(&bigCalls, WithHead(head), WithStore(store), WithMaxEntrySize(8))
+	get(engine, "/xtz/delegations?units=mutez", nil)
+	get(engine, "/xtz/delegations?units=mutez", nil)
+	assert.Equal(t, int32(2), bigCalls.Load())
+}
+
+func TestCache_Middleware_Final(t *testing.T) {
+	t.Parallel()
+
+	mockMapper := mocks.NewMockCycleMapper(t)
+	mockMapper.EXPECT().CycleOf(int64(998)).Return(int64(12), true)
+	mockMapper.EXPECT().CycleOf(int64(1098)).Return(int64(12), true)
+
+	tests := []struct {
+		name                 string
+		path                 string
+		private              bool
+		expectedCacheControl string
+		final                bool
+	}{
+		{
+			name:                 "Ended_Cycle",
+			path:                 "/xtz/cycles/11/delegations",
+			expectedCacheControl: "public, max-age=3600",
+			final:                true,
+		},
+		{
+			name:                 "Ended_Cycle_Private",
+			path:                 "/xtz/cycles/11/delegations",
+			private:              true,
+			expectedCacheControl: "private, max-age=3600",
+			final:                true,
+		},
+		{
+			name:                 "Current_Cycle",
+			path:                 "/xtz/cycles/12/delegations",
+			expectedCacheControl: "public, max-age=5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+			var calls atomic.Int32
+			engine := newTestEngine(&calls,
+				WithHead(head),
+				WithMaxAge(5*time.Second, time.Hour),
+				WithPrivate(tt.private),
+				WithRoute("/xtz/cycles/:cycle/delegations", FinalCycle(mockMapper)),
+			)
+
+			w := get(engine, tt.path, nil)
+			assert.Equal(t, tt.expectedCacheControl, w.Header().Get("Cache-Control"))
+			tag := w.Header().Get("ETag")
+
+			// The ETag of a final response does not change with the head.
+			head.Advance(context.Background(), domain.IndexHead{Level: 1100, Timestamp: headTimestamp.Add(time.Hour)})
+			w = get(engine, tt.path, http.Header{"If-None-Match": {tag}})
+			if tt.final {
+				assert.Equal(t, http.StatusNotModified, w.Code)
+				assert.Empty(t, w.Header().Get("Last-Modified"))
+				return
+			}
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.NotEmpty(t, w.Header().Get("Last-Modified"))
+		})
+	}
+}
+
+func TestCache_Middleware_Final_Expires(t *testing.T) {
+	t.Parallel()
+
+	mockMapper := mocks.NewMockCycleMapper(t)
+	mockMapper.EXPECT().CycleOf(int64(998)).Return(int64(12), true)
+
+	head := &testHead{head: domain.IndexHead{Level: 1000, Timestamp: headTimestamp}}
+	store := cache.NewMemoryStore()
+	now := headTimestamp
+	responseCache := NewCache(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithEnabled(true),
+		WithHead(head),
+		WithStore(store),
+		WithMaxAge(0, time.Hour),
+		WithRoute("/xtz/cycles/:cycle/delegations", FinalCycle(mockMapper)),
+	)
+	responseCache.now = func() time.Time { return now }
+
+	var calls atomic.Int32
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(responseCache.Middleware())
+	engine.GET("/xtz/cycles/:cycle/delegations", func(c *gin.Context) {
+		calls.Add(1)
+		c.JSON(http.StatusOK, gin.H{"data": []string{}})
+	})
+
+	// The final responses are not invalidated by the head, only by their expiry.
+	assert.Equal(t, "MISS", get(engine, "/xtz/cycles/11/delegations", nil).Header().Get("X-Cache"))
+	assert.NoError(t, store.Invalidate(context.Background(), 2000))
+	assert.Equal(t, "HIT", get(engine, "/xtz/cycles/11/delegations", nil).Header().Get("X-Cache"))
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, "MISS", get(engine, "/xtz/cycles/11/delegations", nil).Header().Get("X-Cache"))
+	assert.Equal(t, int32(2), calls.Load())
+}
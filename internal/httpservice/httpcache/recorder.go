@@ -0,0 +1,58 @@
+package httpcache
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// recorder copies a response up to limit bytes while it is written, and drops
+// the validators of the responses that are not a 200.
+type recorder struct {
+	gin.ResponseWriter
+	limit    int
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *recorder) WriteHeader(code int) {
+	if code != http.StatusOK {
+		header := w.Header()
+		header.Del("ETag")
+		header.Del("Last-Modified")
+		header.Del("X-Cache")
+		header.Set("Cache-Control", "no-store")
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recorder) Write(data []byte) (int, error) {
+	w.record(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recorder) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *recorder) record(data []byte) {
+	if w.overflow {
+		return
+	}
+	if w.body.Len()+len(data) > w.limit {
+		w.overflow = true
+		w.body.Reset()
+		return
+	}
+	w.body.Write(data)
+}
+
+// storable reports whether the response is a complete JSON 200, the exports are
+// streamed and never stored.
+func (w *recorder) storable() bool {
+	return w.Status() == http.StatusOK && !w.overflow &&
+		strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CORSConfig is the cross-origin policy, "*" in AllowedOrigins allows every
+// origin. Credentials are never allowed, the API keys are sent as headers.
+type CORSConfig struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	ExposedHeaders []string
+	// MaxAge is how long a preflight response can be cached, in seconds.
+	MaxAge int
+}
+
+// CORS applies the cross-origin policy and answers the preflight requests,
+// which never reach the routes.
+func CORS(cfg CORSConfig) gin.HandlerFunc {
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+	methods := strings.Join(cfg.AllowedMethods, ", ")
+	headers := strings.Join(cfg.AllowedHeaders, ", ")
+	exposed := strings.Join(cfg.ExposedHeaders, ", ")
+
+	return func(c *gin.Context) {
+		origin := c.GetHeader("Origin")
+		if origin == "" {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Origin")
+		if !anyOrigin && !slices.Contains(cfg.AllowedOrigins, origin) {
+			if isPreflight(c.Request) {
+				apierror.Abort(c, domain.NewError(domain.CodePermissionDenied, "origin not allowed").
+					WithDetail("origin", origin))
+				return
+			}
+			c.Next()
+			return
+		}
+
+		if anyOrigin {
+			c.Header("Access-Control-Allow-Origin", "*")
+		} else {
+			c.Header("Access-Control-Allow-Origin", origin)
+		}
+
+		if !isPreflight(c.Request) {
+			if exposed != "" {
+				c.Header("Access-Control-Expose-Headers", exposed)
+			}
+			c.Next()
+			return
+		}
+
+		if methods != "" {
+			c.Header("Access-Control-Allow-Methods", methods)
+		}
+		if headers != "" {
+			c.Header("Access-Control-Allow-Headers", headers)
+		}
+		if cfg.MaxAge > 0 {
+			c.Header("Access-Control-Max-Age", strconv.Itoa(cfg.MaxAge))
+		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
+
+func isPreflight(r *http.Request) bool {
+	return r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+}
@@ -0,0 +1,119 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORS(t *testing.T) {
+	t.Parallel()
+
+	restricted := CORSConfig{
+		AllowedOrigins: []string{"https://dashboard.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "X-API-Key"},
+		ExposedHeaders: []string{"X-Request-ID"},
+		MaxAge:         600,
+	}
+	open := CORSConfig{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET"},
+	}
+
+	tests := []struct {
+		name            string
+		cfg             CORSConfig
+		method          string
+		origin          string
+		preflight       bool
+		expectedStatus  int
+		expectedHeaders map[string]string
+	}{
+		{
+			name:           "Same_Origin",
+			cfg:            restricted,
+			method:         http.MethodGet,
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+		},
+		{
+			name:           "Allowed_Origin",
+			cfg:            restricted,
+			method:         http.MethodGet,
+			origin:         "https://dashboard.example.com",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":   "https://dashboard.example.com",
+				"Access-Control-Expose-Headers": "X-Request-ID",
+				"Vary":                          "Origin",
+			},
+		},
+		{
+			name:           "Other_Origin",
+			cfg:            restricted,
+			method:         http.MethodGet,
+			origin:         "https://scraper.example.com",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "",
+			},
+		},
+		{
+			name:           "Preflight",
+			cfg:            restricted,
+			method:         http.MethodOptions,
+			origin:         "https://dashboard.example.com",
+			preflight:      true,
+			expectedStatus: http.StatusNoContent,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin":  "https://dashboard.example.com",
+				"Access-Control-Allow-Methods": "GET, POST",
+				"Access-Control-Allow-Headers": "Content-Type, X-API-Key",
+				"Access-Control-Max-Age":       "600",
+			},
+		},
+		{
+			name:           "Preflight_Other_Origin",
+			cfg:            restricted,
+			method:         http.MethodOptions,
+			origin:         "https://scraper.example.com",
+			preflight:      true,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Any_Origin",
+			cfg:            open,
+			method:         http.MethodGet,
+			origin:         "https://anywhere.example.com",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Access-Control-Allow-Origin": "*",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(tt.method, "/ping", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if tt.preflight {
+				req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+			}
+			w := serve(newTestEngine(CORS(tt.cfg)), req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for name, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(name), name)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// idleLimiterTTL is how long the bucket of a client IP is kept without requests,
+	// a full bucket is refilled by then at any sensible rate.
+	idleLimiterTTL = 10 * time.Minute
+	// pruneInterval is the minimum delay between two sweeps of the idle buckets.
+	pruneInterval = time.Minute
+)
+
+// ipLimiters holds a token bucket per client IP.
+type ipLimiters struct {
+	limit rate.Limit
+	burst int
+	now   func() time.Time
+
+	mu        sync.Mutex
+	limiters  map[string]*ipLimiter
+	lastPrune time.Time
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// allow takes a token from the bucket of ip, sweeping the idle buckets at most
+// every pruneInterval.
+func (l *ipLimiters) allow(ip string) bool {
+	now := l.now()
+
+	l.mu.Lock()
+	if now.Sub(l.lastPrune) >= pruneInterval {
+		for key, entry := range l.limiters {
+			if now.Sub(entry.lastSeen) >= idleLimiterTTL {
+				delete(l.limiters, key)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	entry, ok := l.limiters[ip]
+	if !ok {
+		entry = &ipLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[ip] = entry
+	}
+	entry.lastSeen = now
+	l.mu.Unlock()
+
+	return entry.limiter.AllowN(now, 1)
+}
+
+// IPRateLimit limits the requests of each client IP with a token bucket of limit
+// requests per second. The client IP is only read from X-Forwarded-For when the
+// request comes from a trusted proxy of the engine.
+func IPRateLimit(limit float64, burst int) gin.HandlerFunc {
+	limiters := &ipLimiters{
+		limit:    rate.Limit(limit),
+		burst:    max(burst, 1),
+		now:      time.Now,
+		limiters: make(map[string]*ipLimiter),
+	}
+	return ipRateLimit(limiters)
+}
+
+func ipRateLimit(limiters *ipLimiters) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !limiters.allow(c.ClientIP()) {
+			c.Header("Retry-After", "1")
+			apierror.Abort(c, domain.NewError(domain.CodeResourceExhausted, "rate limit exceeded").
+				WithDetail("retry_after", 1))
+			return
+		}
+		c.Next()
+	}
+}
+
+// MaxBodySize rejects the request bodies larger than limit bytes, up front when
+// the length is announced and while reading otherwise.
+func MaxBodySize(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > limit {
+			apierror.Abort(c, domain.NewError(domain.CodePayloadTooLarge, "request body too large").
+				WithDetail("limit", limit))
+			return
+		}
+
+		if c.Request.Body != nil {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		}
+		c.Next()
+	}
+}
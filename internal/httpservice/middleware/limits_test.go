@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestIPRateLimit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	limiters := &ipLimiters{
+		limit:    rate.Limit(1),
+		burst:    2,
+		now:      func() time.Time { return now },
+		limiters: make(map[string]*ipLimiter),
+	}
+	engine := newTestEngine(ipRateLimit(limiters))
+
+	request := func(ip string) int {
+		req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.RemoteAddr = ip + ":41000"
+		return serve(engine, req).Code
+	}
+
+	// Each client IP has its own bucket.
+	assert.Equal(t, http.StatusOK, request("203.0.113.1"))
+	assert.Equal(t, http.StatusOK, request("203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, request("203.0.113.1"))
+	assert.Equal(t, http.StatusOK, request("203.0.113.2"))
+
+	// The bucket refills at the limit.
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, request("203.0.113.1"))
+
+	// Idle buckets are swept.
+	now = now.Add(idleLimiterTTL)
+	assert.Equal(t, http.StatusOK, request("203.0.113.3"))
+	assert.Len(t, limiters.limiters, 1)
+}
+
+func TestMaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		body           string
+		unknownLength  bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Within_Limit",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"a":1}`,
+		},
+		{
+			name:           "Announced_Too_Large",
+			body:           `{"a":"` + strings.Repeat("x", 32) + `"}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   `{"error":{"code":"payload_too_large","message":"request body too large","details":{"limit":16}}}`,
+		},
+		{
+			name:           "Streamed_Too_Large",
+			body:           `{"a":"` + strings.Repeat("x", 32) + `"}`,
+			unknownLength:  true,
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "request body too large",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(tt.body))
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+			w := serve(newTestEngine(MaxBodySize(16)), req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"delegator/internal/httpservice/auth"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// RequestIDHeader carries the id of a request, in both directions.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey holds the request id in the gin context.
+const requestIDKey = "request_id"
+
+// validRequestID matches the request ids accepted from the clients, other ids
+// are replaced so that they cannot forge log lines.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestID tags the request with the id given by the client, or a new UUID,
+// and echoes it in the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = uuid.NewString()
+		}
+
+		c.Set(requestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// GetRequestID returns the id of the request, empty when RequestID is not installed.
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(requestIDKey)
+}
+
+// AccessLog logs every request once it is answered, server errors as warnings.
+func AccessLog(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		attrs := []any{
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"route", c.FullPath(),
+			"status", c.Writer.Status(),
+			"duration", time.Since(start),
+			"bytes", c.Writer.Size(),
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
+			"request_id", GetRequestID(c),
+		}
+		if key, ok := auth.FromContext(c); ok {
+			attrs = append(attrs, "api_key", key.ID)
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, "errors", c.Errors.String())
+		}
+
+		if c.Writer.Status() >= http.StatusInternalServerError {
+			logger.Warn("http request", attrs...)
+			return
+		}
+		logger.Info("http request", attrs...)
+	}
+}
@@ -0,0 +1,40 @@
+// Package middleware holds the gin middleware every request goes through:
+// panic recovery, request ids, access logs, CORS, limits and security headers.
+package middleware
+
+import (
+	"delegator/conf"
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Stack returns the configured middleware, in the order they must be installed.
+// The request id comes first so that every log line reports it, and recovery
+// right below the access log so that panicked requests are logged as 500s.
+func Stack(logger *slog.Logger, cfg *conf.DelegatorConfig) []gin.HandlerFunc {
+	mw := cfg.Middleware
+
+	stack := []gin.HandlerFunc{RequestID()}
+	if mw.AccessLog {
+		stack = append(stack, AccessLog(logger))
+	}
+	stack = append(stack, Recovery(logger), SecureHeaders(mw.HSTSMaxAge))
+	if len(mw.CORS.AllowedOrigins) > 0 {
+		stack = append(stack, CORS(CORSConfig{
+			AllowedOrigins: mw.CORS.AllowedOrigins,
+			AllowedMethods: mw.CORS.AllowedMethods,
+			AllowedHeaders: mw.CORS.AllowedHeaders,
+			ExposedHeaders: mw.CORS.ExposedHeaders,
+			MaxAge:         mw.CORS.MaxAge,
+		}))
+	}
+	if mw.IPRateLimit > 0 {
+		stack = append(stack, IPRateLimit(mw.IPRateLimit, mw.IPRateBurst))
+	}
+	if mw.MaxBodySize > 0 {
+		stack = append(stack, MaxBodySize(mw.MaxBodySize))
+	}
+
+	return stack
+}
@@ -0,0 +1,274 @@
+package middleware
+
+import (
+	"bytes"
+	"delegator/conf"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEngine(handlers ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(handlers...)
+
+	engine.GET("/ping", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "pong"})
+	})
+	engine.GET("/panic", func(c *gin.Context) {
+		panic("boom")
+	})
+	engine.GET("/panic/streaming", func(c *gin.Context) {
+		c.String(http.StatusOK, "partial")
+		panic("boom")
+	})
+	engine.POST("/echo", func(c *gin.Context) {
+		var body map[string]any
+		if err := c.ShouldBindJSON(&body); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"msg": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, body)
+	})
+
+	return engine
+}
+
+func serve(engine *gin.Engine, req *http.Request) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+	return w
+}
+
+func TestRecovery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "No_Panic",
+			path:           "/ping",
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":"pong"}`,
+		},
+		{
+			name:           "Panic_Answered_With_JSON",
+			path:           "/panic",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   `{"error":{"code":"internal","message":"internal error","request_id":"{request_id}"}}`,
+		},
+		{
+			name:           "Panic_After_Response_Started",
+			path:           "/panic/streaming",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "partial",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			engine := newTestEngine(RequestID(), Recovery(slog.New(slog.NewJSONHandler(&logs, nil))))
+
+			w := serve(engine, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			expectedBody := strings.ReplaceAll(tt.expectedBody, "{request_id}", w.Header().Get(RequestIDHeader))
+			assert.Equal(t, expectedBody, w.Body.String())
+			if strings.HasPrefix(tt.path, "/panic") {
+				assert.Contains(t, logs.String(), `"panic":"boom"`)
+				assert.Contains(t, logs.String(), w.Header().Get(RequestIDHeader))
+			}
+		})
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		requestID string
+		kept      bool
+	}{
+		{name: "Generated", requestID: ""},
+		{name: "Client_ID_Kept", requestID: "edge-7f3a.01_b", kept: true},
+		{name: "Forged_ID_Replaced", requestID: "abc\n{\"level\":\"ERROR\"}"},
+		{name: "Long_ID_Replaced", requestID: strings.Repeat("a", 65)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var seen string
+			engine := newTestEngine(RequestID(), func(c *gin.Context) {
+				seen = GetRequestID(c)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+			req.Header.Set(RequestIDHeader, tt.requestID)
+			w := serve(engine, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			assert.Equal(t, id, seen)
+			if tt.kept {
+				assert.Equal(t, tt.requestID, id)
+			} else {
+				assert.Len(t, id, 36)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	t.Parallel()
+
+	var logs bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&logs, nil))
+	engine := newTestEngine(RequestID(), AccessLog(logger), Recovery(logger))
+
+	req := httptest.NewRequest(http.MethodGet, "/ping?units=tez", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	serve(engine, req)
+	serve(engine, httptest.NewRequest(http.MethodGet, "/panic", nil))
+
+	lines := strings.Split(strings.TrimSpace(logs.String()), "\n")
+	var entries []map[string]any
+	for _, line := range lines {
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["msg"] == "http request" {
+			entries = append(entries, entry)
+		}
+	}
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "INFO", entries[0]["level"])
+	assert.Equal(t, "GET", entries[0]["method"])
+	assert.Equal(t, "/ping", entries[0]["path"])
+	assert.Equal(t, "/ping", entries[0]["route"])
+	assert.Equal(t, float64(http.StatusOK), entries[0]["status"])
+	assert.Equal(t, "req-1", entries[0]["request_id"])
+	assert.Contains(t, entries[0], "duration")
+	assert.Contains(t, entries[0], "client_ip")
+
+	assert.Equal(t, "WARN", entries[1]["level"])
+	assert.Equal(t, float64(http.StatusInternalServerError), entries[1]["status"])
+}
+
+func TestSecureHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		hstsMaxAge   int
+		expectedHSTS string
+	}{
+		{name: "Without_HSTS", hstsMaxAge: 0, expectedHSTS: ""},
+		{name: "With_HSTS", hstsMaxAge: 31536000, expectedHSTS: "max-age=31536000; includeSubDomains"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := serve(newTestEngine(SecureHeaders(tt.hstsMaxAge)), httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+			assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+			assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+			assert.Equal(t, "no-referrer", w.Header().Get("Referrer-Policy"))
+			assert.Equal(t, contentSecurityPolicy, w.Header().Get("Content-Security-Policy"))
+			assert.Equal(t, tt.expectedHSTS, w.Header().Get("Strict-Transport-Security"))
+		})
+	}
+}
+
+func TestStack(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+	t.Run("Defaults", func(t *testing.T) {
+		t.Parallel()
+
+		// Request id, recovery and secure headers are always installed.
+		assert.Len(t, Stack(logger, &conf.DelegatorConfig{}), 3)
+	})
+
+	t.Run("Configured", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &conf.DelegatorConfig{}
+		cfg.Middleware.AccessLog = true
+		cfg.Middleware.MaxBodySize = 16
+		cfg.Middleware.IPRateLimit = 1
+		cfg.Middleware.IPRateBurst = 1
+		cfg.Middleware.CORS.AllowedOrigins = []string{"https://dashboard.example.com"}
+
+		stack := Stack(logger, cfg)
+		assert.Len(t, stack, 7)
+
+		engine := newTestEngine(stack...)
+
+		req := httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(`{"data":"more than sixteen bytes"}`))
+		req.Header.Set("Origin", "https://dashboard.example.com")
+		w := serve(engine, req)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Equal(t, "https://dashboard.example.com", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.NotEmpty(t, w.Header().Get(RequestIDHeader))
+
+		w = serve(engine, httptest.NewRequest(http.MethodGet, "/ping", nil))
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	})
+
+	t.Run("Local_Config", func(t *testing.T) {
+		t.Parallel()
+
+		cfg, err := conf.LoadConfigFromFile("../../../conf/config.local.toml")
+		require.NoError(t, err)
+
+		stack := Stack(logger, cfg)
+		assert.Len(t, stack, 7)
+
+		engine := newTestEngine(stack...)
+
+		req := httptest.NewRequest(http.MethodOptions, "/ping", nil)
+		req.Header.Set("Origin", "https://dashboard.example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		req.Header.Set("Access-Control-Request-Headers", "X-API-Key")
+		w := serve(engine, req)
+		assert.Equal(t, http.StatusNoContent, w.Code)
+		assert.Equal(t, "*", w.Header().Get("Access-Control-Allow-Origin"))
+		assert.Contains(t, w.Header().Get("Access-Control-Allow-Headers"), "X-API-Key")
+		assert.Equal(t, "600", w.Header().Get("Access-Control-Max-Age"))
+
+		req = httptest.NewRequest(http.MethodGet, "/ping", nil)
+		req.Header.Set("Origin", "https://dashboard.example.com")
+		w = serve(engine, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Access-Control-Expose-Headers"), "X-Quota-Remaining")
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+		assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+		assert.NotEmpty(t, w.Header().Get(RequestIDHeader))
+
+		big := `{"data":"` + strings.Repeat("a", int(cfg.Middleware.MaxBodySize)) + `"}`
+		w = serve(engine, httptest.NewRequest(http.MethodPost, "/echo", strings.NewReader(big)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
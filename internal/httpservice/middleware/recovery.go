@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery answers the requests whose handler panicked with a JSON 500 and logs
+// the panic with its stack. A response that was already started is cut short.
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			// http.ErrAbortHandler aborts the response on purpose, net/http handles it.
+			if err, ok := recovered.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+				panic(recovered)
+			}
+
+			logger.Error("handler panicked",
+				"panic", recovered,
+				"method", c.Request.Method,
+				"path", c.Request.URL.Path,
+				"request_id", GetRequestID(c),
+				"stack", string(debug.Stack()),
+			)
+
+			if c.Writer.Written() {
+				c.Abort()
+				return
+			}
+			apierror.Abort(c, domain.Internal("internal error", fmt.Errorf("panic: %v", recovered)))
+		}()
+
+		c.Next()
+	}
+}
@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// contentSecurityPolicy forbids every resource, the API only serves data. Pages
+// such as the Swagger UI set a policy of their own.
+const contentSecurityPolicy = "default-src 'none'; frame-ancestors 'none'"
+
+// SecureHeaders sets the security headers of the responses. Strict-Transport-Security
+// is only sent with a positive hstsMaxAge, in seconds, as the service may run
+// behind plain HTTP.
+func SecureHeaders(hstsMaxAge int) gin.HandlerFunc {
+	hsts := ""
+	if hstsMaxAge > 0 {
+		hsts = "max-age=" + strconv.Itoa(hstsMaxAge) + "; includeSubDomains"
+	}
+
+	return func(c *gin.Context) {
+		header := c.Writer.Header()
+		header.Set("X-Content-Type-Options", "nosniff")
+		header.Set("X-Frame-Options", "DENY")
+		header.Set("Referrer-Policy", "no-referrer")
+		header.Set("Content-Security-Policy", contentSecurityPolicy)
+		header.Set("Cross-Origin-Opener-Policy", "same-origin")
+		if hsts != "" {
+			header.Set("Strict-Transport-Security", hsts)
+		}
+		c.Next()
+	}
+}
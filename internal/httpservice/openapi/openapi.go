@@ -0,0 +1,141 @@
+// Package openapi holds the OpenAPI document of the HTTP API and validates the
+// query parameters of the requests against it.
+package openapi
+
+import (
+	"context"
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed openapi.yaml
+var document []byte
+
+// Load parses and validates the OpenAPI document.
+func Load() (*openapi3.T, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi document: %w", err)
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, fmt.Errorf("invalid openapi document: %w", err)
+	}
+	return doc, nil
+}
+
+// Path converts a gin route path to the templated path of the document, e.g.
+// /v1/bakers/:address to /v1/bakers/{address}.
+func Path(route string) string {
+	segments := strings.Split(route, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Alias documents the paths of the API version served under target also under
+// prefix, with deprecated operations, for the deprecated aliases of the version.
+func Alias(doc *openapi3.T, prefix, target string) {
+	for path, item := range doc.Paths.Map() {
+		if !strings.HasPrefix(path, target+"/") {
+			continue
+		}
+		aliasPath := prefix + strings.TrimPrefix(path, target)
+
+		alias := *item
+		for method, operation := range item.Operations() {
+			deprecated := *operation
+			deprecated.Deprecated = true
+			deprecated.Description = fmt.Sprintf("Deprecated alias of `%s %s`.", method, path)
+			if deprecated.OperationID != "" {
+				deprecated.OperationID += "_" + strings.Trim(prefix, "/")
+			}
+			alias.SetOperation(method, &deprecated)
+		}
+		doc.Paths.Set(aliasPath, &alias)
+	}
+}
+
+// Validator validates the query parameters of the requests against the
+// operation of their route, routes missing from the document are not checked.
+// It must be installed before the routes are registered.
+func Validator(doc *openapi3.T) gin.HandlerFunc {
+	options := &openapi3filter.Options{
+		// The handlers apply the defaults, the query string is left untouched.
+		SkipSettingDefaults: true,
+	}
+
+	return func(c *gin.Context) {
+		operation, parameters := find(doc, c.FullPath(), c.Request.Method)
+		if operation == nil {
+			c.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request:     c.Request,
+			QueryParams: c.Request.URL.Query(),
+			Options:     options,
+		}
+		for _, parameter := range parameters {
+			if parameter.Value.In != openapi3.ParameterInQuery {
+				continue
+			}
+			if err := openapi3filter.ValidateParameter(c, input, parameter.Value); err != nil {
+				apierror.Abort(c, domain.NewError(domain.CodeInvalidArgument, "%s", describe(parameter.Value.Name, err)).
+					WithDetail("parameter", parameter.Value.Name))
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// find returns the operation of a route with the parameters of its path item
+// and its own, nil when the route is not documented.
+func find(doc *openapi3.T, route, method string) (*openapi3.Operation, openapi3.Parameters) {
+	if route == "" {
+		return nil, nil
+	}
+	item := doc.Paths.Find(Path(route))
+	if item == nil {
+		return nil, nil
+	}
+	operation := item.GetOperation(method)
+	if operation == nil {
+		return nil, nil
+	}
+	return operation, append(append(openapi3.Parameters{}, item.Parameters...), operation.Parameters...)
+}
+
+// describe renders a validation error in the register of the handlers, e.g.
+// invalid units: value is not one of the allowed values ["mutez","tez"].
+func describe(name string, err error) string {
+	reason := err.Error()
+
+	var requestErr *openapi3filter.RequestError
+	if errors.As(err, &requestErr) {
+		var schemaErr *openapi3.SchemaError
+		switch {
+		case errors.As(requestErr.Err, &schemaErr):
+			reason = schemaErr.Reason
+		case requestErr.Reason != "":
+			reason = requestErr.Reason
+		case requestErr.Err != nil:
+			reason = requestErr.Err.Error()
+		}
+	}
+
+	return fmt.Sprintf("invalid %s: %s", name, reason)
+}
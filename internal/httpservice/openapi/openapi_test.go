@@ -0,0 +1,149 @@
+package openapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		route    string
+		expected string
+	}{
+		{route: "/health", expected: "/health"},
+		{route: "/xtz/bakers/:address", expected: "/xtz/bakers/{address}"},
+		{route: "/xtz/webhooks/:id/deliveries", expected: "/xtz/webhooks/{id}/deliveries"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.route, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Path(tt.route))
+		})
+	}
+}
+
+func TestAlias(t *testing.T) {
+	t.Parallel()
+
+	doc, err := Load()
+	require.NoError(t, err)
+	Alias(doc, "/xtz", "/v1")
+
+	versioned := doc.Paths.Find("/v1/bakers/{address}")
+	alias := doc.Paths.Find("/xtz/bakers/{address}")
+	require.NotNil(t, versioned)
+	require.NotNil(t, alias)
+
+	assert.False(t, versioned.Get.Deprecated)
+	assert.True(t, alias.Get.Deprecated)
+	assert.Equal(t, versioned.Get.OperationID+"_xtz", alias.Get.OperationID)
+	assert.Equal(t, versioned.Get.Parameters, alias.Get.Parameters)
+	assert.Nil(t, doc.Paths.Find("/xtz/health"))
+	assert.NoError(t, doc.Validate(t.Context()))
+}
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	doc, err := Load()
+	require.NoError(t, err)
+	Alias(doc, "/xtz", "/v1")
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "Valid_Query",
+			path:           "/xtz/delegations?units=tez&currency=eur&status=failed&format=csv",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Upper_Case_Currency",
+			path:           "/xtz/delegations?currency=USD",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Unknown_Currency",
+			path:           "/xtz/delegations?currency=chf",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Empty_Defaulted_Query",
+			path:           "/xtz/delegations?units=&status=",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid_Enum",
+			path:           "/xtz/delegations?units=nanotez",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"invalid_argument","message":"invalid units: value is not one of the allowed values [\"mutez\",\"tez\"]","details":{"parameter":"units"}}}`,
+		},
+		{
+			name:           "Invalid_Address",
+			path:           "/xtz/delegations?delegator=tz1nope",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"invalid_argument","message":"invalid delegator: string doesn't match the regular expression \"^(tz[1-4]|KT1|sr1)[1-9A-HJ-NP-Za-km-z]{33}$\"","details":{"parameter":"delegator"}}}`,
+		},
+		{
+			name:           "Limit_Out_Of_Range",
+			path:           "/xtz/stats/whales?limit=101",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"error":{"code":"invalid_argument","message":"invalid limit: number must be at most 100","details":{"parameter":"limit"}}}`,
+		},
+		{
+			name:           "Invalid_Enum_Versioned",
+			path:           "/v1/delegations?units=nanotez",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Limit_Not_An_Integer",
+			path:           "/xtz/stats/whales?limit=ten",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Path_Parameters_Not_Checked",
+			path:           "/xtz/bakers/nope",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Undocumented_Route",
+			path:           "/undocumented?units=nanotez",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			router.Use(Validator(doc))
+			for _, path := range []string{"/v1/delegations", "/xtz/delegations", "/xtz/stats/whales", "/xtz/bakers/:address", "/undocumented"} {
+				router.GET(path, func(c *gin.Context) {
+					c.Status(http.StatusOK)
+				})
+			}
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package routes
+
+import (
+	"crypto/subtle"
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// maxAPIKeyNameLength is the size of the name column of the API keys.
+	maxAPIKeyNameLength = 100
+	// maxUsageDays caps the number of days of usage a single request can list.
+	maxUsageDays = 366
+)
+
+// issueAPIKeyRequest is the body of an API key to issue, missing limits take the
+// configured defaults and zero limits are unlimited.
+type issueAPIKeyRequest struct {
+	Name       string   `json:"name"`
+	RateLimit  *float64 `json:"rate_limit"`
+	RateBurst  *int     `json:"rate_burst"`
+	DailyQuota *int64   `json:"daily_quota"`
+}
+
+// RegisterAPIKeyRoutes registers the admin routes of the API keys, which require
+// the admin token as a bearer Authorization header.
+func RegisterAPIKeyRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.APIKeyUseCase,
+	adminToken string,
+) {
+	keys := router.Group("/admin/api-keys", requireAdmin(adminToken))
+
+	keys.POST("", func(c *gin.Context) {
+		var req issueAPIKeyRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apierror.Abort(c, invalidBody(err))
+			return
+		}
+
+		dto, err := parseIssueAPIKey(req)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.IssueAPIKey(c, dto)
+		if err != nil {
+			logger.Warn("failed to issue api key", "error", err)
+			apierror.Abort(c, domain.Internal("failed to issue api key", err))
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{"data": res})
+	})
+
+	keys.GET("", func(c *gin.Context) {
+		res, err := useCase.GetAPIKeys(c)
+		if err != nil {
+			logger.Warn("failed to get api keys", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get api keys", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, res)
+	})
+
+	keys.GET("/:id", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetAPIKey(c, id)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("api key not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get api key", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to get api key", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": res})
+	})
+
+	keys.DELETE("/:id", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		err = useCase.RevokeAPIKey(c, id)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("api key not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to revoke api key", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to revoke api key", err))
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	})
+
+	keys.GET("/:id/usage", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		days, err := parseDaysQuery(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetUsage(c, id, days)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("api key not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get api key usage", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to get api key usage", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, res)
+	})
+}
+
+// requireAdmin rejects the requests without the admin token, compared in
+// constant time. Every request is rejected when no token is configured.
+func requireAdmin(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scheme, given, ok := strings.Cut(c.GetHeader("Authorization"), " ")
+		if token == "" || !ok || !strings.EqualFold(scheme, "Bearer") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimSpace(given)), []byte(token)) != 1 {
+			apierror.Abort(c, domain.NewError(domain.CodeUnauthenticated, "invalid admin token"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// parseIssueAPIKey validates an API key to issue, limits must not be negative.
+func parseIssueAPIKey(req issueAPIKeyRequest) (domain.IssueAPIKeyDTO, error) {
+	dto := domain.IssueAPIKeyDTO{
+		Name:       strings.TrimSpace(req.Name),
+		RateLimit:  req.RateLimit,
+		RateBurst:  req.RateBurst,
+		DailyQuota: req.DailyQuota,
+	}
+
+	if dto.Name == "" || len(dto.Name) > maxAPIKeyNameLength {
+		return dto, fmt.Errorf("invalid name: expected between 1 and %d characters", maxAPIKeyNameLength)
+	}
+	if req.RateLimit != nil && *req.RateLimit < 0 {
+		return dto, errors.New("invalid rate_limit: must not be negative")
+	}
+	if req.RateBurst != nil && *req.RateBurst < 0 {
+		return dto, errors.New("invalid rate_burst: must not be negative")
+	}
+	if req.DailyQuota != nil && *req.DailyQuota < 0 {
+		return dto, errors.New("invalid daily_quota: must not be negative")
+	}
+
+	return dto, nil
+}
+
+// parseDaysQuery reads the number of days of usage to return, zero when unset.
+func parseDaysQuery(c *gin.Context) (int, error) {
+	value, ok := c.GetQuery("days")
+	if !ok {
+		return 0, nil
+	}
+
+	days, err := strconv.Atoi(value)
+	if err != nil || days <= 0 || days > maxUsageDays {
+		return 0, fmt.Errorf("invalid days: expected an integer between 1 and %d", maxUsageDays)
+	}
+	return days, nil
+}
+
+func CreateAPIKeyRegistrar(
+	logger *slog.Logger,
+	apiKeyUseCase domain.APIKeyUseCase,
+	adminToken string,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterAPIKeyRoutes(router, logger, apiKeyUseCase, adminToken)
+	}
+}
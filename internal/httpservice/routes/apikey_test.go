@@ -0,0 +1,239 @@
+package routes
+
+import (
+	"context"
+	"delegator/conf"
+	"delegator/internal/core/apikey"
+	"delegator/internal/models"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAPIKeyEndpoints(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("5d2a7f4e-1c3b-4e8a-9f60-7b2c4d6e8a10")
+	rateLimit := 2.5
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		body           string
+		setupMocks     func(*mocks.MockAPIKeyUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Issue",
+			method: http.MethodPost,
+			path:   "/admin/api-keys",
+			token:  "Bearer admin-secret",
+			body:   `{"name":" dashboard ","rate_limit":2.5}`,
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().IssueAPIKey(mock.Anything, domain.IssueAPIKeyDTO{Name: "dashboard", RateLimit: &rateLimit}).
+					Return(domain.APIKeyResponseType{ID: id, Name: "dashboard", Key: "dlg_abc"}, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"key":"dlg_abc"`,
+		},
+		{
+			name:           "Issue_Missing_Name",
+			method:         http.MethodPost,
+			path:           "/admin/api-keys",
+			token:          "Bearer admin-secret",
+			body:           `{"daily_quota":10}`,
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid name",
+		},
+		{
+			name:           "Issue_Negative_Quota",
+			method:         http.MethodPost,
+			path:           "/admin/api-keys",
+			token:          "Bearer admin-secret",
+			body:           `{"name":"scraper","daily_quota":-1}`,
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid daily_quota",
+		},
+		{
+			name:           "Missing_Admin_Token",
+			method:         http.MethodGet,
+			path:           "/admin/api-keys",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "invalid admin token",
+		},
+		{
+			name:           "Wrong_Admin_Token",
+			method:         http.MethodGet,
+			path:           "/admin/api-keys",
+			token:          "Bearer admin-secre",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   "invalid admin token",
+		},
+		{
+			name:   "List",
+			method: http.MethodGet,
+			path:   "/admin/api-keys",
+			token:  "bearer admin-secret",
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().GetAPIKeys(mock.Anything).
+					Return(domain.ApiResponse[domain.APIKeyResponseType]{Data: []domain.APIKeyResponseType{{ID: id, Prefix: "dlg_3f9a1c2e"}}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"prefix":"dlg_3f9a1c2e"`,
+		},
+		{
+			name:   "Get_Not_Found",
+			method: http.MethodGet,
+			path:   "/admin/api-keys/" + id.String(),
+			token:  "Bearer admin-secret",
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().GetAPIKey(mock.Anything, id).Return(domain.APIKeyResponseType{}, domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "api key not found",
+		},
+		{
+			name:   "Revoke",
+			method: http.MethodDelete,
+			path:   "/admin/api-keys/" + id.String(),
+			token:  "Bearer admin-secret",
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().RevokeAPIKey(mock.Anything, id).Return(nil).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "Revoke_Error",
+			method: http.MethodDelete,
+			path:   "/admin/api-keys/" + id.String(),
+			token:  "Bearer admin-secret",
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().RevokeAPIKey(mock.Anything, id).Return(errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to revoke api key",
+		},
+		{
+			name:   "Usage",
+			method: http.MethodGet,
+			path:   "/admin/api-keys/" + id.String() + "/usage?days=7",
+			token:  "Bearer admin-secret",
+			setupMocks: func(m *mocks.MockAPIKeyUseCase) {
+				m.EXPECT().GetUsage(mock.Anything, id, 7).
+					Return(domain.ApiResponse[domain.APIKeyUsageResponseType]{Data: []domain.APIKeyUsageResponseType{{Day: "2024-06-01", Requests: 42}}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[{"day":"2024-06-01","requests":42}]}`,
+		},
+		{
+			name:           "Usage_Invalid_Days",
+			method:         http.MethodGet,
+			path:           "/admin/api-keys/" + id.String() + "/usage?days=0",
+			token:          "Bearer admin-secret",
+			setupMocks:     func(m *mocks.MockAPIKeyUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid days",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockAPIKeyUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			RegisterAPIKeyRoutes(router, slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase, "admin-secret")
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestAPIKeyEndpoints_NoAdminToken(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	RegisterAPIKeyRoutes(router, slog.New(slog.NewJSONHandler(os.Stdout, nil)), mocks.NewMockAPIKeyUseCase(t), "")
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodGet, "/admin/api-keys", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+// TestAPIKeyEndpoints_LocalConfig issues a key with the key defaults of
+// config.local.toml, the admin token is set by the test as the file has none.
+func TestAPIKeyEndpoints_LocalConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := conf.LoadConfigFromFile("../../../conf/config.local.toml")
+	assert.NoError(t, err)
+	if err != nil {
+		return
+	}
+	// The embedded config ships without a token, which disables the admin routes.
+	assert.Empty(t, config.Auth.AdminToken)
+	config.Auth.AdminToken = "test-admin-token"
+
+	mockRepo := mocks.NewMockAPIKeyRepository(t)
+	mockRepo.EXPECT().CreateAPIKey(mock.Anything, mock.MatchedBy(func(key models.APIKey) bool {
+		return key.Name == "dashboard" &&
+			key.RateLimit == config.Auth.RateLimit &&
+			key.RateBurst == config.Auth.RateBurst &&
+			key.DailyQuota == config.Auth.DailyQuota
+	})).RunAndReturn(func(_ context.Context, key models.APIKey) (models.APIKey, error) {
+		key.ID = uuid.New()
+		return key, nil
+	}).Once()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	useCase := apikey.NewUseCase(
+		apikey.UseCaseWithLogger(logger),
+		apikey.UseCaseWithRepository(mockRepo),
+		apikey.UseCaseWithDefaults(config.Auth.RateLimit, config.Auth.RateBurst, config.Auth.DailyQuota),
+	)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	RegisterAPIKeyRoutes(router, logger, useCase, config.Auth.AdminToken)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/admin/api-keys", strings.NewReader(`{"name":"dashboard"}`))
+	req.Header.Set("Authorization", "Bearer "+config.Auth.AdminToken)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Contains(t, w.Body.String(), `"key":"dlg_`)
+}
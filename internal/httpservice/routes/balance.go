@@ -0,0 +1,97 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterBalanceRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.BalanceUseCase,
+) {
+	router.GET("/bakers/:address/balance", func(c *gin.Context) {
+		address, err := parseAddressParam(c, "address")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		filter, err := parseBalanceHistoryFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetBakerBalance(c, address, filter, opts)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("baker balance not found").WithDetail("address", address))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get baker balance", "error", err, "address", address)
+			apierror.Abort(c, domain.Internal("failed to get baker balance", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": mapResponse(c, res), "units": opts.Units})
+	})
+}
+
+// parseBalanceHistoryFilter reads the RFC 3339 from and to bounds of the history.
+func parseBalanceHistoryFilter(c *gin.Context) (domain.BalanceHistoryFilter, error) {
+	var filter domain.BalanceHistoryFilter
+
+	from, err := parseTimeQuery(c, "from")
+	if err != nil {
+		return filter, err
+	}
+	filter.From = from
+
+	to, err := parseTimeQuery(c, "to")
+	if err != nil {
+		return filter, err
+	}
+	filter.To = to
+
+	if from != nil && to != nil && to.Before(*from) {
+		return filter, fmt.Errorf("invalid to: must not be before from")
+	}
+
+	return filter, nil
+}
+
+func parseTimeQuery(c *gin.Context, key string) (*time.Time, error) {
+	value, ok := c.GetQuery(key)
+	if !ok {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: expected an RFC 3339 timestamp: %w", key, err)
+	}
+	return &parsed, nil
+}
+
+func CreateBalanceRegistrar(
+	logger *slog.Logger,
+	balanceUseCase domain.BalanceUseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterBalanceRoutes(router, logger, balanceUseCase)
+	}
+}
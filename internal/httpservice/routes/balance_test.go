@@ -0,0 +1,106 @@
+package routes
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBalanceEndpoints(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMocks     func(*mocks.MockBalanceUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Get_Baker_Balance",
+			path: "/xtz/bakers/" + baker.String() + "/balance?from=2024-06-01T00:00:00Z&units=tez",
+			setupMocks: func(m *mocks.MockBalanceUseCase) {
+				m.EXPECT().GetBakerBalance(mock.Anything, baker, domain.BalanceHistoryFilter{From: &from}, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.BakerBalanceResponseType{Baker: baker, Delegators: 2}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"units":"tez"`,
+		},
+		{
+			name:           "Get_Baker_Balance_Invalid_Address",
+			path:           "/xtz/bakers/tz1nope/balance",
+			setupMocks:     func(m *mocks.MockBalanceUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid address",
+		},
+		{
+			name:           "Get_Baker_Balance_Invalid_From",
+			path:           "/xtz/bakers/" + baker.String() + "/balance?from=yesterday",
+			setupMocks:     func(m *mocks.MockBalanceUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid from",
+		},
+		{
+			name:           "Get_Baker_Balance_To_Before_From",
+			path:           "/xtz/bakers/" + baker.String() + "/balance?from=2024-06-02T00:00:00Z&to=2024-06-01T00:00:00Z",
+			setupMocks:     func(m *mocks.MockBalanceUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid to",
+		},
+		{
+			name: "Get_Baker_Balance_Not_Found",
+			path: "/xtz/bakers/" + baker.String() + "/balance",
+			setupMocks: func(m *mocks.MockBalanceUseCase) {
+				m.EXPECT().GetBakerBalance(mock.Anything, baker, domain.BalanceHistoryFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.BakerBalanceResponseType{}, domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "baker balance not found",
+		},
+		{
+			name: "Get_Baker_Balance_Error",
+			path: "/xtz/bakers/" + baker.String() + "/balance",
+			setupMocks: func(m *mocks.MockBalanceUseCase) {
+				m.EXPECT().GetBakerBalance(mock.Anything, baker, domain.BalanceHistoryFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.BakerBalanceResponseType{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get baker balance",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockBalanceUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			CreateBalanceRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)(router.Group("/xtz"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package routes
 
 import (
+	"delegator/internal/httpservice/apierror"
 	"delegator/pkg/domain"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -9,34 +11,152 @@ import (
 )
 
 func RegisterBaseRoutes(
-	router *gin.Engine,
+	router gin.IRouter,
 	logger *slog.Logger,
 	useCase domain.UseCase,
 ) {
-	router.GET("/health", func(c *gin.Context) {
-		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	router.GET("/delegations", func(c *gin.Context) {
+		filter, err := parseDelegationFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		respondDelegations(c, logger, useCase, filter)
+	})
+
+	router.GET("/bakers", func(c *gin.Context) {
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetBakers(c, opts)
+		if err != nil {
+			logger.Warn("failed to get bakers", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get bakers", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
 	})
 
-	xtz := router.Group("/xtz")
-	xtz.GET("/delegations", func(c *gin.Context) {
-		res, err := useCase.GetDelegations(c)
+	router.GET("/bakers/:address", func(c *gin.Context) {
+		address, err := parseAddressParam(c, "address")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetBaker(c, address, opts)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("baker not found").WithDetail("address", address))
+			return
+		}
 		if err != nil {
-			logger.Warn("failed to get delegations", "error", err)
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"msg": "failed to get delegations",
-			})
+			logger.Warn("failed to get baker", "error", err, "address", address)
+			apierror.Abort(c, domain.Internal("failed to get baker", err))
 			return
 		}
 
-		c.JSON(http.StatusOK, res)
+		c.JSON(http.StatusOK, gin.H{"data": mapResponse(c, res), "units": opts.Units})
 	})
 }
 
+// respondDelegations renders the delegations matching filter in the requested format,
+// with the rendering options, fields and expansion read from the query string.
+func respondDelegations(c *gin.Context, logger *slog.Logger, useCase domain.UseCase, filter domain.DelegationFilter) {
+	format, err := parseExportFormat(c)
+	if err != nil {
+		apierror.Abort(c, domain.WrapError(domain.CodeNotAcceptable, err))
+		return
+	}
+
+	opts, err := parseResponseOptions(c)
+	if err != nil {
+		apierror.Abort(c, domain.InvalidArgument(err))
+		return
+	}
+
+	fields, err := parseFields(c)
+	if err != nil {
+		apierror.Abort(c, domain.InvalidArgument(err))
+		return
+	}
+
+	opts.Expand, err = parseExpand(c)
+	if err != nil {
+		apierror.Abort(c, domain.InvalidArgument(err))
+		return
+	}
+	opts.Expand = opts.Expand || requiresExpand(fields)
+
+	switch format {
+	case formatCSV, formatParquet:
+		if fields != nil {
+			apierror.Abort(c, domain.NewError(domain.CodeInvalidArgument, "invalid fields: not supported with the %s format", format))
+			return
+		}
+		// Flat exports always carry the operation metadata columns.
+		opts.Expand = true
+		exportDelegations(c, logger, useCase, filter, opts, format, nil)
+		return
+	case formatNDJSON:
+		exportDelegations(c, logger, useCase, filter, opts, format, fields)
+		return
+	}
+
+	res, err := useCase.GetDelegations(c, filter, opts)
+	if err != nil {
+		logger.Warn("failed to get delegations", "error", err)
+		apierror.Abort(c, domain.Internal("failed to get delegations", err))
+		return
+	}
+
+	body := mapResponse(c, res)
+	if fields == nil {
+		c.JSON(http.StatusOK, body)
+		return
+	}
+
+	// The fields are picked from the response in the shape of the version.
+	projected, err := projectData(body, fields)
+	if err != nil {
+		logger.Warn("failed to project delegations", "error", err)
+		apierror.Abort(c, domain.Internal("failed to get delegations", err))
+		return
+	}
+
+	c.JSON(http.StatusOK, projected)
+}
+
+// RegisterHealthRoutes registers the health check, which is not versioned.
+func RegisterHealthRoutes(
+	router gin.IRouter,
+) {
+	router.GET("/health", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"data": "ok"})
+	})
+}
+
+func CreateHealthRegistrar() RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterHealthRoutes(router)
+	}
+}
+
 func CreateDelegatorRegistrar(
 	logger *slog.Logger,
 	queryUseCase domain.UseCase,
 ) RouteRegistrar {
-	return func(engine *gin.Engine) {
-		RegisterBaseRoutes(engine, logger, queryUseCase)
+	return func(router gin.IRouter) {
+		RegisterBaseRoutes(router, logger, queryUseCase)
 	}
 }
@@ -48,12 +48,13 @@ func TestRegisterBaseRoutes(t *testing.T) {
 			useCase, logger := tt.args.setupMocks()
 
 			assert.NotPanics(t, func() {
-				RegisterBaseRoutes(router, logger, useCase)
+				RegisterHealthRoutes(router)
+				RegisterBaseRoutes(router.Group("/xtz"), logger, useCase)
 			})
 
 			// Verify routes are registered
 			routes := router.Routes()
-			assert.Len(t, routes, 2) // health + delegations endpoints
+			assert.Len(t, routes, 4) // health + delegations + bakers endpoints
 
 			// Check health endpoint exists
 			healthFound := false
@@ -93,10 +94,7 @@ func TestHealthEndpoint(t *testing.T) {
 
 			gin.SetMode(gin.TestMode)
 			router := gin.New()
-			mockUseCase := mocks.NewMockUseCase(t)
-			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-
-			RegisterBaseRoutes(router, logger, mockUseCase)
+			RegisterHealthRoutes(router)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest("GET", "/health", nil)
@@ -130,13 +128,13 @@ func TestDelegationsEndpoint(t *testing.T) {
 						Data: []domain.DelegationsResponseType{
 							{
 								Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
-								Amount:    100000,
+								Amount:    domain.NewAmount(100000, domain.UnitMutez),
 								Delegator: "tz1delegator1",
 								Level:     1000,
 							},
 						},
 					}
-					mockUseCase.EXPECT().GetDelegations(mock.Anything).Return(response, nil).Once()
+					mockUseCase.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(response, nil).Once()
 					return mockUseCase
 				},
 			},
@@ -148,7 +146,7 @@ func TestDelegationsEndpoint(t *testing.T) {
 			args: args{
 				setupMocks: func() domain.UseCase {
 					mockUseCase := mocks.NewMockUseCase(t)
-					mockUseCase.EXPECT().GetDelegations(mock.Anything).Return(
+					mockUseCase.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(
 						domain.ApiResponse[domain.DelegationsResponseType]{}, 
 						errors.New("database error"),
 					).Once()
@@ -169,7 +167,7 @@ func TestDelegationsEndpoint(t *testing.T) {
 			useCase := tt.args.setupMocks()
 			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 
-			RegisterBaseRoutes(router, logger, useCase)
+			RegisterBaseRoutes(router.Group("/xtz"), logger, useCase)
 
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest("GET", "/xtz/delegations", nil)
@@ -227,7 +225,7 @@ func TestCreateDelegatorRegistrar(t *testing.T) {
 
 			// Verify routes are registered
 			routes := engine.Routes()
-			assert.Len(t, routes, 2) // health + delegations endpoints
+			assert.Len(t, routes, 3) // delegations + bakers endpoints
 		})
 	}
 }
@@ -257,16 +255,21 @@ func TestRouteRegistrar_Integration(t *testing.T) {
 				Data: []domain.DelegationsResponseType{
 					{
 						Timestamp: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
-						Amount:    100000,
+						Amount:    domain.NewAmount(100000, domain.UnitMutez),
 						Delegator: "tz1delegator1",
 						Level:     1000,
 					},
 				},
 			}
-			mockUseCase.EXPECT().GetDelegations(mock.Anything).Return(response, nil).Once()
+			mockUseCase.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(response, nil).Once()
 
 			// Create and register routes
-			registrar := CreateDelegatorRegistrar(logger, mockUseCase)
+			registrar := CreateRouteRegistrar(
+				CreateHealthRegistrar(),
+				CreateVersionRegistrar(NewVersion("v1", VersionWithAlias("/xtz", time.Time{}, time.Time{})),
+					CreateDelegatorRegistrar(logger, mockUseCase),
+				),
+			)
 			registrar(engine)
 
 			// Test health endpoint
@@ -282,4 +285,271 @@ func TestRouteRegistrar_Integration(t *testing.T) {
 			assert.Equal(t, http.StatusOK, w2.Code)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestDelegationsEndpoint_Filters(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb")
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+
+	tests := []struct {
+		name           string
+		query          string
+		setupMocks     func(*mocks.MockUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "Valid_Delegator_And_Baker",
+			query: "?delegator=" + delegator.String() + "&baker=" + baker.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{
+					Delegator: &delegator,
+					Baker:     &baker,
+				}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(domain.ApiResponse[domain.DelegationsResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Currency",
+			query: "?currency=usd",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{
+					Units:    domain.UnitMutez,
+					Currency: domain.CurrencyUSD,
+				}).Return(domain.ApiResponse[domain.DelegationsResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid_Currency",
+			query:          "?currency=doge",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid currency",
+		},
+		{
+			name:  "Expand_Full",
+			query: "?expand=full",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{
+					Units:  domain.UnitMutez,
+					Expand: true,
+				}).Return(domain.ApiResponse[domain.DelegationsResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid_Expand",
+			query:          "?expand=partial",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid expand",
+		},
+		{
+			name:  "Fields_Projection",
+			query: "?fields=delegator,level",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{
+					Units: domain.UnitMutez,
+				}).Return(domain.ApiResponse[domain.DelegationsResponseType]{
+					Data: []domain.DelegationsResponseType{
+						{Delegator: delegator, Level: 1000, Amount: domain.NewAmount(1, domain.UnitMutez)},
+					},
+					Units: domain.UnitMutez,
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[{"delegator":"` + delegator.String() + `","level":1000}],"units":"mutez"}`,
+		},
+		{
+			name:  "Metadata_Fields_Expand",
+			query: "?fields=delegator,block,baker_fee",
+			setupMocks: func(m *mocks.MockUseCase) {
+				block := "BLockHash123"
+				fee := domain.NewAmount(397, domain.UnitMutez)
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{
+					Units:  domain.UnitMutez,
+					Expand: true,
+				}).Return(domain.ApiResponse[domain.DelegationsResponseType]{
+					Data: []domain.DelegationsResponseType{
+						{Delegator: delegator, DelegationMetadata: &domain.DelegationMetadata{Block: &block, BakerFee: &fee}},
+					},
+					Units: domain.UnitMutez,
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"baker_fee":"397","block":"BLockHash123","delegator":"` + delegator.String() + `"`,
+		},
+		{
+			name:           "Unknown_Field",
+			query:          "?fields=delegator,password",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "unknown field",
+		},
+		{
+			name:  "Status_Failed",
+			query: "?delegator=" + delegator.String() + "&status=failed",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{
+					Delegator: &delegator,
+					Status:    domain.StatusFailed,
+				}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(domain.ApiResponse[domain.DelegationsResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:  "Status_Applied",
+			query: "?status=applied",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(domain.ApiResponse[domain.DelegationsResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid_Status",
+			query:          "?status=pending",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid status",
+		},
+		{
+			name:           "Truncated_Delegator",
+			query:          "?delegator=tz1VSUr8wwNhLAzempoch5d6hLRiTh8",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid delegator",
+		},
+		{
+			name:           "Malformed_Baker",
+			query:          "?baker=not-an-address",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid baker",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+			RegisterBaseRoutes(router.Group("/xtz"), logger, mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/xtz/delegations"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
+
+func TestBakersEndpoints(t *testing.T) {
+	t.Parallel()
+
+	address := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMocks     func(*mocks.MockUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "List_Bakers_In_Tez",
+			path: "/xtz/bakers?units=tez",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBakers(mock.Anything, domain.ResponseOptions{Units: domain.UnitTez}).Return(
+					domain.ApiResponse[domain.BakerResponseType]{
+						Data: []domain.BakerResponseType{
+							{Address: address, DelegatedAmount: domain.NewAmount(1500000, domain.UnitTez)},
+						},
+						Units: domain.UnitTez,
+					}, nil,
+				).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"delegated_amount":"1.500000"`,
+		},
+		{
+			name:           "List_Bakers_Invalid_Units",
+			path:           "/xtz/bakers?units=xtz",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid units",
+		},
+		{
+			name: "List_Bakers_Error",
+			path: "/xtz/bakers",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBakers(mock.Anything, domain.ResponseOptions{Units: domain.UnitMutez}).Return(
+					domain.ApiResponse[domain.BakerResponseType]{}, errors.New("database error"),
+				).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get bakers",
+		},
+		{
+			name: "Get_Baker",
+			path: "/xtz/bakers/" + address.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBaker(mock.Anything, address, domain.ResponseOptions{Units: domain.UnitMutez}).Return(
+					domain.BakerResponseType{Address: address, DelegatedAmount: domain.NewAmount(1500000, domain.UnitMutez)}, nil,
+				).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"delegated_amount":"1500000"`,
+		},
+		{
+			name: "Get_Baker_Not_Found",
+			path: "/xtz/bakers/" + address.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBaker(mock.Anything, address, domain.ResponseOptions{Units: domain.UnitMutez}).Return(
+					domain.BakerResponseType{}, domain.ErrNotFound,
+				).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "baker not found",
+		},
+		{
+			name:           "Get_Baker_Invalid_Address",
+			path:           "/xtz/bakers/tz1aSkwEot3L2kmUvcoxzjMomb9mvBNu",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+			RegisterBaseRoutes(router.Group("/xtz"), logger, mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
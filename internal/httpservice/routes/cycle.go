@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterCycleRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	delegatorUseCase domain.UseCase,
+	cycleUseCase domain.CycleUseCase,
+) {
+	cycles := router.Group("/cycles/:cycle")
+	cycles.GET("/delegations", func(c *gin.Context) {
+		cycle, err := parseCycleParam(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		filter, err := parseDelegationFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+		if filter.Status != "" {
+			apierror.Abort(c, domain.NewError(domain.CodeInvalidArgument, "invalid status: only applied delegations are assigned a cycle"))
+			return
+		}
+		filter.Cycle = &cycle
+
+		respondDelegations(c, logger, delegatorUseCase, filter)
+	})
+
+	cycles.GET("/bakers", func(c *gin.Context) {
+		cycle, err := parseCycleParam(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := cycleUseCase.GetBakers(c, cycle, opts)
+		if err != nil {
+			logger.Warn("failed to get cycle bakers", "error", err, "cycle", cycle)
+			apierror.Abort(c, domain.Internal("failed to get cycle bakers", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+}
+
+func parseCycleParam(c *gin.Context) (int64, error) {
+	cycle, err := strconv.ParseInt(c.Param("cycle"), 10, 64)
+	if err == nil && cycle < 0 {
+		err = errors.New("must not be negative")
+	}
+	if err != nil {
+		return 0, fmt.Errorf("invalid cycle: %w", err)
+	}
+	return cycle, nil
+}
+
+func CreateCycleRegistrar(
+	logger *slog.Logger,
+	delegatorUseCase domain.UseCase,
+	cycleUseCase domain.CycleUseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterCycleRoutes(router, logger, delegatorUseCase, cycleUseCase)
+	}
+}
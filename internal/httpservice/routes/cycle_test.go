@@ -0,0 +1,112 @@
+package routes
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCycleEndpoints(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	cycle := int64(750)
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMocks     func(*mocks.MockUseCase, *mocks.MockCycleUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Cycle_Delegations",
+			path: "/xtz/cycles/750/delegations?baker=" + baker.String(),
+			setupMocks: func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {
+				d.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{Baker: &baker, Cycle: &cycle}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.DelegationsResponseType]{
+						Data:  []domain.DelegationsResponseType{{Delegator: "tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT", Cycle: &cycle}},
+						Units: domain.UnitMutez,
+					}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"cycle":750`,
+		},
+		{
+			name:           "Cycle_Delegations_Invalid_Cycle",
+			path:           "/xtz/cycles/latest/delegations",
+			setupMocks:     func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid cycle",
+		},
+		{
+			name:           "Cycle_Delegations_Negative_Cycle",
+			path:           "/xtz/cycles/-1/delegations",
+			setupMocks:     func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid cycle",
+		},
+		{
+			name:           "Cycle_Delegations_Failed_Status",
+			path:           "/xtz/cycles/750/delegations?status=failed",
+			setupMocks:     func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid status",
+		},
+		{
+			name: "Cycle_Bakers",
+			path: "/xtz/cycles/750/bakers?units=tez",
+			setupMocks: func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {
+				c.EXPECT().GetBakers(mock.Anything, cycle, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.ApiResponse[domain.CycleBakerResponseType]{
+						Data:  []domain.CycleBakerResponseType{{Cycle: cycle, Address: baker, Delegators: 2}},
+						Units: domain.UnitTez,
+					}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"delegators":2`,
+		},
+		{
+			name: "Cycle_Bakers_Error",
+			path: "/xtz/cycles/750/bakers",
+			setupMocks: func(d *mocks.MockUseCase, c *mocks.MockCycleUseCase) {
+				c.EXPECT().GetBakers(mock.Anything, cycle, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.CycleBakerResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get cycle bakers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockDelegatorUseCase := mocks.NewMockUseCase(t)
+			mockCycleUseCase := mocks.NewMockCycleUseCase(t)
+			tt.setupMocks(mockDelegatorUseCase, mockCycleUseCase)
+
+			CreateCycleRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockDelegatorUseCase, mockCycleUseCase)(router.Group("/xtz"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
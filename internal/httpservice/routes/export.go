@@ -0,0 +1,376 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/parquet-go/parquet-go"
+)
+
+// exportFormat is the representation delegations are rendered in.
+type exportFormat string
+
+const (
+	formatJSON    exportFormat = "json"
+	formatCSV     exportFormat = "csv"
+	formatNDJSON  exportFormat = "ndjson"
+	formatParquet exportFormat = "parquet"
+)
+
+const (
+	mimeCSV     = "text/csv"
+	mimeNDJSON  = "application/x-ndjson"
+	mimeParquet = "application/vnd.apache.parquet"
+)
+
+// exportRowGroupSize is the number of rows buffered in a parquet row group
+// before it is written out.
+const exportRowGroupSize = 10_000
+
+// acceptedFormats maps the media types and ranges of the Accept header to their
+// format, JSON stands for the ranges covering it.
+var acceptedFormats = map[string]exportFormat{
+	gin.MIMEJSON:            formatJSON,
+	mimeCSV:                 formatCSV,
+	mimeNDJSON:              formatNDJSON,
+	"application/ndjson":    formatNDJSON,
+	mimeParquet:             formatParquet,
+	"application/x-parquet": formatParquet,
+	"*/*":                   formatJSON,
+	"application/*":         formatJSON,
+	"text/*":                formatCSV,
+}
+
+// parseExportFormat reads the format query parameter, or negotiates the format
+// from the Accept header when it is missing. JSON is the default, and the
+// fallback of the headers that do not name a supported format unless they only
+// list other media types.
+func parseExportFormat(c *gin.Context) (exportFormat, error) {
+	if value, ok := c.GetQuery("format"); ok {
+		switch format := exportFormat(value); format {
+		case formatJSON, formatCSV, formatNDJSON, formatParquet:
+			return format, nil
+		default:
+			return "", fmt.Errorf("invalid format: %q, expected one of json, csv, ndjson, parquet", value)
+		}
+	}
+
+	accept := c.GetHeader("Accept")
+	if accept == "" {
+		return formatJSON, nil
+	}
+
+	format, ok := negotiateFormat(accept)
+	if !ok {
+		return "", fmt.Errorf("unsupported Accept header: expected one of %s, %s, %s, %s", gin.MIMEJSON, mimeCSV, mimeNDJSON, mimeParquet)
+	}
+	return format, nil
+}
+
+// negotiateFormat returns the format of the Accept header media range with the
+// highest quality, the first one on ties. It reports false when every listed
+// range is valid, and either unsupported or refused with q=0.
+func negotiateFormat(accept string) (exportFormat, bool) {
+	var best exportFormat
+	bestQuality := 0.0
+	explicit := true
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			explicit = false
+			continue
+		}
+
+		quality := 1.0
+		if value, ok := params["q"]; ok {
+			quality, err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				explicit = false
+				continue
+			}
+		}
+
+		format, ok := acceptedFormats[mediaType]
+		if ok && quality > bestQuality {
+			best, bestQuality = format, quality
+		}
+	}
+
+	if best == "" && !explicit {
+		return formatJSON, true
+	}
+	return best, best != ""
+}
+
+// exportRow is the flat representation of a delegation in the CSV and parquet exports.
+type exportRow struct {
+	OperationID   *int64    `parquet:"operation_id,optional"`
+	OperationHash *string   `parquet:"operation_hash,optional"`
+	Timestamp     time.Time `parquet:"timestamp,timestamp(millisecond)"`
+	Level         int64     `parquet:"level"`
+	Cycle         *int64    `parquet:"cycle,optional"`
+	Delegator     string    `parquet:"delegator"`
+	Baker         *string   `parquet:"baker,optional"`
+	PreviousBaker *string   `parquet:"previous_baker,optional"`
+	Amount        string    `parquet:"amount"`
+	Status        string    `parquet:"status"`
+	FiatCurrency  *string   `parquet:"fiat_currency,optional"`
+	FiatValue     *float64  `parquet:"fiat_value,optional"`
+}
+
+// exportColumns are the CSV header, in the order of the exportRow fields.
+var exportColumns = []string{
+	"operation_id", "operation_hash", "timestamp", "level", "cycle", "delegator",
+	"baker", "previous_baker", "amount", "status", "fiat_currency", "fiat_value",
+}
+
+// toExportRow flattens a delegation in the shape of the version of the request.
+// A version that maps the delegations to another type is flattened through the
+// JSON of its shape, so the columns keep the fields it renders under the same
+// names.
+func toExportRow(mapped any) (exportRow, error) {
+	delegation, ok := mapped.(domain.DelegationsResponseType)
+	if !ok {
+		raw, err := json.Marshal(mapped)
+		if err != nil {
+			return exportRow{}, err
+		}
+		if err := json.Unmarshal(raw, &delegation); err != nil {
+			return exportRow{}, err
+		}
+	}
+
+	row := exportRow{
+		Timestamp: delegation.Timestamp,
+		Level:     delegation.Level,
+		Cycle:     delegation.Cycle,
+		Delegator: delegation.Delegator.String(),
+		Amount:    delegation.Amount.String(),
+		Status:    string(delegation.Status),
+	}
+	if row.Status == "" {
+		row.Status = string(domain.StatusApplied)
+	}
+
+	if metadata := delegation.DelegationMetadata; metadata != nil {
+		row.OperationID = metadata.OperationID
+		row.OperationHash = metadata.OperationHash
+		row.Baker = addressString(metadata.Baker)
+		row.PreviousBaker = addressString(metadata.PreviousBaker)
+	}
+
+	if fiat := delegation.FiatValue; fiat != nil {
+		currency := string(fiat.Currency)
+		row.FiatCurrency = &currency
+		row.FiatValue = &fiat.Value
+	}
+	return row, nil
+}
+
+func (r exportRow) record() []string {
+	return []string{
+		optionalInt(r.OperationID),
+		optionalString(r.OperationHash),
+		r.Timestamp.UTC().Format(time.RFC3339),
+		strconv.FormatInt(r.Level, 10),
+		optionalInt(r.Cycle),
+		r.Delegator,
+		optionalString(r.Baker),
+		optionalString(r.PreviousBaker),
+		r.Amount,
+		r.Status,
+		optionalString(r.FiatCurrency),
+		optionalFloat(r.FiatValue),
+	}
+}
+
+// exportWriter writes delegations one at a time, in the shape of the version of
+// the request. Close completes the document.
+type exportWriter interface {
+	Write(delegation any) error
+	Close() error
+}
+
+func newExportWriter(w io.Writer, format exportFormat, fields []string) exportWriter {
+	switch format {
+	case formatCSV:
+		return &csvWriter{writer: csv.NewWriter(w)}
+	case formatParquet:
+		return &parquetWriter{writer: parquet.NewGenericWriter[exportRow](w,
+			parquet.MaxRowsPerRowGroup(exportRowGroupSize),
+			parquet.Compression(&parquet.Snappy),
+		)}
+	default:
+		return &ndjsonWriter{encoder: json.NewEncoder(w), fields: fields}
+	}
+}
+
+type csvWriter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writer.Write(exportColumns)
+}
+
+func (w *csvWriter) Write(delegation any) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	row, err := toExportRow(delegation)
+	if err != nil {
+		return err
+	}
+	return w.writer.Write(row.record())
+}
+
+func (w *csvWriter) Close() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+	fields  []string
+}
+
+func (w *ndjsonWriter) Write(delegation any) error {
+	if w.fields == nil {
+		return w.encoder.Encode(delegation)
+	}
+
+	projected, err := project([]any{delegation}, w.fields)
+	if err != nil {
+		return err
+	}
+	return w.encoder.Encode(projected[0])
+}
+
+func (w *ndjsonWriter) Close() error {
+	return nil
+}
+
+type parquetWriter struct {
+	writer *parquet.GenericWriter[exportRow]
+}
+
+func (w *parquetWriter) Write(delegation any) error {
+	row, err := toExportRow(delegation)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write([]exportRow{row})
+	return err
+}
+
+func (w *parquetWriter) Close() error {
+	return w.writer.Close()
+}
+
+// exportDelegations streams the delegations matching filter in format. The headers are
+// only sent with the first delegation, so a failing query is still answered with a
+// JSON error; a failure in the middle of the stream truncates the response.
+func exportDelegations(
+	c *gin.Context,
+	logger *slog.Logger,
+	useCase domain.UseCase,
+	filter domain.DelegationFilter,
+	opts domain.ResponseOptions,
+	format exportFormat,
+	fields []string,
+) {
+	var writer exportWriter
+	start := func() {
+		c.Header("Content-Type", exportContentType(format))
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="delegations.%s"`, format))
+		c.Header("X-Units", string(opts.Units))
+		c.Status(http.StatusOK)
+		writer = newExportWriter(c.Writer, format, fields)
+	}
+
+	rows := 0
+	err := useCase.ExportDelegations(c, filter, opts, func(delegation domain.DelegationsResponseType) error {
+		if writer == nil {
+			start()
+		}
+		rows++
+		return writer.Write(mapResponse(c, delegation))
+	})
+	if err != nil && writer == nil {
+		logger.Warn("failed to export delegations", "error", err, "format", format)
+		apierror.Abort(c, domain.Internal("failed to export delegations", err))
+		return
+	}
+	if err != nil {
+		logger.Warn("delegation export interrupted", "error", err, "format", format, "rows", rows)
+		c.Abort()
+		return
+	}
+
+	if writer == nil {
+		start()
+	}
+	if err := writer.Close(); err != nil {
+		logger.Warn("failed to complete delegation export", "error", err, "format", format, "rows", rows)
+		c.Abort()
+	}
+}
+
+func exportContentType(format exportFormat) string {
+	switch format {
+	case formatCSV:
+		return mimeCSV + "; charset=utf-8"
+	case formatParquet:
+		return mimeParquet
+	default:
+		return mimeNDJSON
+	}
+}
+
+func addressString(address *domain.Address) *string {
+	if address == nil {
+		return nil
+	}
+	res := address.String()
+	return &res
+}
+
+func optionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalInt(value *int64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatInt(*value, 10)
+}
+
+func optionalFloat(value *float64) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*value, 'f', -1, 64)
+}
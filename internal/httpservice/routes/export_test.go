@@ -0,0 +1,252 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/parquet-go/parquet-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelegationsEndpoint_Export(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb")
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	operationID := int64(42)
+	cycle := int64(7)
+	timestamp := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	delegation := domain.DelegationsResponseType{
+		Timestamp: timestamp,
+		Amount:    domain.NewAmount(1500000, domain.UnitMutez),
+		Delegator: delegator,
+		Level:     1000,
+		Cycle:     &cycle,
+		DelegationMetadata: &domain.DelegationMetadata{
+			OperationID: &operationID,
+			Baker:       &baker,
+		},
+	}
+	exportTwice := func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+		for range 2 {
+			if err := fn(delegation); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	expandedOpts := domain.ResponseOptions{Units: domain.UnitMutez, Expand: true}
+
+	tests := []struct {
+		name                string
+		query               string
+		accept              string
+		setupMocks          func(*mocks.MockUseCase)
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:  "CSV_Format_Query",
+			query: "?format=csv&baker=" + baker.String(),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{Baker: &baker}, expandedOpts, mock.Anything).
+					RunAndReturn(exportTwice).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody: "operation_id,operation_hash,timestamp,level,cycle,delegator,baker,previous_baker,amount,status,fiat_currency,fiat_value\n" +
+				"42,,2024-06-01T12:00:00Z,1000,7," + delegator.String() + "," + baker.String() + ",,1500000,applied,,\n" +
+				"42,,2024-06-01T12:00:00Z,1000,7," + delegator.String() + "," + baker.String() + ",,1500000,applied,,\n",
+		},
+		{
+			name:   "CSV_Accept_Header_Empty",
+			accept: "text/csv",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{}, expandedOpts, mock.Anything).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "operation_id,operation_hash,timestamp,level,cycle,delegator,baker,previous_baker,amount,status,fiat_currency,fiat_value\n",
+		},
+		{
+			name:   "NDJSON_Accept_Header_Fields",
+			accept: "application/x-ndjson",
+			query:  "?fields=delegator,level",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}, mock.Anything).
+					RunAndReturn(exportTwice).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: mimeNDJSON,
+			expectedBody: `{"delegator":"` + delegator.String() + `","level":1000}` + "\n" +
+				`{"delegator":"` + delegator.String() + `","level":1000}` + "\n",
+		},
+		{
+			name:   "JSON_By_Default",
+			accept: "*/*",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.DelegationsResponseType]{Data: []domain.DelegationsResponseType{}, Units: domain.UnitMutez}, nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name:   "Browser_Accept_Header",
+			accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.DelegationsResponseType]{Data: []domain.DelegationsResponseType{}, Units: domain.UnitMutez}, nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name:   "Accept_Header_Quality",
+			accept: "application/json;q=0.5, text/csv",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{}, expandedOpts, mock.Anything).Return(nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/csv; charset=utf-8",
+			expectedBody:        "operation_id,operation_hash,timestamp,level,cycle,delegator,baker,previous_baker,amount,status,fiat_currency,fiat_value\n",
+		},
+		{
+			name:   "Malformed_Accept_Header",
+			accept: "text/html;q=high",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.DelegationsResponseType]{Data: []domain.DelegationsResponseType{}, Units: domain.UnitMutez}, nil).Once()
+			},
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name:           "Refused_Accept_Header",
+			accept:         "application/json;q=0, text/html",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusNotAcceptable,
+			expectedBody:   "unsupported Accept header",
+		},
+		{
+			name:           "Invalid_Format",
+			query:          "?format=xml",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusNotAcceptable,
+			expectedBody:   "invalid format",
+		},
+		{
+			name:           "Unsupported_Accept_Header",
+			accept:         "application/xml",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusNotAcceptable,
+			expectedBody:   "unsupported Accept header",
+		},
+		{
+			name:           "Fields_With_CSV",
+			query:          "?format=csv&fields=delegator",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid fields: not supported with the csv format",
+		},
+		{
+			name:  "Export_Error",
+			query: "?format=ndjson",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}, mock.Anything).
+					Return(errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to export delegations",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+
+			RegisterBaseRoutes(router.Group("/xtz"), logger, mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/xtz/delegations"+tt.query, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedContentType != "" {
+				assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			}
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+				return
+			}
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestDelegationsEndpoint_ExportParquet(t *testing.T) {
+	t.Parallel()
+
+	delegator := domain.Address("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb")
+	timestamp := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	mockUseCase := mocks.NewMockUseCase(t)
+	mockUseCase.EXPECT().ExportDelegations(mock.Anything, domain.DelegationFilter{}, domain.ResponseOptions{Units: domain.UnitTez, Expand: true}, mock.Anything).
+		RunAndReturn(func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+			return fn(domain.DelegationsResponseType{
+				Timestamp:          timestamp,
+				Amount:             domain.NewAmount(1500000, domain.UnitTez),
+				Delegator:          delegator,
+				Level:              1000,
+				DelegationMetadata: &domain.DelegationMetadata{},
+			})
+		}).Once()
+
+	RegisterBaseRoutes(router.Group("/xtz"), slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/xtz/delegations?units=tez", nil)
+	req.Header.Set("Accept", "application/vnd.apache.parquet")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, mimeParquet, w.Header().Get("Content-Type"))
+
+	rows, err := parquet.Read[exportRow](bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	assert.NoError(t, err)
+	assert.Equal(t, []exportRow{
+		{
+			Timestamp: timestamp,
+			Level:     1000,
+			Delegator: delegator.String(),
+			Amount:    "1.500000",
+			Status:    "applied",
+		},
+	}, rows)
+}
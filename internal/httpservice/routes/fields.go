@@ -0,0 +1,143 @@
+package routes
+
+import (
+	"delegator/pkg/domain"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	// delegationFields are the fields a delegation can be projected on.
+	delegationFields = jsonFields(reflect.TypeFor[domain.DelegationsResponseType]())
+	// metadataFields are the fields only rendered when the metadata is expanded.
+	metadataFields = jsonFields(reflect.TypeFor[domain.DelegationMetadata]())
+)
+
+// parseExpand reads the expand query parameter, "full" is the only supported value.
+func parseExpand(c *gin.Context) (bool, error) {
+	value, ok := c.GetQuery("expand")
+	if !ok {
+		return false, nil
+	}
+	if value != "full" {
+		return false, fmt.Errorf("invalid expand: %q, expected \"full\"", value)
+	}
+	return true, nil
+}
+
+// parseFields reads the comma separated fields query parameter. It returns nil
+// when every field should be rendered.
+func parseFields(c *gin.Context) ([]string, error) {
+	value, ok := c.GetQuery("fields")
+	if !ok {
+		return nil, nil
+	}
+
+	var fields []string
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !delegationFields[field] {
+			return nil, fmt.Errorf("invalid fields: unknown field %q", field)
+		}
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("invalid fields: no field requested")
+	}
+	return fields, nil
+}
+
+// requiresExpand reports whether one of fields is part of the delegation metadata.
+func requiresExpand(fields []string) bool {
+	for _, field := range fields {
+		if metadataFields[field] {
+			return true
+		}
+	}
+	return false
+}
+
+// project renders every item with the requested fields only.
+func project[T any](items []T, fields []string) ([]map[string]json.RawMessage, error) {
+	res := make([]map[string]json.RawMessage, len(items))
+	for i, item := range items {
+		raw, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &all); err != nil {
+			return nil, err
+		}
+
+		res[i] = make(map[string]json.RawMessage, len(fields))
+		for _, field := range fields {
+			if value, ok := all[field]; ok {
+				res[i][field] = value
+			}
+		}
+	}
+	return res, nil
+}
+
+// projectData renders the items of the data of a response body with the
+// requested fields only, the other members of the body are kept.
+func projectData(body any, fields []string) (map[string]json.RawMessage, error) {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(envelope["data"], &items); err != nil {
+		return nil, err
+	}
+
+	projected, err := project(items, fields)
+	if err != nil {
+		return nil, err
+	}
+	if envelope["data"], err = json.Marshal(projected); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// jsonFields returns the JSON names of the fields of t, including the fields
+// of embedded structs.
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool)
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if field.Anonymous {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			for name := range jsonFields(embedded) {
+				fields[name] = true
+			}
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = true
+	}
+	return fields
+}
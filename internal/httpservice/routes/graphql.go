@@ -0,0 +1,59 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/internal/httpservice/graph"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// graphqlRequest is the body of a GraphQL query.
+type graphqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func RegisterGraphQLRoutes(
+	router gin.IRouter,
+	executor *graph.Executor,
+) {
+	router.POST("/graphql", func(c *gin.Context) {
+		var req graphqlRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apierror.Abort(c, invalidBody(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, executor.Exec(c, req.Query, req.OperationName, req.Variables))
+	})
+
+	// GET only serves queries, the variables are given as a JSON object.
+	router.GET("/graphql", func(c *gin.Context) {
+		req := graphqlRequest{
+			Query:         c.Query("query"),
+			OperationName: c.Query("operationName"),
+		}
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				apierror.Abort(c, domain.NewError(domain.CodeInvalidArgument, "invalid variables: %s", err))
+				return
+			}
+		}
+
+		c.JSON(http.StatusOK, executor.Exec(c, req.Query, req.OperationName, req.Variables))
+	})
+}
+
+func CreateGraphQLRegistrar(
+	logger *slog.Logger,
+	delegatorUseCase domain.UseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterGraphQLRoutes(router, graph.NewExecutor(logger, delegatorUseCase))
+	}
+}
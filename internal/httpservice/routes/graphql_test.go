@@ -0,0 +1,192 @@
+package routes
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestGraphQLEndpoints(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	previousBaker := domain.Address("tz1aRoaRhSpRYvFdyvgWLL6TGyRoGF51wDjM")
+	delegator := domain.Address("tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL")
+	opts := domain.ResponseOptions{Units: domain.UnitMutez, Expand: true}
+
+	delegation := domain.DelegationsResponseType{
+		Delegator: delegator,
+		Level:     100,
+		Amount:    domain.NewAmount(2500000, domain.UnitMutez),
+		DelegationMetadata: &domain.DelegationMetadata{
+			Baker:         &baker,
+			PreviousBaker: &previousBaker,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		setupMocks     func(*mocks.MockUseCase)
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:   "Delegations_Batch_Bakers",
+			method: http.MethodPost,
+			target: "/graphql",
+			body:   `{"query":"{ delegations(first: 2) { edges { cursor node { kind amount(units: TEZ) baker { address } previousBaker { address } } } pageInfo { hasNextPage endCursor } } }"}`,
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, domain.DelegationFilter{}, domain.Page{First: 2}, opts).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{
+						Data:        []domain.DelegationsResponseType{delegation, delegation},
+						Cursors:     []string{"first", "second"},
+						HasNextPage: true,
+					}, nil).Once()
+				// Both delegations resolve their bakers with a single lookup.
+				m.EXPECT().GetBakersByAddress(mock.Anything, mock.MatchedBy(func(addresses []domain.Address) bool {
+					return assert.ElementsMatch(t, []domain.Address{baker, previousBaker}, addresses)
+				}), opts).Return(map[domain.Address]domain.BakerResponseType{
+					baker:         {Address: baker},
+					previousBaker: {Address: previousBaker},
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				`"kind":"REDELEGATION"`,
+				`"amount":"2.500000"`,
+				`"baker":{"address":"` + baker.String() + `"}`,
+				`"pageInfo":{"hasNextPage":true,"endCursor":"second"}`,
+			},
+		},
+		{
+			name:   "Baker_Delegators_History",
+			method: http.MethodPost,
+			target: "/graphql",
+			body:   `{"query":"query($address: String!) { baker(address: $address) { delegators(first: 1) { edges { node { address currentDelegation { level } history { edges { node { level } } } } } } } }","variables":{"address":"` + baker.String() + `"}}`,
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBakersByAddress(mock.Anything, []domain.Address{baker}, opts).
+					Return(map[domain.Address]domain.BakerResponseType{baker: {Address: baker}}, nil).Once()
+				m.EXPECT().GetCurrentDelegations(mock.Anything, baker, domain.Page{First: 1}, opts).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{
+						Data:    []domain.DelegationsResponseType{delegation},
+						Cursors: []string{domain.AddressCursor(delegator)},
+					}, nil).Once()
+				// The current delegation comes with the page, only the histories are fetched, in one batch.
+				m.EXPECT().GetDelegationsPages(mock.Anything, domain.GroupByDelegator, []domain.Address{delegator}, domain.Page{First: domain.DefaultPageSize}, opts).
+					Return(map[domain.Address]domain.PageResponse[domain.DelegationsResponseType]{
+						delegator: {
+							Data:    []domain.DelegationsResponseType{delegation},
+							Cursors: []string{"history"},
+						},
+					}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				`"address":"` + delegator.String() + `"`,
+				`"currentDelegation":{"level":100}`,
+				`"history":{"edges":[{"node":{"level":100}}]}`,
+			},
+		},
+		{
+			name:   "Get_With_Variables",
+			method: http.MethodGet,
+			target: "/graphql?query=" + url.QueryEscape(`query($address: String!) { delegator(address: $address) { address baker { address } } }`) +
+				"&variables=" + url.QueryEscape(`{"address":"`+delegator.String()+`"}`),
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetLatestDelegations(mock.Anything, []domain.Address{delegator}, opts).
+					Return(map[domain.Address]domain.DelegationsResponseType{delegator: delegation}, nil).Once()
+				m.EXPECT().GetBakersByAddress(mock.Anything, []domain.Address{baker}, opts).
+					Return(map[domain.Address]domain.BakerResponseType{baker: {Address: baker}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"delegator":{"address":"` + delegator.String() + `","baker":{"address":"` + baker.String() + `"}}`},
+		},
+		{
+			name:           "Invalid_Address",
+			method:         http.MethodPost,
+			target:         "/graphql",
+			body:           `{"query":"{ baker(address: \"tz1nope\") { address } }"}`,
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{`"baker":null`, "invalid address"},
+		},
+		{
+			name:   "Invalid_Cursor",
+			method: http.MethodPost,
+			target: "/graphql",
+			body:   `{"query":"{ delegations(after: \"nope\") { edges { cursor } } }"}`,
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegationsPage(mock.Anything, domain.DelegationFilter{}, domain.Page{First: domain.DefaultPageSize, After: "nope"}, opts).
+					Return(domain.PageResponse[domain.DelegationsResponseType]{}, domain.ErrInvalidCursor).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{"invalid cursor"},
+		},
+		{
+			name:   "Internal_Error_Hidden",
+			method: http.MethodPost,
+			target: "/graphql",
+			body:   `{"query":"{ bakers { edges { cursor } } }"}`,
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetBakersPage(mock.Anything, domain.Page{First: domain.DefaultPageSize}, opts).
+					Return(domain.PageResponse[domain.BakerResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{"failed to get bakers"},
+		},
+		{
+			name:           "Invalid_Body",
+			method:         http.MethodPost,
+			target:         "/graphql",
+			body:           `{"query":`,
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"invalid body"},
+		},
+		{
+			name:           "Invalid_Variables",
+			method:         http.MethodGet,
+			target:         "/graphql?query=%7B__typename%7D&variables=nope",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"invalid variables"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			router := gin.New()
+			CreateGraphQLRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)(router)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			for _, body := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), body)
+			}
+			assert.NotContains(t, w.Body.String(), "db down")
+		})
+	}
+}
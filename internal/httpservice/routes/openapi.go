@@ -0,0 +1,82 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// swaggerUIPolicy only lets the Swagger UI page load its own assets and fetch
+// the document, the API responses forbid every resource. Swagger UI sets inline
+// styles, its scripts are all served from /docs.
+const swaggerUIPolicy = "default-src 'none'; script-src 'self'; style-src 'self' 'unsafe-inline'; " +
+	"img-src 'self' data:; connect-src 'self'; frame-ancestors 'none'"
+
+// swaggerUI renders /openapi.json with the Swagger UI 5.18.2 assets of
+// github.com/swaggo/files/v2, embedded in the binary and pinned by go.sum.
+const swaggerUI = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>Tezos Delegation Indexer</title>
+  <link rel="stylesheet" href="/docs/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="/docs/swagger-ui-bundle.js"></script>
+  <script src="/docs/swagger-initializer.js"></script>
+</body>
+</html>
+`
+
+// swaggerUIInitializer starts Swagger UI, in its own file as the policy forbids
+// inline scripts. The online validator badge is disabled as it loads an image of
+// validator.swagger.io.
+const swaggerUIInitializer = `window.onload = () => {
+  window.ui = SwaggerUIBundle({url: "/openapi.json", dom_id: "#swagger-ui", validatorUrl: null});
+};
+`
+
+// swaggerUIAssets are the files of the Swagger UI distribution the page loads.
+var swaggerUIAssets = map[string]bool{
+	"swagger-ui.css":       true,
+	"swagger-ui-bundle.js": true,
+}
+
+func RegisterOpenAPIRoutes(
+	router gin.IRouter,
+	doc *openapi3.T,
+) {
+	router.GET("/openapi.json", func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	})
+
+	router.GET("/docs", func(c *gin.Context) {
+		c.Header("Content-Security-Policy", swaggerUIPolicy)
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUI))
+	})
+
+	router.GET("/docs/:file", func(c *gin.Context) {
+		file := c.Param("file")
+		switch {
+		case file == "swagger-initializer.js":
+			c.Data(http.StatusOK, "text/javascript; charset=utf-8", []byte(swaggerUIInitializer))
+		case swaggerUIAssets[file]:
+			c.FileFromFS(file, http.FS(swaggerFiles.FS))
+		default:
+			apierror.Abort(c, domain.NotFound("file not found").WithDetail("file", file))
+		}
+	})
+}
+
+func CreateOpenAPIRegistrar(
+	doc *openapi3.T,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterOpenAPIRoutes(router, doc)
+	}
+}
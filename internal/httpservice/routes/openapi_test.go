@@ -0,0 +1,144 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/openapi"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOpenAPI_Document checks that the document describes exactly the routes
+// of the registrars.
+func TestOpenAPI_Document(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi.Load()
+	require.NoError(t, err)
+	v1 := NewVersion("v1", VersionWithAlias("/xtz", time.Time{}, time.Time{}))
+	for _, alias := range v1.Aliases {
+		openapi.Alias(doc, alias.Prefix, v1.Prefix)
+	}
+
+	gin.SetMode(gin.TestMode)
+	logger := slog.New(slog.NewTextHandler(os.Stdout, nil))
+	engine := gin.New()
+	CreateRouteRegistrar(
+		CreateHealthRegistrar(),
+		CreateVersionRegistrar(v1,
+			CreateDelegatorRegistrar(logger, nil),
+			CreateStreamRegistrar(logger, nil),
+			CreateStakingRegistrar(logger, nil),
+			CreateBalanceRegistrar(logger, nil),
+			CreateCycleRegistrar(logger, nil, nil),
+			CreateStatsRegistrar(logger, nil),
+			CreateWebhookRegistrar(logger, nil),
+		),
+		CreateGraphQLRegistrar(logger, nil),
+		CreateOpenAPIRegistrar(doc),
+		CreateAPIKeyRegistrar(logger, nil, "token"),
+	)(engine)
+
+	registered := make(map[string]bool)
+	for _, route := range engine.Routes() {
+		operation := route.Method + " " + openapi.Path(route.Path)
+		registered[operation] = true
+
+		item := doc.Paths.Find(openapi.Path(route.Path))
+		if assert.NotNil(t, item, "undocumented route %s", operation) {
+			assert.NotNil(t, item.GetOperation(route.Method), "undocumented route %s", operation)
+		}
+	}
+
+	for path, item := range doc.Paths.Map() {
+		for method := range item.Operations() {
+			assert.True(t, registered[method+" "+path], "documented route %s %s is not registered", method, path)
+		}
+	}
+}
+
+func TestOpenAPIEndpoints(t *testing.T) {
+	t.Parallel()
+
+	doc, err := openapi.Load()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		path                string
+		expectedStatus      int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:                "Document",
+			path:                "/openapi.json",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `"openapi":"3.0.3"`,
+		},
+		{
+			name:                "Swagger_UI",
+			path:                "/docs",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `<script src="/docs/swagger-ui-bundle.js"></script>`,
+		},
+		{
+			name:                "Swagger_UI_Initializer",
+			path:                "/docs/swagger-initializer.js",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/javascript; charset=utf-8",
+			expectedBody:        `url: "/openapi.json"`,
+		},
+		{
+			name:                "Swagger_UI_Bundle",
+			path:                "/docs/swagger-ui-bundle.js",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/javascript; charset=utf-8",
+			expectedBody:        "SwaggerUIBundle",
+		},
+		{
+			name:                "Swagger_UI_Stylesheet",
+			path:                "/docs/swagger-ui.css",
+			expectedStatus:      http.StatusOK,
+			expectedContentType: "text/css; charset=utf-8",
+			expectedBody:        ".swagger-ui",
+		},
+		{
+			name:                "Swagger_UI_Unknown_File",
+			path:                "/docs/index.html",
+			expectedStatus:      http.StatusNotFound,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        "file not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			RegisterOpenAPIRoutes(router, doc)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedContentType, w.Header().Get("Content-Type"))
+			assert.True(t, strings.Contains(w.Body.String(), tt.expectedBody), w.Body.String())
+			if tt.path == "/docs" {
+				assert.Equal(t, swaggerUIPolicy, w.Header().Get("Content-Security-Policy"))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package routes
+
+import (
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// parseDelegationFilter reads the delegation filters from the query string.
+func parseDelegationFilter(c *gin.Context) (domain.DelegationFilter, error) {
+	var filter domain.DelegationFilter
+
+	delegator, err := parseAddressQuery(c, "delegator")
+	if err != nil {
+		return filter, err
+	}
+	filter.Delegator = delegator
+
+	baker, err := parseAddressQuery(c, "baker")
+	if err != nil {
+		return filter, err
+	}
+	filter.Baker = baker
+
+	status, err := domain.ParseOperationStatus(c.Query("status"))
+	if err != nil {
+		return filter, fmt.Errorf("invalid status: %w", err)
+	}
+	if status != domain.StatusApplied {
+		filter.Status = status
+	}
+
+	return filter, nil
+}
+
+// parseResponseOptions reads the rendering options from the query string.
+func parseResponseOptions(c *gin.Context) (domain.ResponseOptions, error) {
+	var opts domain.ResponseOptions
+
+	units, err := domain.ParseUnit(c.Query("units"))
+	if err != nil {
+		return opts, fmt.Errorf("invalid units: %w", err)
+	}
+	opts.Units = units
+
+	if value, ok := c.GetQuery("currency"); ok {
+		currency, err := domain.ParseCurrency(value)
+		if err != nil {
+			return opts, fmt.Errorf("invalid currency: %w", err)
+		}
+		opts.Currency = currency
+	}
+
+	return opts, nil
+}
+
+func parseAddressQuery(c *gin.Context, key string) (*domain.Address, error) {
+	value, ok := c.GetQuery(key)
+	if !ok {
+		return nil, nil
+	}
+
+	address, err := domain.ParseAddress(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return &address, nil
+}
+
+func parseAddressParam(c *gin.Context, key string) (domain.Address, error) {
+	address, err := domain.ParseAddress(c.Param(key))
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return address, nil
+}
+
+// invalidBody returns the error of a body that failed to bind, which is too large
+// rather than invalid when it exceeds the body size limit.
+func invalidBody(err error) *domain.APIError {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return domain.NewError(domain.CodePayloadTooLarge, "request body too large").
+			WithDetail("limit", maxBytesErr.Limit)
+	}
+	return domain.NewError(domain.CodeInvalidArgument, "invalid body: %s", err)
+}
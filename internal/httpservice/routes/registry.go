@@ -4,8 +4,9 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// RouteRegistrar defines a function that registers routes on an engine.
-type RouteRegistrar func(*gin.Engine)
+// RouteRegistrar defines a function that registers routes on a router, the
+// engine or the group of an API version.
+type RouteRegistrar func(gin.IRouter)
 
 // RouteRegistry manages multiple route registrars.
 type RouteRegistry struct {
@@ -24,10 +25,10 @@ func (r *RouteRegistry) AddRegistrar(registrar RouteRegistrar) {
 	r.registrars = append(r.registrars, registrar)
 }
 
-// RegisterAll registers all routes with the provided engine.
-func (r *RouteRegistry) RegisterAll(engine *gin.Engine) {
+// RegisterAll registers all routes with the provided router.
+func (r *RouteRegistry) RegisterAll(router gin.IRouter) {
 	for _, registrar := range r.registrars {
-		registrar(engine)
+		registrar(router)
 	}
 }
 
@@ -41,3 +42,17 @@ func CreateRouteRegistrar(registrars ...RouteRegistrar) func(*gin.Engine) {
 		registry.RegisterAll(engine)
 	}
 }
+
+// CreateVersionRegistrar creates a route registrar that registers the routes of
+// registrars under the prefix of version and under each of its aliases.
+func CreateVersionRegistrar(version *Version, registrars ...RouteRegistrar) RouteRegistrar {
+	return func(router gin.IRouter) {
+		registry := NewRouteRegistry()
+		for _, registrar := range registrars {
+			registry.AddRegistrar(registrar)
+		}
+		for _, prefix := range version.prefixes() {
+			registry.RegisterAll(router.Group(prefix))
+		}
+	}
+}
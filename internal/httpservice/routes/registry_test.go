@@ -59,7 +59,7 @@ func TestRouteRegistry_AddRegistrar(t *testing.T) {
 
 			// Add the specified number of registrars
 			for i := 0; i < tt.args.registrarCount; i++ {
-				registrar := func(engine *gin.Engine) {
+				registrar := func(router gin.IRouter) {
 					// Mock registrar function
 				}
 				registry.AddRegistrar(registrar)
@@ -101,9 +101,9 @@ func TestRouteRegistry_RegisterAll(t *testing.T) {
 
 			// Add the specified number of registrars
 			for i := 0; i < tt.registrarCount; i++ {
-				registrar := func(engine *gin.Engine) {
+				registrar := func(router gin.IRouter) {
 					callCount++
-					assert.NotNil(t, engine)
+					assert.NotNil(t, router)
 				}
 				registry.AddRegistrar(registrar)
 			}
@@ -150,9 +150,9 @@ func TestCreateRouteRegistrar(t *testing.T) {
 			// Create mock registrars
 			var registrars []RouteRegistrar
 			for i := 0; i < tt.args.registrarCount; i++ {
-				registrar := func(engine *gin.Engine) {
+				registrar := func(router gin.IRouter) {
 					callCount++
-					assert.NotNil(t, engine)
+					assert.NotNil(t, router)
 				}
 				registrars = append(registrars, registrar)
 			}
@@ -184,7 +184,7 @@ func TestRouteRegistrar_Type(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			var registrar RouteRegistrar = func(engine *gin.Engine) {
+			var registrar RouteRegistrar = func(router gin.IRouter) {
 				// Mock function
 			}
 
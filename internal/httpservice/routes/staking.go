@@ -0,0 +1,100 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func RegisterStakingRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.StakingUseCase,
+) {
+	staking := router.Group("/staking")
+	staking.GET("", func(c *gin.Context) {
+		filter, err := parseStakingFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetStaking(c, filter, opts)
+		if err != nil {
+			logger.Warn("failed to get staking operations", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get staking operations", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+
+	staking.GET("/stakers", func(c *gin.Context) {
+		filter, err := parseStakingFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetStakers(c, filter, opts)
+		if err != nil {
+			logger.Warn("failed to get stakers", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get stakers", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+}
+
+// parseStakingFilter reads the staking filters from the query string.
+func parseStakingFilter(c *gin.Context) (domain.StakingFilter, error) {
+	var filter domain.StakingFilter
+
+	staker, err := parseAddressQuery(c, "staker")
+	if err != nil {
+		return filter, err
+	}
+	filter.Staker = staker
+
+	baker, err := parseAddressQuery(c, "baker")
+	if err != nil {
+		return filter, err
+	}
+	filter.Baker = baker
+
+	if value, ok := c.GetQuery("action"); ok {
+		action, err := domain.ParseStakingAction(value)
+		if err != nil {
+			return filter, fmt.Errorf("invalid action: %w", err)
+		}
+		filter.Action = &action
+	}
+
+	return filter, nil
+}
+
+func CreateStakingRegistrar(
+	logger *slog.Logger,
+	stakingUseCase domain.StakingUseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterStakingRoutes(router, logger, stakingUseCase)
+	}
+}
@@ -0,0 +1,104 @@
+package routes
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStakingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	staker := domain.Address("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb")
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	unstake := domain.StakingActionUnstake
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMocks     func(*mocks.MockStakingUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "List_Staking_Filtered",
+			path: "/xtz/staking?staker=" + staker.String() + "&baker=" + baker.String() + "&action=unstake",
+			setupMocks: func(m *mocks.MockStakingUseCase) {
+				m.EXPECT().GetStaking(mock.Anything, domain.StakingFilter{
+					Staker: &staker,
+					Baker:  &baker,
+					Action: &unstake,
+				}, domain.ResponseOptions{Units: domain.UnitMutez}).Return(domain.ApiResponse[domain.StakingResponseType]{
+					Data:  []domain.StakingResponseType{},
+					Units: domain.UnitMutez,
+				}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name:           "List_Staking_Invalid_Action",
+			path:           "/xtz/staking?action=restake",
+			setupMocks:     func(m *mocks.MockStakingUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid action",
+		},
+		{
+			name: "List_Staking_Error",
+			path: "/xtz/staking",
+			setupMocks: func(m *mocks.MockStakingUseCase) {
+				m.EXPECT().GetStaking(mock.Anything, domain.StakingFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.StakingResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get staking operations",
+		},
+		{
+			name: "List_Stakers",
+			path: "/xtz/staking/stakers?baker=" + baker.String() + "&units=tez",
+			setupMocks: func(m *mocks.MockStakingUseCase) {
+				m.EXPECT().GetStakers(mock.Anything, domain.StakingFilter{Baker: &baker}, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.ApiResponse[domain.StakerResponseType]{Units: domain.UnitTez}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "List_Stakers_Invalid_Baker",
+			path:           "/xtz/staking/stakers?baker=tz1nope",
+			setupMocks:     func(m *mocks.MockStakingUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid baker",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockStakingUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			CreateStakingRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)(router.Group("/xtz"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
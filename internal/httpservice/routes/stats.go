@@ -0,0 +1,219 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxLimit caps the number of items a single request can list.
+const maxLimit = 100
+
+func RegisterStatsRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.StatsUseCase,
+) {
+	stats := router.Group("/stats")
+	stats.GET("/timeseries", func(c *gin.Context) {
+		filter, err := parseTimeseriesFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetTimeseries(c, filter, opts)
+		if errors.Is(err, domain.ErrRangeTooLarge) {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get timeseries", "error", err, "metric", filter.Metric, "interval", filter.Interval)
+			apierror.Abort(c, domain.Internal("failed to get timeseries", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+
+	stats.GET("/flows", func(c *gin.Context) {
+		filter, err := parsePeriodFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetFlows(c, filter, opts)
+		if err != nil {
+			logger.Warn("failed to get flows", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get flows", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": mapResponse(c, res), "units": opts.Units})
+	})
+
+	stats.GET("/whales", func(c *gin.Context) {
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		filter, err := parseWhaleFilter(c, opts.Units)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetWhales(c, filter, opts)
+		if err != nil {
+			logger.Warn("failed to get whales", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get whales", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": mapResponse(c, res), "units": opts.Units})
+	})
+
+	stats.GET("/whales/reports", func(c *gin.Context) {
+		limit, err := parseLimitQuery(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetWhaleReports(c, limit)
+		if err != nil {
+			logger.Warn("failed to get whale reports", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get whale reports", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+}
+
+// parseWhaleFilter reads the period, limit and minimum amount, expressed in unit,
+// of a whale report from the query string.
+func parseWhaleFilter(c *gin.Context, unit domain.Unit) (domain.WhaleFilter, error) {
+	var filter domain.WhaleFilter
+
+	period, err := parsePeriodFilter(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.PeriodFilter = period
+
+	filter.Limit, err = parseLimitQuery(c)
+	if err != nil {
+		return filter, err
+	}
+
+	if value, ok := c.GetQuery("min_amount"); ok {
+		minAmount, err := domain.ParseAmount(value, unit)
+		if err != nil {
+			return filter, fmt.Errorf("invalid min_amount: %w", err)
+		}
+		filter.MinAmount = &minAmount
+	}
+
+	return filter, nil
+}
+
+// parseLimitQuery reads the number of items to return, zero when unset.
+func parseLimitQuery(c *gin.Context) (int, error) {
+	value, ok := c.GetQuery("limit")
+	if !ok {
+		return 0, nil
+	}
+
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 || limit > maxLimit {
+		return 0, fmt.Errorf("invalid limit: expected an integer between 1 and %d", maxLimit)
+	}
+	return limit, nil
+}
+
+// parseTimeseriesFilter reads the interval, metric, baker and RFC 3339 range of a
+// time series from the query string.
+func parseTimeseriesFilter(c *gin.Context) (domain.TimeseriesFilter, error) {
+	var filter domain.TimeseriesFilter
+
+	interval, err := domain.ParseStatsInterval(c.Query("interval"))
+	if err != nil {
+		return filter, fmt.Errorf("invalid interval: %w", err)
+	}
+	filter.Interval = interval
+
+	metric, err := domain.ParseStatsMetric(c.Query("metric"))
+	if err != nil {
+		return filter, fmt.Errorf("invalid metric: %w", err)
+	}
+	filter.Metric = metric
+
+	baker, err := parseAddressQuery(c, "baker")
+	if err != nil {
+		return filter, err
+	}
+	filter.Baker = baker
+
+	period, err := parsePeriodFilter(c)
+	if err != nil {
+		return filter, err
+	}
+	filter.From = period.From
+	filter.To = period.To
+
+	return filter, nil
+}
+
+// parsePeriodFilter reads the RFC 3339 from and to bounds of a period.
+func parsePeriodFilter(c *gin.Context) (domain.PeriodFilter, error) {
+	var filter domain.PeriodFilter
+
+	from, err := parseTimeQuery(c, "from")
+	if err != nil {
+		return filter, err
+	}
+	filter.From = from
+
+	to, err := parseTimeQuery(c, "to")
+	if err != nil {
+		return filter, err
+	}
+	filter.To = to
+
+	if from != nil && to != nil && to.Before(*from) {
+		return filter, fmt.Errorf("invalid to: must not be before from")
+	}
+
+	return filter, nil
+}
+
+func CreateStatsRegistrar(
+	logger *slog.Logger,
+	statsUseCase domain.StatsUseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterStatsRoutes(router, logger, statsUseCase)
+	}
+}
@@ -0,0 +1,219 @@
+package routes
+
+import (
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestStatsEndpoints(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	minAmount, noThreshold := domain.Mutez(1500000), domain.Mutez(0)
+
+	tests := []struct {
+		name           string
+		path           string
+		setupMocks     func(*mocks.MockStatsUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "Timeseries_Defaults",
+			path: "/xtz/stats/timeseries",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetTimeseries(mock.Anything, domain.TimeseriesFilter{Interval: domain.IntervalDay, Metric: domain.MetricCount}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.TimeseriesPointResponseType]{Data: []domain.TimeseriesPointResponseType{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[]}`,
+		},
+		{
+			name: "Timeseries_Filtered",
+			path: "/xtz/stats/timeseries?interval=week&metric=undelegations&baker=" + baker.String() + "&from=2024-06-01T00:00:00Z",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetTimeseries(mock.Anything, domain.TimeseriesFilter{
+					Interval: domain.IntervalWeek,
+					Metric:   domain.MetricUndelegations,
+					Baker:    &baker,
+					From:     &from,
+				}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.TimeseriesPointResponseType]{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Timeseries_Invalid_Interval",
+			path:           "/xtz/stats/timeseries?interval=year",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid interval",
+		},
+		{
+			name:           "Timeseries_Invalid_Metric",
+			path:           "/xtz/stats/timeseries?metric=sum",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid metric",
+		},
+		{
+			name:           "Timeseries_Invalid_Baker",
+			path:           "/xtz/stats/timeseries?baker=tz1nope",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid baker",
+		},
+		{
+			name:           "Timeseries_To_Before_From",
+			path:           "/xtz/stats/timeseries?from=2024-06-02T00:00:00Z&to=2024-06-01T00:00:00Z",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid to",
+		},
+		{
+			name: "Timeseries_Range_Too_Large",
+			path: "/xtz/stats/timeseries?from=2020-01-01T00:00:00Z",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetTimeseries(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ApiResponse[domain.TimeseriesPointResponseType]{}, fmt.Errorf("%w: 1752 day buckets, at most 366", domain.ErrRangeTooLarge)).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "range too large",
+		},
+		{
+			name: "Timeseries_Error",
+			path: "/xtz/stats/timeseries",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetTimeseries(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ApiResponse[domain.TimeseriesPointResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get timeseries",
+		},
+		{
+			name: "Flows",
+			path: "/xtz/stats/flows?from=2024-06-01T00:00:00Z&units=tez",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetFlows(mock.Anything, domain.PeriodFilter{From: &from}, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.FlowsResponseType{From: from}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"units":"tez"`,
+		},
+		{
+			name:           "Flows_Invalid_From",
+			path:           "/xtz/stats/flows?from=last-week",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid from",
+		},
+		{
+			name: "Flows_Error",
+			path: "/xtz/stats/flows",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetFlows(mock.Anything, domain.PeriodFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.FlowsResponseType{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get flows",
+		},
+		{
+			name: "Whales",
+			path: "/xtz/stats/whales?limit=5&min_amount=1.5&units=tez",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetWhales(mock.Anything, domain.WhaleFilter{Limit: 5, MinAmount: &minAmount}, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.WhalesResponseType{MinAmount: domain.NewAmount(1500000, domain.UnitTez)}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"min_amount":"1.500000"`,
+		},
+		{
+			name: "Whales_No_Threshold",
+			path: "/xtz/stats/whales?min_amount=0",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetWhales(mock.Anything, domain.WhaleFilter{MinAmount: &noThreshold}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.WhalesResponseType{}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Whales_Invalid_Limit",
+			path:           "/xtz/stats/whales?limit=1000",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid limit",
+		},
+		{
+			name:           "Whales_Invalid_Min_Amount",
+			path:           "/xtz/stats/whales?min_amount=1.5",
+			setupMocks:     func(m *mocks.MockStatsUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid min_amount",
+		},
+		{
+			name: "Whales_Error",
+			path: "/xtz/stats/whales",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetWhales(mock.Anything, domain.WhaleFilter{}, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.WhalesResponseType{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get whales",
+		},
+		{
+			name: "Whale_Reports",
+			path: "/xtz/stats/whales/reports?limit=3",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetWhaleReports(mock.Anything, 3).
+					Return(domain.ApiResponse[domain.WhaleReportResponseType]{Data: []domain.WhaleReportResponseType{}, Units: domain.UnitMutez}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name: "Whale_Reports_Error",
+			path: "/xtz/stats/whales/reports",
+			setupMocks: func(m *mocks.MockStatsUseCase) {
+				m.EXPECT().GetWhaleReports(mock.Anything, 0).
+					Return(domain.ApiResponse[domain.WhaleReportResponseType]{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to get whale reports",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockStatsUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			CreateStatsRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)(router.Group("/xtz"))
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedBody != "" {
+				assert.Contains(t, w.Body.String(), tt.expectedBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package routes
+
+import (
+	"context"
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// sseHeartbeatInterval is the delay between two keep-alive comments, so that
+	// idle streams are not closed by proxies.
+	sseHeartbeatInterval = 15 * time.Second
+	// sseRetry is the reconnection delay advertised to the clients, in milliseconds.
+	sseRetry = 3000
+)
+
+func RegisterStreamRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.UseCase,
+	heartbeatInterval time.Duration,
+) {
+	router.GET("/delegations/stream", func(c *gin.Context) {
+		filter, err := parseDelegationFilter(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+		if !filter.Status.IsApplied() {
+			apierror.Abort(c, domain.NewError(domain.CodeInvalidArgument, "invalid status: only applied delegations are streamed"))
+			return
+		}
+
+		filter.AfterOperationID, err = parseLastEventID(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		// The stream outlives the server write timeout, clients reconnect when it ends.
+		_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		c.Header("X-Accel-Buffering", "no")
+		c.Header("X-Units", string(opts.Units))
+		c.Status(http.StatusOK)
+
+		stream := &eventStream{writer: c.Writer, c: c}
+		if err := stream.retry(sseRetry); err != nil {
+			return
+		}
+
+		ctx, cancel := context.WithCancel(c.Request.Context())
+		defer cancel()
+
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			ticker := time.NewTicker(heartbeatInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					if err := stream.comment("heartbeat"); err != nil {
+						cancel()
+						return
+					}
+				}
+			}
+		})
+
+		err = useCase.WatchDelegations(ctx, filter, opts, stream.delegation)
+		cancel()
+		wg.Wait()
+
+		switch {
+		case errors.Is(err, context.Canceled):
+		case errors.Is(err, domain.ErrSubscriptionClosed):
+			logger.Info("delegation stream subscriber fell behind, closing the stream")
+		case err != nil:
+			logger.Warn("failed to stream delegations", "error", err)
+			_ = stream.event("", "error", apierror.New(c, domain.Internal("failed to stream delegations", err)))
+		}
+	})
+}
+
+// parseLastEventID reads the operation id the stream resumes after, from the
+// Last-Event-ID header sent by reconnecting clients or the last_event_id query
+// parameter.
+func parseLastEventID(c *gin.Context) (*int64, error) {
+	value := c.GetHeader("Last-Event-ID")
+	if value == "" {
+		value = c.Query("last_event_id")
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return nil, fmt.Errorf("invalid Last-Event-ID: expected an operation id, got %q", value)
+	}
+	return &id, nil
+}
+
+// eventStream writes Server-Sent Events, it is safe for concurrent use.
+type eventStream struct {
+	mu     sync.Mutex
+	writer gin.ResponseWriter
+	c      *gin.Context
+}
+
+// delegation sends a delegation event identified by its operation id.
+func (s *eventStream) delegation(delegation domain.DelegationsResponseType) error {
+	id := ""
+	if delegation.DelegationMetadata != nil && delegation.OperationID != nil {
+		id = strconv.FormatInt(*delegation.OperationID, 10)
+	}
+	return s.event(id, "delegation", mapResponse(s.c, delegation))
+}
+
+func (s *eventStream) event(id, name string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	message := ""
+	if id != "" {
+		message += "id: " + id + "\n"
+	}
+	message += "event: " + name + "\ndata: " + string(payload) + "\n\n"
+	return s.write(message)
+}
+
+func (s *eventStream) comment(text string) error {
+	return s.write(": " + text + "\n\n")
+}
+
+func (s *eventStream) retry(milliseconds int) error {
+	return s.write("retry: " + strconv.Itoa(milliseconds) + "\n\n")
+}
+
+func (s *eventStream) write(message string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := io.WriteString(s.writer, message); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return nil
+}
+
+func CreateStreamRegistrar(
+	logger *slog.Logger,
+	delegatorUseCase domain.UseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterStreamRoutes(router, logger, delegatorUseCase, sseHeartbeatInterval)
+	}
+}
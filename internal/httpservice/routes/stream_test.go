@@ -0,0 +1,123 @@
+package routes
+
+import (
+	"context"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDelegationsStreamEndpoint(t *testing.T) {
+	t.Parallel()
+
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	delegator := domain.Address("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb")
+	operationID := int64(43)
+	lastEventID := int64(42)
+	lastEventIDQuery := int64(7)
+	delegation := domain.DelegationsResponseType{
+		Timestamp:          time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		Amount:             domain.NewAmount(1500000, domain.UnitMutez),
+		Delegator:          delegator,
+		Level:              1000,
+		DelegationMetadata: &domain.DelegationMetadata{OperationID: &operationID, Baker: &baker},
+	}
+
+	tests := []struct {
+		name           string
+		query          string
+		lastEventID    string
+		setupMocks     func(*mocks.MockUseCase)
+		expectedStatus int
+		expectedBody   []string
+	}{
+		{
+			name:        "Resume_From_Last_Event_ID",
+			query:       "?baker=" + baker.String(),
+			lastEventID: "42",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().WatchDelegations(mock.Anything, domain.DelegationFilter{Baker: &baker, AfterOperationID: &lastEventID}, domain.ResponseOptions{Units: domain.UnitMutez}, mock.Anything).
+					RunAndReturn(func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+						if err := fn(delegation); err != nil {
+							return err
+						}
+						return domain.ErrSubscriptionClosed
+					}).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: []string{
+				"retry: 3000\n\n",
+				"id: 43\nevent: delegation\ndata: {\"timestamp\":\"2024-06-01T12:00:00Z\",\"amount\":\"1500000\"",
+			},
+		},
+		{
+			name:  "Last_Event_ID_Query",
+			query: "?last_event_id=7",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().WatchDelegations(mock.Anything, domain.DelegationFilter{AfterOperationID: &lastEventIDQuery}, domain.ResponseOptions{Units: domain.UnitMutez}, mock.Anything).
+					Return(errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   []string{"event: error\ndata: {\"error\":{\"code\":\"internal\",\"message\":\"failed to stream delegations\"}}\n\n"},
+		},
+		{
+			name:           "Invalid_Last_Event_ID",
+			lastEventID:    "abc",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"invalid Last-Event-ID"},
+		},
+		{
+			name:           "Invalid_Status",
+			query:          "?status=failed",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"only applied delegations are streamed"},
+		},
+		{
+			name:           "Invalid_Baker",
+			query:          "?baker=not-an-address",
+			setupMocks:     func(m *mocks.MockUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   []string{"invalid baker"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			RegisterStreamRoutes(router.Group("/xtz"), slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase, time.Hour)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/xtz/delegations/stream"+tt.query, nil)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+			}
+			for _, expected := range tt.expectedBody {
+				assert.Contains(t, w.Body.String(), expected)
+			}
+		})
+	}
+}
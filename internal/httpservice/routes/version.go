@@ -0,0 +1,131 @@
+package routes
+
+import (
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// versionKey is the context key of the API version of a request.
+const versionKey = "api_version"
+
+// ResponseMapper converts a response of the use cases to the shape a version
+// of the API returns.
+type ResponseMapper func(any) any
+
+// Version is a version of the REST API, served under its prefix and aliases. Its
+// mappers keep the responses in the shape of the version when the domain types
+// change.
+type Version struct {
+	Name    string
+	Prefix  string
+	Aliases []Alias
+
+	mappers map[reflect.Type]ResponseMapper
+}
+
+// Alias is a deprecated prefix a version is also served under. Its responses
+// carry the Deprecation and Sunset headers of its dates, when set, and a Link
+// to the same route of the version.
+type Alias struct {
+	Prefix      string
+	Deprecation time.Time
+	Sunset      time.Time
+}
+
+type VersionOption func(*Version)
+
+// VersionWithAlias also serves the version under prefix, deprecated since
+// deprecation and removed at sunset.
+func VersionWithAlias(prefix string, deprecation, sunset time.Time) VersionOption {
+	return func(v *Version) {
+		v.Aliases = append(v.Aliases, Alias{Prefix: prefix, Deprecation: deprecation, Sunset: sunset})
+	}
+}
+
+// VersionWithMapper converts the responses of type T with mapper. Responses of
+// the types without a mapper are returned as is.
+func VersionWithMapper[T any](mapper func(T) any) VersionOption {
+	return func(v *Version) {
+		v.mappers[reflect.TypeFor[T]()] = func(res any) any {
+			return mapper(res.(T))
+		}
+	}
+}
+
+// NewVersion creates a version of the API served under /name.
+func NewVersion(name string, opts ...VersionOption) *Version {
+	v := &Version{
+		Name:    name,
+		Prefix:  "/" + name,
+		mappers: make(map[reflect.Type]ResponseMapper),
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// Middleware sets the version of the requests to the routes of v and the
+// deprecation headers of its aliases. It is installed on the engine, before the
+// response cache whose hits never reach the routes.
+func (v *Version) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if hasPrefix(route, v.Prefix) {
+			c.Set(versionKey, v)
+			c.Next()
+			return
+		}
+
+		for _, alias := range v.Aliases {
+			if !hasPrefix(route, alias.Prefix) {
+				continue
+			}
+			c.Set(versionKey, v)
+			if !alias.Deprecation.IsZero() {
+				c.Header("Deprecation", "@"+strconv.FormatInt(alias.Deprecation.Unix(), 10))
+			}
+			if !alias.Sunset.IsZero() {
+				c.Header("Sunset", alias.Sunset.UTC().Format(http.TimeFormat))
+			}
+			successor := v.Prefix + strings.TrimPrefix(c.Request.URL.Path, alias.Prefix)
+			if c.Request.URL.RawQuery != "" {
+				successor += "?" + c.Request.URL.RawQuery
+			}
+			c.Header("Link", "<"+successor+`>; rel="successor-version"`)
+			break
+		}
+		c.Next()
+	}
+}
+
+// prefixes returns the prefix of the version followed by the ones of its aliases.
+func (v *Version) prefixes() []string {
+	prefixes := []string{v.Prefix}
+	for _, alias := range v.Aliases {
+		prefixes = append(prefixes, alias.Prefix)
+	}
+	return prefixes
+}
+
+// mapResponse returns res in the shape of the version of the request.
+func mapResponse(c *gin.Context, res any) any {
+	value, ok := c.Get(versionKey)
+	if !ok {
+		return res
+	}
+	mapper, ok := value.(*Version).mappers[reflect.TypeOf(res)]
+	if !ok {
+		return res
+	}
+	return mapper(res)
+}
+
+func hasPrefix(route, prefix string) bool {
+	return route == prefix || strings.HasPrefix(route, prefix+"/")
+}
@@ -0,0 +1,255 @@
+package routes
+
+import (
+	"context"
+	"delegator/internal/httpservice/openapi"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVersion_Middleware(t *testing.T) {
+	t.Parallel()
+
+	deprecation := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		path            string
+		expectedHeaders map[string]string
+	}{
+		{
+			name: "Versioned",
+			path: "/v1/bakers/tz1abc",
+			expectedHeaders: map[string]string{
+				"Deprecation": "",
+				"Sunset":      "",
+				"Link":        "",
+			},
+		},
+		{
+			name: "Alias",
+			path: "/xtz/bakers/tz1abc?units=tez",
+			expectedHeaders: map[string]string{
+				"Deprecation": "@1767225600",
+				"Sunset":      "Thu, 31 Dec 2026 00:00:00 GMT",
+				"Link":        `</v1/bakers/tz1abc?units=tez>; rel="successor-version"`,
+			},
+		},
+		{
+			name: "Unversioned",
+			path: "/health",
+			expectedHeaders: map[string]string{
+				"Deprecation": "",
+				"Link":        "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			v1 := NewVersion("v1", VersionWithAlias("/xtz", deprecation, sunset))
+			engine := gin.New()
+			engine.Use(v1.Middleware())
+			CreateRouteRegistrar(
+				CreateHealthRegistrar(),
+				CreateVersionRegistrar(v1, func(router gin.IRouter) {
+					router.GET("/bakers/:address", func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				}),
+			)(engine)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			for header, expected := range tt.expectedHeaders {
+				assert.Equal(t, expected, w.Header().Get(header), header)
+			}
+		})
+	}
+}
+
+func TestVersion_Mappers(t *testing.T) {
+	t.Parallel()
+
+	type bakerV2 struct {
+		Address  domain.Address `json:"address"`
+		Capacity string         `json:"capacity"`
+	}
+
+	baker := domain.BakerResponseType{Address: "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb"}
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedBody string
+	}{
+		{
+			name:         "Current_Shape",
+			path:         "/v1/bakers/tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb",
+			expectedBody: `"address":"tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb","first_seen"`,
+		},
+		{
+			name:         "Mapped_Shape",
+			path:         "/v2/bakers/tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb",
+			expectedBody: `{"data":{"address":"tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb","capacity":"unknown"},"units":"mutez"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			mockUseCase := mocks.NewMockUseCase(t)
+			mockUseCase.EXPECT().GetBaker(mock.Anything, baker.Address, mock.Anything).Return(baker, nil).Once()
+
+			v1 := NewVersion("v1")
+			v2 := NewVersion("v2", VersionWithMapper(func(baker domain.BakerResponseType) any {
+				return bakerV2{Address: baker.Address, Capacity: "unknown"}
+			}))
+
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			engine := gin.New()
+			engine.Use(v1.Middleware(), v2.Middleware())
+			CreateRouteRegistrar(
+				CreateVersionRegistrar(v1, CreateDelegatorRegistrar(logger, mockUseCase)),
+				CreateVersionRegistrar(v2, CreateDelegatorRegistrar(logger, mockUseCase)),
+			)(engine)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestVersion_Mappers_Delegations(t *testing.T) {
+	t.Parallel()
+
+	type delegationV2 struct {
+		Delegator domain.Address `json:"delegator"`
+		Level     int64          `json:"level"`
+		Sender    domain.Address `json:"sender"`
+	}
+
+	delegation := domain.DelegationsResponseType{
+		Delegator: "tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL",
+		Level:     1000,
+		Amount:    domain.NewAmount(1500000, domain.UnitMutez),
+	}
+	toV2 := func(delegation domain.DelegationsResponseType) delegationV2 {
+		return delegationV2{Delegator: delegation.Delegator, Level: delegation.Level + 1, Sender: delegation.Delegator}
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		setupMocks   func(*mocks.MockUseCase)
+		expectedBody string
+	}{
+		{
+			name: "Fields",
+			path: "/v2/delegations?fields=delegator,level",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().GetDelegations(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.ApiResponse[domain.DelegationsResponseType]{
+						Data:  []domain.DelegationsResponseType{delegation},
+						Units: domain.UnitMutez,
+					}, nil).Once()
+			},
+			expectedBody: `{"data":[{"delegator":"tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL","level":1001}],"units":"mutez"}`,
+		},
+		{
+			name: "NDJSON",
+			path: "/v2/delegations?format=ndjson&fields=level",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ domain.DelegationFilter, _ domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+						return fn(delegation)
+					}).Once()
+			},
+			expectedBody: `{"level":1001}` + "\n",
+		},
+		{
+			name: "CSV",
+			path: "/v2/delegations?format=csv",
+			setupMocks: func(m *mocks.MockUseCase) {
+				m.EXPECT().ExportDelegations(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ domain.DelegationFilter, _ domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+						return fn(delegation)
+					}).Once()
+			},
+			expectedBody: "operation_id,operation_hash,timestamp,level,cycle,delegator,baker,previous_baker,amount,status,fiat_currency,fiat_value\n" +
+				",,0001-01-01T00:00:00Z,1001,,tz1a1SAaXRt9yoGMx29rh9FsBF4UzmvojdTL,,,0,applied,,\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			mockUseCase := mocks.NewMockUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			v2 := NewVersion("v2",
+				VersionWithMapper(func(res domain.ApiResponse[domain.DelegationsResponseType]) any {
+					data := make([]delegationV2, len(res.Data))
+					for i, delegation := range res.Data {
+						data[i] = toV2(delegation)
+					}
+					return gin.H{"data": data, "units": res.Units}
+				}),
+				VersionWithMapper(func(delegation domain.DelegationsResponseType) any {
+					return toV2(delegation)
+				}),
+			)
+
+			engine := gin.New()
+			engine.Use(v2.Middleware())
+			CreateVersionRegistrar(v2, CreateDelegatorRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase))(engine)
+
+			w := httptest.NewRecorder()
+			engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestCreateVersionRegistrar(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	version := NewVersion("v1", VersionWithAlias("/xtz", time.Time{}, time.Time{}))
+	CreateVersionRegistrar(version, CreateDelegatorRegistrar(slog.New(slog.NewJSONHandler(os.Stdout, nil)), nil))(engine)
+
+	var paths []string
+	for _, route := range engine.Routes() {
+		paths = append(paths, openapi.Path(route.Path))
+	}
+	assert.ElementsMatch(t, []string{
+		"/v1/delegations", "/v1/bakers", "/v1/bakers/{address}",
+		"/xtz/delegations", "/xtz/bakers", "/xtz/bakers/{address}",
+	}, paths)
+}
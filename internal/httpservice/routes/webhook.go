@@ -0,0 +1,237 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/apierror"
+	"delegator/internal/httpservice/auth"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// createWebhookRequest is the body of a webhook subscription, min_amount is
+// given in the units of the query string.
+type createWebhookRequest struct {
+	URL       string   `json:"url"`
+	Baker     *string  `json:"baker"`
+	Delegator *string  `json:"delegator"`
+	MinAmount *string  `json:"min_amount"`
+	Kinds     []string `json:"kinds"`
+}
+
+func RegisterWebhookRoutes(
+	router gin.IRouter,
+	logger *slog.Logger,
+	useCase domain.WebhookUseCase,
+) {
+	webhooks := router.Group("/webhooks", requireAPIKey)
+
+	webhooks.POST("", func(c *gin.Context) {
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		var req createWebhookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			apierror.Abort(c, invalidBody(err))
+			return
+		}
+
+		dto, err := parseCreateWebhook(req, opts.Units)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+		dto.APIKeyID = apiKeyID(c)
+
+		res, err := useCase.CreateWebhook(c, dto, opts)
+		if errors.Is(err, domain.ErrInvalidEndpoint) {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to create webhook", "error", err)
+			apierror.Abort(c, domain.Internal("failed to create webhook", err))
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin.H{"data": mapResponse(c, res), "units": opts.Units})
+	})
+
+	webhooks.GET("", func(c *gin.Context) {
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetWebhooks(c, apiKeyID(c), opts)
+		if err != nil {
+			logger.Warn("failed to get webhooks", "error", err)
+			apierror.Abort(c, domain.Internal("failed to get webhooks", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+
+	webhooks.GET("/:id", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		opts, err := parseResponseOptions(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetWebhook(c, apiKeyID(c), id, opts)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("webhook not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get webhook", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to get webhook", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": mapResponse(c, res), "units": opts.Units})
+	})
+
+	webhooks.DELETE("/:id", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		err = useCase.DeleteWebhook(c, apiKeyID(c), id)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("webhook not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to delete webhook", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to delete webhook", err))
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	})
+
+	webhooks.GET("/:id/deliveries", func(c *gin.Context) {
+		id, err := parseUUIDParam(c, "id")
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		limit, err := parseLimitQuery(c)
+		if err != nil {
+			apierror.Abort(c, domain.InvalidArgument(err))
+			return
+		}
+
+		res, err := useCase.GetDeliveries(c, apiKeyID(c), id, limit)
+		if errors.Is(err, domain.ErrNotFound) {
+			apierror.Abort(c, domain.NotFound("webhook not found").WithDetail("id", id))
+			return
+		}
+		if err != nil {
+			logger.Warn("failed to get webhook deliveries", "error", err, "id", id)
+			apierror.Abort(c, domain.Internal("failed to get webhook deliveries", err))
+			return
+		}
+
+		c.JSON(http.StatusOK, mapResponse(c, res))
+	})
+}
+
+// parseCreateWebhook validates a webhook subscription, the endpoint must be an
+// absolute http or https URL.
+func parseCreateWebhook(req createWebhookRequest, unit domain.Unit) (domain.CreateWebhookDTO, error) {
+	var dto domain.CreateWebhookDTO
+
+	endpoint, err := url.Parse(req.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return dto, fmt.Errorf("invalid url: expected an absolute http or https URL")
+	}
+	dto.URL = endpoint.String()
+
+	if req.Baker != nil {
+		baker, err := domain.ParseAddress(*req.Baker)
+		if err != nil {
+			return dto, fmt.Errorf("invalid baker: %w", err)
+		}
+		dto.Baker = &baker
+	}
+
+	if req.Delegator != nil {
+		delegator, err := domain.ParseAddress(*req.Delegator)
+		if err != nil {
+			return dto, fmt.Errorf("invalid delegator: %w", err)
+		}
+		dto.Delegator = &delegator
+	}
+
+	if req.MinAmount != nil {
+		dto.MinAmount, err = domain.ParseAmount(*req.MinAmount, unit)
+		if err != nil {
+			return dto, fmt.Errorf("invalid min_amount: %w", err)
+		}
+	}
+
+	for _, value := range req.Kinds {
+		kind, err := domain.ParseDelegationKind(value)
+		if err != nil {
+			return dto, fmt.Errorf("invalid kinds: %w", err)
+		}
+		dto.Kinds = append(dto.Kinds, kind)
+	}
+
+	return dto, nil
+}
+
+// requireAPIKey rejects the requests without an API key, even when keys are
+// optional, as the webhooks are only visible to the key that created them.
+func requireAPIKey(c *gin.Context) {
+	if _, ok := auth.FromContext(c); !ok {
+		apierror.Abort(c, domain.NewError(domain.CodeUnauthenticated, "missing api key"))
+		return
+	}
+	c.Next()
+}
+
+// apiKeyID returns the id of the API key of the request, set by requireAPIKey.
+func apiKeyID(c *gin.Context) uuid.UUID {
+	key, _ := auth.FromContext(c)
+	return key.ID
+}
+
+func parseUUIDParam(c *gin.Context, key string) (uuid.UUID, error) {
+	id, err := uuid.Parse(c.Param(key))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s: expected a UUID", key)
+	}
+	return id, nil
+}
+
+func CreateWebhookRegistrar(
+	logger *slog.Logger,
+	webhookUseCase domain.WebhookUseCase,
+) RouteRegistrar {
+	return func(router gin.IRouter) {
+		RegisterWebhookRoutes(router, logger, webhookUseCase)
+	}
+}
@@ -0,0 +1,290 @@
+package routes
+
+import (
+	"delegator/internal/httpservice/auth"
+	"delegator/mocks"
+	"delegator/pkg/domain"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookEndpoints(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("0b6f4c9e-7a3d-4b8e-9a51-2f7c1d3e5a60")
+	baker := domain.Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	key := domain.APIKey{ID: uuid.MustParse("5d1c0e2a-8f3b-4c6d-9e7f-1a2b3c4d5e6f"), Name: "test"}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		setupMocks     func(*mocks.MockWebhookUseCase)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Create",
+			method: http.MethodPost,
+			path:   "/xtz/webhooks?units=tez",
+			body:   `{"url":"https://example.com/hook","baker":"` + baker.String() + `","min_amount":"1.5","kinds":["new","undelegation"]}`,
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().CreateWebhook(mock.Anything, domain.CreateWebhookDTO{
+					APIKeyID:  key.ID,
+					URL:       "https://example.com/hook",
+					Baker:     &baker,
+					MinAmount: 1500000,
+					Kinds:     []domain.DelegationKind{domain.KindNew, domain.KindUndelegation},
+				}, domain.ResponseOptions{Units: domain.UnitTez}).
+					Return(domain.WebhookResponseType{ID: id, Secret: "s3cr3t"}, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `"secret":"s3cr3t"`,
+		},
+		{
+			name:           "Create_Invalid_URL",
+			method:         http.MethodPost,
+			path:           "/xtz/webhooks",
+			body:           `{"url":"ftp://example.com"}`,
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid url",
+		},
+		{
+			name:   "Create_Private_Endpoint",
+			method: http.MethodPost,
+			path:   "/xtz/webhooks",
+			body:   `{"url":"http://169.254.169.254/latest"}`,
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().CreateWebhook(mock.Anything, mock.Anything, mock.Anything).
+					Return(domain.WebhookResponseType{}, fmt.Errorf("%w: 169.254.169.254 is not a public address", domain.ErrInvalidEndpoint)).Once()
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "not a public address",
+		},
+		{
+			name:           "Create_Invalid_Kind",
+			method:         http.MethodPost,
+			path:           "/xtz/webhooks",
+			body:           `{"url":"https://example.com/hook","kinds":["staking"]}`,
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid kinds",
+		},
+		{
+			name:           "Create_Invalid_Body",
+			method:         http.MethodPost,
+			path:           "/xtz/webhooks",
+			body:           `{"url":`,
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid body",
+		},
+		{
+			name:   "Create_Error",
+			method: http.MethodPost,
+			path:   "/xtz/webhooks",
+			body:   `{"url":"https://example.com/hook"}`,
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().CreateWebhook(mock.Anything, mock.Anything, mock.Anything).Return(domain.WebhookResponseType{}, errors.New("db down")).Once()
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "failed to create webhook",
+		},
+		{
+			name:   "List",
+			method: http.MethodGet,
+			path:   "/xtz/webhooks",
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().GetWebhooks(mock.Anything, key.ID, domain.ResponseOptions{Units: domain.UnitMutez}).
+					Return(domain.ApiResponse[domain.WebhookResponseType]{Data: []domain.WebhookResponseType{}, Units: domain.UnitMutez}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[],"units":"mutez"}`,
+		},
+		{
+			name:   "Get_Not_Found",
+			method: http.MethodGet,
+			path:   "/xtz/webhooks/" + id.String(),
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().GetWebhook(mock.Anything, key.ID, id, domain.ResponseOptions{Units: domain.UnitMutez}).Return(domain.WebhookResponseType{}, domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "webhook not found",
+		},
+		{
+			name:           "Get_Invalid_ID",
+			method:         http.MethodGet,
+			path:           "/xtz/webhooks/42",
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "invalid id",
+		},
+		{
+			name:   "Delete",
+			method: http.MethodDelete,
+			path:   "/xtz/webhooks/" + id.String(),
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().DeleteWebhook(mock.Anything, key.ID, id).Return(nil).Once()
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:   "Deliveries",
+			method: http.MethodGet,
+			path:   "/xtz/webhooks/" + id.String() + "/deliveries?limit=5",
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().GetDeliveries(mock.Anything, key.ID, id, 5).
+					Return(domain.ApiResponse[domain.WebhookDeliveryResponseType]{Data: []domain.WebhookDeliveryResponseType{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"data":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			mockKeys := mocks.NewMockAPIKeyUseCase(t)
+			mockKeys.EXPECT().Authenticate(mock.Anything, "dlg_secret").Return(key, nil).Once()
+			mockKeys.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{}, nil).Once()
+			mockUseCase := mocks.NewMockWebhookUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			router := gin.New()
+			router.Use(auth.NewAuthenticator(auth.WithUseCase(mockKeys)).Middleware())
+			RegisterWebhookRoutes(router.Group("/xtz"), slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set(auth.Header, "dlg_secret")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestWebhookEndpoints_APIKey(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("0b6f4c9e-7a3d-4b8e-9a51-2f7c1d3e5a60")
+	key := domain.APIKey{ID: uuid.MustParse("5d1c0e2a-8f3b-4c6d-9e7f-1a2b3c4d5e6f"), Name: "test"}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		body           string
+		apiKey         string
+		setupMocks     func(*mocks.MockWebhookUseCase)
+		expectedStatus int
+	}{
+		{
+			name:   "Create_Owned_By_Key",
+			method: http.MethodPost,
+			path:   "/xtz/webhooks",
+			body:   `{"url":"https://example.com/hook"}`,
+			apiKey: "dlg_secret",
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().CreateWebhook(mock.Anything, domain.CreateWebhookDTO{APIKeyID: key.ID, URL: "https://example.com/hook"}, mock.Anything).
+					Return(domain.WebhookResponseType{ID: id}, nil).Once()
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:   "List_Scoped_To_Key",
+			method: http.MethodGet,
+			path:   "/xtz/webhooks",
+			apiKey: "dlg_secret",
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().GetWebhooks(mock.Anything, key.ID, mock.Anything).
+					Return(domain.ApiResponse[domain.WebhookResponseType]{Data: []domain.WebhookResponseType{}}, nil).Once()
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "Delete_Of_Another_Key",
+			method: http.MethodDelete,
+			path:   "/xtz/webhooks/" + id.String(),
+			apiKey: "dlg_secret",
+			setupMocks: func(m *mocks.MockWebhookUseCase) {
+				m.EXPECT().DeleteWebhook(mock.Anything, key.ID, id).Return(domain.ErrNotFound).Once()
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Create_Anonymous",
+			method:         http.MethodPost,
+			path:           "/xtz/webhooks",
+			body:           `{"url":"https://example.com/hook"}`,
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "List_Anonymous",
+			method:         http.MethodGet,
+			path:           "/xtz/webhooks",
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Deliveries_Anonymous",
+			method:         http.MethodGet,
+			path:           "/xtz/webhooks/" + id.String() + "/deliveries",
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Delete_Anonymous",
+			method:         http.MethodDelete,
+			path:           "/xtz/webhooks/" + id.String(),
+			setupMocks:     func(m *mocks.MockWebhookUseCase) {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			mockKeys := mocks.NewMockAPIKeyUseCase(t)
+			if tt.apiKey != "" {
+				mockKeys.EXPECT().Authenticate(mock.Anything, tt.apiKey).Return(key, nil).Once()
+				mockKeys.EXPECT().RecordUsage(mock.Anything, key).Return(domain.APIKeyQuota{}, nil).Once()
+			}
+			mockUseCase := mocks.NewMockWebhookUseCase(t)
+			tt.setupMocks(mockUseCase)
+
+			// Keys are optional, the default, yet the webhooks require one.
+			router := gin.New()
+			router.Use(auth.NewAuthenticator(auth.WithUseCase(mockKeys)).Middleware())
+			RegisterWebhookRoutes(router.Group("/xtz"), slog.New(slog.NewJSONHandler(os.Stdout, nil)), mockUseCase)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if tt.apiKey != "" {
+				req.Header.Set(auth.Header, tt.apiKey)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package httpservice
 import (
 	"context"
 	"delegator/conf"
+	"delegator/internal/httpservice/apierror"
+	"delegator/pkg/domain"
 	"errors"
 	"log/slog"
 	"net/http"
@@ -10,6 +12,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
 )
 
 type Server struct {
@@ -17,6 +20,8 @@ type Server struct {
 
 	engine *gin.Engine // should have a domain engine here
 	server *http.Server
+
+	limiter *rate.Limiter
 }
 
 type Options func(*Server)
@@ -53,6 +58,54 @@ func WithRoutes(routerRegister func(engine *gin.Engine)) Options {
 	}
 }
 
+// WithRateLimit installs a global request rate limit on the engine. It must be
+// applied before WithRoutes so that the registered routes go through it.
+func WithRateLimit(limit float64, burst int) Options {
+	return func(h *Server) {
+		if h.engine == nil {
+			panic(errors.New("ErrEngineErrorOrder"))
+		}
+		h.limiter = rate.NewLimiter(conf.RateLimit(limit), conf.RateBurst(burst))
+		h.engine.Use(h.rateLimit())
+	}
+}
+
+// WithMiddleware installs handlers on the engine. Like WithRateLimit, it must
+// be applied before WithRoutes.
+func WithMiddleware(handlers ...gin.HandlerFunc) Options {
+	return func(h *Server) {
+		if h.engine == nil {
+			panic(errors.New("ErrEngineErrorOrder"))
+		}
+		h.engine.Use(handlers...)
+	}
+}
+
+func (s *Server) rateLimit() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !s.limiter.Allow() {
+			apierror.Abort(c, domain.NewError(domain.CodeResourceExhausted, "rate limit exceeded"))
+			return
+		}
+		c.Next()
+	}
+}
+
+// SetRateLimit updates the API rate limit at runtime.
+func (s *Server) SetRateLimit(limit float64, burst int) {
+	if s.limiter == nil {
+		return
+	}
+	s.limiter.SetLimit(conf.RateLimit(limit))
+	s.limiter.SetBurst(conf.RateBurst(burst))
+	s.logger.Info("updated api rate limit", "limit", limit, "burst", burst)
+}
+
+// Reload applies the API rate limit from a freshly loaded configuration.
+func (s *Server) Reload(cfg *conf.DelegatorConfig) {
+	s.SetRateLimit(cfg.API.RateLimit, cfg.API.RateBurst)
+}
+
 func NewHTTPServer(opts ...Options) *Server {
 	h := &Server{}
 	for _, opt := range opts {
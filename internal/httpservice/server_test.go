@@ -4,12 +4,15 @@ import (
 	"context"
 	"delegator/conf"
 	"log/slog"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 )
 
 func TestNewHTTPServer(t *testing.T) {
@@ -46,9 +49,9 @@ func TestNewHTTPServer(t *testing.T) {
 				WithEngine(gin.New()),
 				WithHTTPServer(&conf.DelegatorConfig{
 					HTTP: struct {
-						Port         int `toml:"port"`
-						ReadTimeout  int `toml:"read_timeout"`
-						WriteTimeout int `toml:"write_timeout"`
+						Port         int `toml:"port" koanf:"port"`
+						ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+						WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 					}{
 						Port:         8080,
 						ReadTimeout:  30,
@@ -146,9 +149,9 @@ func TestWithHTTPServer(t *testing.T) {
 			name: "Set_HTTPServer_Option_Success",
 			args: args{config: &conf.DelegatorConfig{
 				HTTP: struct {
-					Port         int `toml:"port"`
-					ReadTimeout  int `toml:"read_timeout"`
-					WriteTimeout int `toml:"write_timeout"`
+					Port         int `toml:"port" koanf:"port"`
+					ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+					WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 				}{
 					Port:         8080,
 					ReadTimeout:  30,
@@ -162,9 +165,9 @@ func TestWithHTTPServer(t *testing.T) {
 			name: "Set_HTTPServer_Option_Panic_No_Engine",
 			args: args{config: &conf.DelegatorConfig{
 				HTTP: struct {
-					Port         int `toml:"port"`
-					ReadTimeout  int `toml:"read_timeout"`
-					WriteTimeout int `toml:"write_timeout"`
+					Port         int `toml:"port" koanf:"port"`
+					ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+					WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 				}{
 					Port:         8080,
 					ReadTimeout:  30,
@@ -247,9 +250,9 @@ func TestServer_Run(t *testing.T) {
 				engine := gin.New()
 				config := &conf.DelegatorConfig{
 					HTTP: struct {
-						Port         int `toml:"port"`
-						ReadTimeout  int `toml:"read_timeout"`
-						WriteTimeout int `toml:"write_timeout"`
+						Port         int `toml:"port" koanf:"port"`
+						ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+						WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 					}{
 						Port:         8080,
 						ReadTimeout:  30,
@@ -302,9 +305,9 @@ func TestServer_Shutdown(t *testing.T) {
 				engine := gin.New()
 				config := &conf.DelegatorConfig{
 					HTTP: struct {
-						Port         int `toml:"port"`
-						ReadTimeout  int `toml:"read_timeout"`
-						WriteTimeout int `toml:"write_timeout"`
+						Port         int `toml:"port" koanf:"port"`
+						ReadTimeout  int `toml:"read_timeout" koanf:"read_timeout"`
+						WriteTimeout int `toml:"write_timeout" koanf:"write_timeout"`
 					}{
 						Port:         8080,
 						ReadTimeout:  30,
@@ -339,4 +342,116 @@ func TestServer_Shutdown(t *testing.T) {
 			assert.NoError(t, err)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		limit          float64
+		burst          int
+		requests       int
+		expectedStatus int
+	}{
+		{
+			name:           "Within_Limit",
+			limit:          1,
+			burst:          2,
+			requests:       2,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Limit_Exceeded",
+			limit:          1,
+			burst:          1,
+			requests:       2,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		{
+			name:           "Unlimited",
+			limit:          0,
+			burst:          0,
+			requests:       5,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gin.SetMode(gin.TestMode)
+			engine := gin.New()
+			NewHTTPServer(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithEngine(engine),
+				WithRateLimit(tt.limit, tt.burst),
+				WithRoutes(func(engine *gin.Engine) {
+					engine.GET("/ping", func(c *gin.Context) {
+						c.Status(http.StatusOK)
+					})
+				}),
+			)
+
+			var status int
+			for i := 0; i < tt.requests; i++ {
+				w := httptest.NewRecorder()
+				req, _ := http.NewRequest("GET", "/ping", nil)
+				engine.ServeHTTP(w, req)
+				status = w.Code
+			}
+
+			assert.Equal(t, tt.expectedStatus, status)
+		})
+	}
+}
+
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	NewHTTPServer(
+		WithEngine(engine),
+		WithMiddleware(
+			func(c *gin.Context) {
+				c.Header("X-First", "1")
+				c.Next()
+			},
+			func(c *gin.Context) {
+				c.AbortWithStatus(http.StatusTeapot)
+			},
+		),
+		WithRoutes(func(engine *gin.Engine) {
+			engine.GET("/ping", func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+		}),
+	)
+
+	w := httptest.NewRecorder()
+	req, _ := http.NewRequest("GET", "/ping", nil)
+	engine.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusTeapot, w.Code)
+	assert.Equal(t, "1", w.Header().Get("X-First"))
+}
+
+func TestServer_Reload(t *testing.T) {
+	t.Parallel()
+
+	server := NewHTTPServer(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithEngine(gin.New()),
+		WithRateLimit(1, 1),
+	)
+
+	cfg := &conf.DelegatorConfig{}
+	cfg.API.RateLimit = 20
+	cfg.API.RateBurst = 40
+	server.Reload(cfg)
+
+	assert.Equal(t, rate.Limit(20), server.limiter.Limit())
+	assert.Equal(t, 40, server.limiter.Burst())
+}
@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKey is a key issued to a partner. Only the SHA-256 of the key is stored,
+// Prefix holds its first characters so that it can be recognized. Revoked keys
+// are soft deleted so that their usage is kept.
+type APIKey struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Name       string         `gorm:"size:100;not null" json:"name"`
+	Prefix     string         `gorm:"size:16;not null" json:"prefix"`
+	KeyHash    string         `gorm:"size:64;not null;uniqueIndex:idx_api_keys_key_hash" json:"-"`
+	RateLimit  float64        `gorm:"not null;default:0" json:"rate_limit"`
+	RateBurst  int            `gorm:"not null;default:0" json:"rate_burst"`
+	DailyQuota int64          `gorm:"not null;default:0" json:"daily_quota"`
+	CreatedAt  time.Time      `gorm:"default:now()" json:"created_at"`
+	RevokedAt  gorm.DeletedAt `gorm:"index:idx_api_keys_revoked_at" json:"-"`
+}
+
+// APIKeyUsage is the number of requests made with a key during a UTC day.
+type APIKeyUsage struct {
+	APIKeyID uuid.UUID `gorm:"type:uuid;primaryKey" json:"api_key_id"`
+	Day      time.Time `gorm:"type:date;primaryKey" json:"day"`
+	Requests int64     `gorm:"not null;default:0" json:"requests"`
+}
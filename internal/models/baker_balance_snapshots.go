@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BakerBalanceSnapshot is the balance delegated to a baker by its current
+// delegators at a given time.
+type BakerBalanceSnapshot struct {
+	ID               uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	Baker            string    `gorm:"size:50;not null;index:idx_baker_balance_snapshots_baker_taken_at,priority:1" json:"baker"`
+	DelegatedBalance int64     `gorm:"not null" json:"delegated_balance"`
+	Delegators       int64     `gorm:"not null" json:"delegators"`
+	TakenAt          time.Time `gorm:"not null;index:idx_baker_balance_snapshots_baker_taken_at,priority:2,sort:desc" json:"taken_at"`
+}
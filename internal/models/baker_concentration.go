@@ -0,0 +1,11 @@
+package models
+
+// BakerConcentration is how the current delegated amount of a baker is spread
+// over its delegators, it is not a table.
+type BakerConcentration struct {
+	Baker           string  `json:"baker"`
+	Delegators      int64   `json:"delegators"`
+	DelegatedAmount int64   `json:"delegated_amount"`
+	HerfindahlIndex float64 `json:"herfindahl_index"`
+	Top10Share      float64 `json:"top10_share"`
+}
@@ -0,0 +1,10 @@
+package models
+
+// BakerFlow is the number and amount of delegations moving from a baker to another,
+// it is not a table. FromBaker is nil for new delegators and ToBaker for undelegations.
+type BakerFlow struct {
+	FromBaker *string `json:"from_baker"`
+	ToBaker   *string `json:"to_baker"`
+	Count     int64   `json:"count"`
+	Amount    int64   `json:"amount"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// BakerStats is a baker aggregated with its current delegators and stakers, it is not a table.
+type BakerStats struct {
+	Address                  string     `json:"address"`
+	Alias                    *string    `json:"alias"`
+	FirstSeen                time.Time  `json:"first_seen"`
+	LastSeen                 time.Time  `json:"last_seen"`
+	Active                   bool       `json:"active"`
+	RegistrationLevel        *int64     `json:"registration_level"`
+	RegistrationTimestamp    *time.Time `json:"registration_timestamp"`
+	DeactivationLevel        *int64     `json:"deactivation_level"`
+	DeactivationTimestamp    *time.Time `json:"deactivation_timestamp"`
+	TotalDelegationsReceived int64      `json:"total_delegations_received"`
+	Delegators               int64      `json:"delegators"`
+	DelegatedAmount          int64      `json:"delegated_amount"`
+	Stakers                  int64      `json:"stakers"`
+	StakedAmount             int64      `json:"staked_amount"`
+}
@@ -8,4 +8,13 @@ type Baker struct {
 	LastSeen                 time.Time `gorm:"not null" json:"last_seen"`
 	TotalDelegationsReceived int64 `gorm:"default:0" json:"total_delegations_received"`
 	UniqueDelegators         int `gorm:"default:0" json:"unique_delegators"`
+	Alias                    *string `gorm:"size:100" json:"alias"`
+
+	// Active reports whether the baker is registered, i.e. its latest registration
+	// event is a self-delegation rather than a delegation away from itself.
+	Active                bool       `gorm:"default:false" json:"active"`
+	RegistrationLevel     *int64     `json:"registration_level"`
+	RegistrationTimestamp *time.Time `json:"registration_timestamp"`
+	DeactivationLevel     *int64     `json:"deactivation_level"`
+	DeactivationTimestamp *time.Time `json:"deactivation_timestamp"`
 }
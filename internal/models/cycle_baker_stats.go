@@ -0,0 +1,14 @@
+package models
+
+// CycleBakerStats is the delegation activity of a baker during a cycle and its
+// delegators at the end of the cycle, it is not a table.
+type CycleBakerStats struct {
+	Cycle               int64   `json:"cycle"`
+	Address             string  `json:"address"`
+	Alias               *string `json:"alias"`
+	DelegationsReceived int64   `json:"delegations_received"`
+	NewDelegators       int64   `json:"new_delegators"`
+	Departures          int64   `json:"departures"`
+	Delegators          int64   `json:"delegators"`
+	DelegatedAmount     int64   `json:"delegated_amount"`
+}
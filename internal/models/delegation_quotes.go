@@ -0,0 +1,16 @@
+package models
+
+import "github.com/google/uuid"
+
+// DelegationQuote is the price of one tez in several currencies at the time of a delegation.
+type DelegationQuote struct {
+	DelegationID uuid.UUID `gorm:"type:uuid;primaryKey" json:"delegation_id"`
+	BTC          float64   `gorm:"column:btc" json:"btc"`
+	EUR          float64   `gorm:"column:eur" json:"eur"`
+	USD          float64   `gorm:"column:usd" json:"usd"`
+	CNY          float64   `gorm:"column:cny" json:"cny"`
+	JPY          float64   `gorm:"column:jpy" json:"jpy"`
+	KRW          float64   `gorm:"column:krw" json:"krw"`
+	ETH          float64   `gorm:"column:eth" json:"eth"`
+	GBP          float64   `gorm:"column:gbp" json:"gbp"`
+}
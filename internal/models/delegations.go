@@ -13,11 +13,27 @@ type Delegation struct {
 	Amount            int64     `gorm:"not null;index:idx_delegations_amount,sort:desc" json:"amount"`
 	Timestamp         time.Time `gorm:"not null;index:idx_delegations_timestamp,sort:desc;index:idx_delegations_date,expression:DATE(timestamp)" json:"timestamp"`
 	Level             int64     `gorm:"not null;index:idx_delegations_level" json:"level"`
+	Cycle             *int64    `gorm:"index:idx_delegations_cycle" json:"cycle"`
 	OperationHash     *string   `gorm:"size:100;unique" json:"operation_hash"`
 	IsNewDelegation   bool      `gorm:"default:false" json:"is_new_delegation"`
+	IsSelfDelegation  bool      `gorm:"default:false" json:"is_self_delegation"`
 	PreviousBaker     *string   `gorm:"size:50" json:"previous_baker"`
 	CreatedAt         time.Time `gorm:"default:now()" json:"created_at"`
 	IndexedAt         time.Time `gorm:"default:now()" json:"indexed_at"`
-	
-	Baker Baker `gorm:"foreignKey:BakerID;references:Address" json:"baker,omitempty"`
+
+	OperationID         *int64  `gorm:"uniqueIndex:idx_delegations_operation_id" json:"operation_id"`
+	Block               *string `gorm:"size:60;index:idx_delegations_block" json:"block"`
+	Counter             *int64  `json:"counter"`
+	GasUsed             *int    `json:"gas_used"`
+	BakerFee            *int64  `json:"baker_fee"`
+	Initiator           *string `gorm:"size:50" json:"initiator"`
+	Nonce               *int    `json:"nonce"`
+	StakingUpdatesCount *int    `json:"staking_updates_count"`
+	DelegatorAlias      *string `gorm:"size:100" json:"delegator_alias"`
+	BakerAlias          *string `gorm:"size:100" json:"baker_alias"`
+	PreviousBakerAlias  *string `gorm:"size:100" json:"previous_baker_alias"`
+	InitiatorAlias      *string `gorm:"size:100" json:"initiator_alias"`
+
+	Baker Baker            `gorm:"foreignKey:BakerID;references:Address" json:"baker,omitempty"`
+	Quote *DelegationQuote `gorm:"foreignKey:DelegationID" json:"quote,omitempty"`
 }
@@ -0,0 +1,10 @@
+package models
+
+import "time"
+
+// DelegatorBalance is the latest known balance of a delegator.
+type DelegatorBalance struct {
+	Address   string    `gorm:"primaryKey;size:50" json:"address"`
+	Balance   int64     `gorm:"not null" json:"balance"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
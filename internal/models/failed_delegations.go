@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FailedDelegation is a delegation operation that did not take effect on
+// chain, i.e. whose status is failed, backtracked or skipped.
+type FailedDelegation struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OperationID   int64     `gorm:"not null;unique" json:"operation_id"`
+	OperationHash string    `gorm:"size:100;not null" json:"operation_hash"`
+	Status        string    `gorm:"size:20;not null;index:idx_failed_delegations_status" json:"status"`
+	Errors        []string  `gorm:"type:jsonb;serializer:json" json:"errors"`
+	Delegator     string    `gorm:"size:50;not null;index:idx_failed_delegations_delegator" json:"delegator"`
+	Baker         *string   `gorm:"size:50;index:idx_failed_delegations_baker" json:"baker"`
+	PreviousBaker *string   `gorm:"size:50" json:"previous_baker"`
+	Amount        int64     `gorm:"not null" json:"amount"`
+	Timestamp     time.Time `gorm:"not null" json:"timestamp"`
+	Level         int64     `gorm:"not null;index:idx_failed_delegations_level" json:"level"`
+	CreatedAt     time.Time `gorm:"default:now()" json:"created_at"`
+	IndexedAt     time.Time `gorm:"default:now()" json:"indexed_at"`
+}
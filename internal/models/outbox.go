@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// OutboxEvent is a delegation event written in the same transaction as the
+// delegation, PublishedAt is set once the relay published it.
+type OutboxEvent struct {
+	ID          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Event       string     `gorm:"size:50;not null" json:"event"`
+	Key         string     `gorm:"size:50;not null" json:"key"`
+	Payload     string     `gorm:"type:jsonb;not null" json:"payload"`
+	CreatedAt   time.Time  `gorm:"default:now()" json:"created_at"`
+	PublishedAt *time.Time `gorm:"index:idx_outbox_events_pending,where:published_at IS NULL" json:"published_at"`
+}
@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// Protocol is a Tezos protocol with the constants levels are mapped to cycles with.
+type Protocol struct {
+	Code            int       `gorm:"primaryKey;autoIncrement:false" json:"code"`
+	Hash            string    `gorm:"size:60;not null" json:"hash"`
+	FirstLevel      int64     `gorm:"not null" json:"first_level"`
+	LastLevel       *int64    `json:"last_level"`
+	FirstCycle      int64     `gorm:"not null" json:"first_cycle"`
+	FirstCycleLevel int64     `gorm:"not null" json:"first_cycle_level"`
+	BlocksPerCycle  int64     `gorm:"not null" json:"blocks_per_cycle"`
+	UpdatedAt       time.Time `gorm:"default:now()" json:"updated_at"`
+}
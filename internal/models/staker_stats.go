@@ -0,0 +1,12 @@
+package models
+
+import "time"
+
+// StakerStats is the net stake of a staker with a baker, it is not a table.
+type StakerStats struct {
+	Staker       string    `json:"staker"`
+	Baker        string    `json:"baker"`
+	StakedAmount int64     `json:"staked_amount"`
+	FirstStaked  time.Time `json:"first_staked"`
+	LastUpdated  time.Time `json:"last_updated"`
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StakingOperation is an applied stake, unstake or finalize operation.
+type StakingOperation struct {
+	ID                  uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	OperationID         int64     `gorm:"not null;unique" json:"operation_id"`
+	OperationHash       string    `gorm:"size:100;not null" json:"operation_hash"`
+	Block               string    `gorm:"size:60;not null" json:"block"`
+	Level               int64     `gorm:"not null;index:idx_staking_operations_level" json:"level"`
+	Timestamp           time.Time `gorm:"not null" json:"timestamp"`
+	Action              string    `gorm:"size:20;not null" json:"action"`
+	Staker              string    `gorm:"size:50;not null;index:idx_staking_operations_staker" json:"staker"`
+	StakerAlias         *string   `gorm:"size:100" json:"staker_alias"`
+	Baker               string    `gorm:"size:50;not null;index:idx_staking_operations_baker" json:"baker"`
+	BakerAlias          *string   `gorm:"size:100" json:"baker_alias"`
+	RequestedAmount     int64     `gorm:"not null" json:"requested_amount"`
+	Amount              int64     `gorm:"not null" json:"amount"`
+	BakerFee            int64     `gorm:"not null" json:"baker_fee"`
+	GasUsed             int       `json:"gas_used"`
+	StakingUpdatesCount *int      `json:"staking_updates_count"`
+	CreatedAt           time.Time `gorm:"default:now()" json:"created_at"`
+	IndexedAt           time.Time `gorm:"default:now()" json:"indexed_at"`
+}
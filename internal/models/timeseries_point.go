@@ -0,0 +1,9 @@
+package models
+
+import "time"
+
+// TimeseriesPoint is the value of a metric in the bucket starting at Bucket, it is not a table.
+type TimeseriesPoint struct {
+	Bucket time.Time `json:"bucket"`
+	Value  int64     `json:"value"`
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Webhook is a subscription to the delegation events. Baker, Delegator, MinAmount
+// and Kinds filter the events, unset filters match every delegation. APIKeyID is
+// the key that created the webhook, the column is only null for the anonymous
+// webhooks created before they required a key, which are deleted.
+type Webhook struct {
+	ID        uuid.UUID      `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	APIKeyID  uuid.UUID      `gorm:"type:uuid;index:idx_webhooks_api_key_id" json:"-"`
+	URL       string         `gorm:"not null" json:"url"`
+	Secret    string         `gorm:"size:64;not null" json:"-"`
+	Baker     *string        `gorm:"size:50" json:"baker"`
+	Delegator *string        `gorm:"size:50" json:"delegator"`
+	MinAmount int64          `gorm:"not null;default:0" json:"min_amount"`
+	Kinds     []string       `gorm:"type:jsonb;serializer:json;not null" json:"kinds"`
+	CreatedAt time.Time      `gorm:"default:now()" json:"created_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index:idx_webhooks_deleted_at" json:"-"`
+}
+
+// WebhookDelivery is the delivery log of an event to a webhook, Payload holds
+// the JSON body that is posted.
+type WebhookDelivery struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key" json:"id"`
+	WebhookID      uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_webhook_deliveries_operation" json:"webhook_id"`
+	OperationID    int64      `gorm:"not null;uniqueIndex:idx_webhook_deliveries_operation" json:"operation_id"`
+	Event          string     `gorm:"size:50;not null" json:"event"`
+	Payload        string     `gorm:"type:jsonb;not null" json:"payload"`
+	Status         string     `gorm:"size:20;not null;index:idx_webhook_deliveries_due,priority:1" json:"status"`
+	Attempts       int        `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt  *time.Time `gorm:"index:idx_webhook_deliveries_due,priority:2" json:"next_attempt_at"`
+	LastStatusCode *int       `json:"last_status_code"`
+	LastError      *string    `json:"last_error"`
+	CreatedAt      time.Time  `gorm:"default:now()" json:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at"`
+
+	Webhook Webhook `gorm:"foreignKey:WebhookID" json:"-"`
+}
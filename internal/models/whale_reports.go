@@ -0,0 +1,16 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WhaleReport is a scheduled whale report, Report holds its JSON document.
+type WhaleReport struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()" json:"id"`
+	PeriodFrom  time.Time `gorm:"not null" json:"period_from"`
+	PeriodTo    time.Time `gorm:"not null" json:"period_to"`
+	GeneratedAt time.Time `gorm:"not null;index:idx_whale_reports_generated_at,sort:desc" json:"generated_at"`
+	Report      string    `gorm:"type:jsonb;not null" json:"report"`
+}
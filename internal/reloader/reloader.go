@@ -0,0 +1,216 @@
+package reloader
+
+import (
+	"context"
+	"delegator/conf"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/charmbracelet/log"
+	"github.com/fsnotify/fsnotify"
+)
+
+// Target is a component whose runtime settings can be updated from a freshly
+// loaded configuration.
+type Target interface {
+	Reload(cfg *conf.DelegatorConfig)
+}
+
+// TargetFunc adapts a plain function to the Target interface.
+type TargetFunc func(cfg *conf.DelegatorConfig)
+
+// Reload calls f(cfg).
+func (f TargetFunc) Reload(cfg *conf.DelegatorConfig) {
+	f(cfg)
+}
+
+// Loader loads a configuration, usually conf.LoadConfig or conf.LoadConfigFromFile.
+type Loader func() (*conf.DelegatorConfig, error)
+
+// Reloader watches the configuration file and listens for SIGHUP, then pushes
+// the settings that can change at runtime to its targets.
+type Reloader struct {
+	logger *slog.Logger
+
+	mu      sync.Mutex
+	current *conf.DelegatorConfig
+	path    string
+	loader  Loader
+	targets []Target
+
+	watcher *fsnotify.Watcher
+}
+
+type Options func(*Reloader)
+
+func WithLogger(logger *slog.Logger) Options {
+	return func(r *Reloader) {
+		r.logger = logger
+	}
+}
+
+// WithConfig sets the configuration the service was started with.
+func WithConfig(cfg *conf.DelegatorConfig) Options {
+	return func(r *Reloader) {
+		r.current = cfg
+	}
+}
+
+// WithConfigPath sets the file to watch and reload on SIGHUP. When empty, the
+// configuration is the embedded one and hot reload is disabled.
+func WithConfigPath(path string) Options {
+	return func(r *Reloader) {
+		r.path = path
+	}
+}
+
+func WithLoader(loader Loader) Options {
+	return func(r *Reloader) {
+		r.loader = loader
+	}
+}
+
+func WithTargets(targets ...Target) Options {
+	return func(r *Reloader) {
+		r.targets = append(r.targets, targets...)
+	}
+}
+
+// LogLevel returns a Target updating the level of a logger built by glog.
+func LogLevel(logger *slog.Logger) Target {
+	return TargetFunc(func(cfg *conf.DelegatorConfig) {
+		setter, ok := logger.Handler().(interface{ SetLevel(level log.Level) })
+		if !ok || cfg.Logging.Level == "" {
+			return
+		}
+
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(cfg.Logging.Level)); err != nil {
+			logger.Warn("invalid log level", "level", cfg.Logging.Level, "error", err)
+			return
+		}
+
+		setter.SetLevel(log.Level(level))
+	})
+}
+
+func (r *Reloader) Run(ctx context.Context) error {
+	r.logger.Info("starting config reloader", "path", r.path)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	var events chan fsnotify.Event
+	var errs chan error
+	if r.path == "" {
+		r.logger.Warn("CONFIG_PATH is not set, hot reload is disabled")
+	} else {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return err
+		}
+
+		// Watch the directory rather than the file, editors and config maps
+		// replace the file instead of writing to it.
+		if err := watcher.Add(filepath.Dir(r.path)); err != nil {
+			_ = watcher.Close()
+			return err
+		}
+
+		r.mu.Lock()
+		r.watcher = watcher
+		r.mu.Unlock()
+
+		events = watcher.Events
+		errs = watcher.Errors
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-signals:
+			if r.path == "" {
+				r.logger.Warn("received SIGHUP, ignoring it as hot reload needs CONFIG_PATH")
+				continue
+			}
+			r.logger.Info("received SIGHUP, reloading config")
+			r.Reload()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(r.path) || !event.Has(fsnotify.Write|fsnotify.Create) {
+				continue
+			}
+			r.logger.Info("config file changed, reloading config", "path", r.path)
+			r.Reload()
+		case err, ok := <-errs:
+			if !ok {
+				return nil
+			}
+			r.logger.Warn("config watcher error", "error", err)
+		}
+	}
+}
+
+// Reload loads the configuration and applies it to every target. Settings that
+// require a restart are reported and left untouched.
+func (r *Reloader) Reload() {
+	next, err := r.loader()
+	if err != nil {
+		r.logger.Warn("failed to reload config, keeping current one", "error", err)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	merged, ignored := r.current.Merge(next)
+	for _, setting := range ignored {
+		r.logger.Warn("config change requires a restart, ignoring", "setting", setting)
+	}
+
+	for _, target := range r.targets {
+		target.Reload(merged)
+	}
+
+	r.current = merged
+	r.logger.Info("config reloaded")
+}
+
+// Current returns the configuration currently applied.
+func (r *Reloader) Current() *conf.DelegatorConfig {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.current
+}
+
+func (r *Reloader) Shutdown(ctx context.Context) error {
+	r.logger.Info("shutting down config reloader")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.watcher != nil {
+		return r.watcher.Close()
+	}
+	return nil
+}
+
+func NewReloader(opts ...Options) *Reloader {
+	r := &Reloader{
+		loader: conf.LoadConfig,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
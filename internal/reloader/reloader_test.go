@@ -0,0 +1,235 @@
+package reloader
+
+import (
+	"context"
+	"delegator/conf"
+	"errors"
+	"log/slog"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReloader(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	cfg := &conf.DelegatorConfig{}
+	target := TargetFunc(func(cfg *conf.DelegatorConfig) {})
+
+	reloader := NewReloader(
+		WithLogger(logger),
+		WithConfig(cfg),
+		WithConfigPath("/tmp/config.toml"),
+		WithTargets(target),
+	)
+
+	assert.NotNil(t, reloader)
+	assert.Equal(t, logger, reloader.logger)
+	assert.Equal(t, cfg, reloader.current)
+	assert.Equal(t, "/tmp/config.toml", reloader.path)
+	assert.Len(t, reloader.targets, 1)
+	assert.NotNil(t, reloader.loader)
+}
+
+func TestReloader_Reload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		loader           Loader
+		expectedApplied  bool
+		expectedPort     int
+		expectedInterval int
+	}{
+		{
+			name: "Reloadable_Settings_Applied",
+			loader: func() (*conf.DelegatorConfig, error) {
+				next := &conf.DelegatorConfig{}
+				next.HTTP.Port = 8888
+				next.Indexer.PollInterval = 5
+				return next, nil
+			},
+			expectedApplied:  true,
+			expectedPort:     8888,
+			expectedInterval: 5,
+		},
+		{
+			name: "Structural_Settings_Ignored",
+			loader: func() (*conf.DelegatorConfig, error) {
+				next := &conf.DelegatorConfig{}
+				next.HTTP.Port = 9999
+				next.Indexer.PollInterval = 10
+				return next, nil
+			},
+			expectedApplied:  true,
+			expectedPort:     8888,
+			expectedInterval: 10,
+		},
+		{
+			name: "Loader_Error_Keeps_Current",
+			loader: func() (*conf.DelegatorConfig, error) {
+				return nil, errors.New("invalid toml")
+			},
+			expectedApplied:  false,
+			expectedPort:     8888,
+			expectedInterval: 30,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			current := &conf.DelegatorConfig{}
+			current.HTTP.Port = 8888
+			current.Indexer.PollInterval = 30
+
+			var applied *conf.DelegatorConfig
+			reloader := NewReloader(
+				WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WithConfig(current),
+				WithLoader(tt.loader),
+				WithTargets(TargetFunc(func(cfg *conf.DelegatorConfig) {
+					applied = cfg
+				})),
+			)
+
+			reloader.Reload()
+
+			assert.Equal(t, tt.expectedApplied, applied != nil)
+			assert.Equal(t, tt.expectedPort, reloader.Current().HTTP.Port)
+			assert.Equal(t, tt.expectedInterval, reloader.Current().Indexer.PollInterval)
+		})
+	}
+}
+
+func TestReloader_Run_FileChange(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	assert.NoError(t, os.WriteFile(path, []byte("[indexer]\n"), 0o644))
+
+	reloaded := make(chan *conf.DelegatorConfig, 1)
+	reloader := NewReloader(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithConfig(&conf.DelegatorConfig{}),
+		WithConfigPath(path),
+		WithLoader(func() (*conf.DelegatorConfig, error) {
+			return &conf.DelegatorConfig{}, nil
+		}),
+		WithTargets(TargetFunc(func(cfg *conf.DelegatorConfig) {
+			select {
+			case reloaded <- cfg:
+			default:
+			}
+		})),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- reloader.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool {
+		reloader.mu.Lock()
+		defer reloader.mu.Unlock()
+		return reloader.watcher != nil
+	}, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, os.WriteFile(path, []byte("[indexer]\npoll_interval = 5\n"), 0o644))
+
+	select {
+	case cfg := <-reloaded:
+		assert.NotNil(t, cfg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("config was not reloaded after file change")
+	}
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.NoError(t, reloader.Shutdown(context.Background()))
+}
+
+func TestReloader_Run_WithoutConfigPath(t *testing.T) {
+	t.Parallel()
+
+	var loads atomic.Int32
+	reloader := NewReloader(
+		WithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		WithConfig(&conf.DelegatorConfig{}),
+		WithLoader(func() (*conf.DelegatorConfig, error) {
+			loads.Add(1)
+			return &conf.DelegatorConfig{}, nil
+		}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- reloader.Run(ctx)
+	}()
+
+	// Keep the test process alive whether or not Run listens for SIGHUP yet.
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP)
+	defer signal.Stop(signals)
+
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, syscall.Kill(os.Getpid(), syscall.SIGHUP))
+	<-signals
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Zero(t, loads.Load())
+	assert.Nil(t, reloader.watcher)
+	assert.NoError(t, reloader.Shutdown(context.Background()))
+}
+
+func TestLogLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		level string
+	}{
+		{
+			name:  "Valid_Level",
+			level: "debug",
+		},
+		{
+			name:  "Invalid_Level",
+			level: "verbose",
+		},
+		{
+			name:  "Empty_Level",
+			level: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &conf.DelegatorConfig{}
+			cfg.Logging.Level = tt.level
+
+			logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+			assert.NotPanics(t, func() {
+				LogLevel(logger).Reload(cfg)
+			})
+		})
+	}
+}
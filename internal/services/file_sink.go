@@ -0,0 +1,63 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+)
+
+// FileSink appends the outbox messages to a file, one JSON document per line.
+// It is meant for local development and tests rather than production.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// fileRecord is a line of the file sink.
+type fileRecord struct {
+	ID        int64           `json:"id"`
+	Event     string          `json:"event"`
+	Key       string          `json:"key"`
+	CreatedAt time.Time       `json:"created_at"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// Publish appends the messages and syncs the file.
+func (s *FileSink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	encoder := json.NewEncoder(s.file)
+	for _, message := range messages {
+		err := encoder.Encode(fileRecord{
+			ID:        message.ID,
+			Event:     message.Event,
+			Key:       message.Key,
+			CreatedAt: message.CreatedAt,
+			Payload:   message.Payload,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return s.file.Sync()
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+// NewFileSink opens path for appending, creating it when missing.
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
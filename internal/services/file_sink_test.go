@@ -0,0 +1,39 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSink_Publish(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "outbox.ndjson")
+	createdAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	sink, err := NewFileSink(path)
+	assert.NoError(t, err)
+
+	err = sink.Publish(context.Background(), []domain.OutboxMessage{
+		{ID: 1, Event: "delegation.new", Key: "tz1a", Payload: []byte(`{"event":"delegation.new"}`), CreatedAt: createdAt},
+	})
+	assert.NoError(t, err)
+	err = sink.Publish(context.Background(), []domain.OutboxMessage{
+		{ID: 2, Event: "delegation.undelegation", Key: "tz1b", Payload: []byte(`{"event":"delegation.undelegation"}`), CreatedAt: createdAt},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, sink.Close())
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t,
+		`{"id":1,"event":"delegation.new","key":"tz1a","created_at":"2024-06-01T12:00:00Z","payload":{"event":"delegation.new"}}`+"\n"+
+			`{"id":2,"event":"delegation.undelegation","key":"tz1b","created_at":"2024-06-01T12:00:00Z","payload":{"event":"delegation.undelegation"}}`+"\n",
+		string(content))
+}
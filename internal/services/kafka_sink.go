@@ -0,0 +1,103 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	// DefaultKafkaBroker is the broker used when none is configured.
+	DefaultKafkaBroker = "localhost:9092"
+	// DefaultKafkaTopic is the topic the events are published on when none is configured.
+	DefaultKafkaTopic = "delegator.delegations"
+)
+
+// kafkaBatchTimeout bounds the time a write waits for more messages before
+// sending a partial batch.
+const kafkaBatchTimeout = 10 * time.Millisecond
+
+// KafkaSink publishes the outbox messages on a Kafka topic, keyed by delegator
+// so that the events of a delegator land in the same partition, in order.
+type KafkaSink struct {
+	logger  *slog.Logger
+	brokers []string
+	topic   string
+	writer  *kafka.Writer
+}
+
+type KafkaSinkOptions func(*KafkaSink)
+
+func KafkaSinkWithLogger(logger *slog.Logger) KafkaSinkOptions {
+	return func(s *KafkaSink) {
+		s.logger = logger
+	}
+}
+
+// KafkaSinkWithBrokers sets the bootstrap brokers, none keeps DefaultKafkaBroker.
+func KafkaSinkWithBrokers(brokers ...string) KafkaSinkOptions {
+	return func(s *KafkaSink) {
+		if len(brokers) > 0 {
+			s.brokers = brokers
+		}
+	}
+}
+
+// KafkaSinkWithTopic sets the topic, empty keeps DefaultKafkaTopic.
+func KafkaSinkWithTopic(topic string) KafkaSinkOptions {
+	return func(s *KafkaSink) {
+		if topic != "" {
+			s.topic = topic
+		}
+	}
+}
+
+// Publish writes the messages and waits for every in-sync replica to acknowledge them.
+func (s *KafkaSink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	records := make([]kafka.Message, len(messages))
+	for i, message := range messages {
+		records[i] = kafka.Message{
+			Key:   []byte(message.Key),
+			Value: message.Payload,
+			Time:  message.CreatedAt,
+			Headers: []kafka.Header{
+				{Key: "id", Value: []byte(strconv.FormatInt(message.ID, 10))},
+				{Key: "event", Value: []byte(message.Event)},
+			},
+		}
+	}
+
+	if err := s.writer.WriteMessages(ctx, records...); err != nil {
+		s.logger.Warn("error publishing to kafka", "error", err, "count", len(records))
+		return err
+	}
+	return nil
+}
+
+func (s *KafkaSink) Close() error {
+	return s.writer.Close()
+}
+
+// NewKafkaSink creates a writer for the brokers, connections are opened on the first publish.
+func NewKafkaSink(opts ...KafkaSinkOptions) *KafkaSink {
+	s := &KafkaSink{
+		brokers: []string{DefaultKafkaBroker},
+		topic:   DefaultKafkaTopic,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.writer = &kafka.Writer{
+		Addr:         kafka.TCP(s.brokers...),
+		Topic:        s.topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchTimeout: kafkaBatchTimeout,
+	}
+	return s
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"slices"
+	"sync"
+)
+
+// MemorySink keeps the outbox messages in memory, for tests.
+type MemorySink struct {
+	mu       sync.Mutex
+	messages []domain.OutboxMessage
+}
+
+func (s *MemorySink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.messages = append(s.messages, messages...)
+	return nil
+}
+
+func (s *MemorySink) Close() error {
+	return nil
+}
+
+// Messages returns the messages published so far, in order.
+func (s *MemorySink) Messages() []domain.OutboxMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.messages)
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// DefaultNATSSubject prefixes the subjects the events are published on, e.g.
+// delegator.delegation.new.
+const DefaultNATSSubject = "delegator"
+
+// NATSFlushTimeout bounds the wait for the server to process the published
+// messages when the context has no deadline.
+const NATSFlushTimeout = 10 * time.Second
+
+// NATSSink publishes the outbox messages on NATS. Every message carries its id
+// in the Nats-Msg-Id header, so that a JetStream stream bound to the subjects
+// drops the ones published twice.
+type NATSSink struct {
+	logger  *slog.Logger
+	url     string
+	subject string
+	conn    *nats.Conn
+}
+
+type NATSSinkOptions func(*NATSSink)
+
+func NATSSinkWithLogger(logger *slog.Logger) NATSSinkOptions {
+	return func(s *NATSSink) {
+		s.logger = logger
+	}
+}
+
+// NATSSinkWithURL sets the server URL, empty keeps the default one.
+func NATSSinkWithURL(url string) NATSSinkOptions {
+	return func(s *NATSSink) {
+		if url != "" {
+			s.url = url
+		}
+	}
+}
+
+// NATSSinkWithSubject sets the subject prefix, empty keeps DefaultNATSSubject.
+func NATSSinkWithSubject(subject string) NATSSinkOptions {
+	return func(s *NATSSink) {
+		if subject != "" {
+			s.subject = subject
+		}
+	}
+}
+
+// Publish sends the messages and waits for the server to have processed them,
+// for at most NATSFlushTimeout unless ctx has a deadline.
+func (s *NATSSink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	for _, message := range messages {
+		msg := nats.NewMsg(s.subject + "." + message.Event)
+		msg.Data = message.Payload
+		msg.Header.Set(nats.MsgIdHdr, strconv.FormatInt(message.ID, 10))
+		msg.Header.Set("Delegator-Key", message.Key)
+		if err := s.conn.PublishMsg(msg); err != nil {
+			s.logger.Warn("error publishing to nats", "error", err, "id", message.ID)
+			return err
+		}
+	}
+
+	// FlushWithContext refuses contexts without a deadline.
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, NATSFlushTimeout)
+		defer cancel()
+	}
+	return s.conn.FlushWithContext(ctx)
+}
+
+// Close sends the buffered messages and closes the connection.
+func (s *NATSSink) Close() error {
+	return s.conn.Drain()
+}
+
+// NewNATSSink connects to the NATS server.
+func NewNATSSink(opts ...NATSSinkOptions) (*NATSSink, error) {
+	s := &NATSSink{
+		url:     nats.DefaultURL,
+		subject: DefaultNATSSubject,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	conn, err := nats.Connect(s.url, nats.Name("delegator"))
+	if err != nil {
+		return nil, err
+	}
+	s.conn = conn
+	return s, nil
+}
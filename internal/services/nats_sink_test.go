@@ -0,0 +1,81 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"delegator/pkg/domain"
+	"io"
+	"log/slog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serveNATS answers the NATS handshake and the pings of the first client, and
+// returns the subjects of the messages it published.
+func serveNATS(t *testing.T) (string, <-chan string) {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = listener.Close() })
+
+	subjects := make(chan string, 10)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		_, _ = conn.Write([]byte(`INFO {"server_id":"test","version":"2.10.0","proto":1,"headers":true,"max_payload":1048576}` + "\r\n"))
+		reader := bufio.NewReader(conn)
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch fields := strings.Fields(line); {
+			case len(fields) == 0:
+			case fields[0] == "PING":
+				_, _ = conn.Write([]byte("PONG\r\n"))
+			case fields[0] == "HPUB" && len(fields) == 4:
+				// HPUB <subject> <header size> <total size>, followed by the
+				// headers and the payload.
+				size, _ := strconv.Atoi(fields[3])
+				if _, err := io.ReadFull(reader, make([]byte, size+2)); err != nil {
+					return
+				}
+				subjects <- fields[1]
+			}
+		}
+	}()
+
+	return "nats://" + listener.Addr().String(), subjects
+}
+
+func TestNATSSink_Publish_WithoutDeadline(t *testing.T) {
+	t.Parallel()
+
+	url, subjects := serveNATS(t)
+	sink, err := NewNATSSink(
+		NATSSinkWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		NATSSinkWithURL(url),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sink.Close()
+
+	err = sink.Publish(context.Background(), []domain.OutboxMessage{
+		{ID: 1, Event: "delegation.new", Key: "tz1a", Payload: []byte(`{"event":"delegation.new"}`)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "delegator.delegation.new", <-subjects)
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"log/slog"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultRedisURL is the Redis server used when none is configured.
+	DefaultRedisURL = "redis://localhost:6379/0"
+	// DefaultRedisStream is the stream the events are appended to when none is configured.
+	DefaultRedisStream = "delegator:delegations"
+)
+
+// RedisSink appends the outbox messages to a Redis stream, with the id, event,
+// key and payload fields.
+type RedisSink struct {
+	logger *slog.Logger
+	url    string
+	stream string
+	client *redis.Client
+}
+
+type RedisSinkOptions func(*RedisSink)
+
+func RedisSinkWithLogger(logger *slog.Logger) RedisSinkOptions {
+	return func(s *RedisSink) {
+		s.logger = logger
+	}
+}
+
+// RedisSinkWithURL sets the server URL, empty keeps the default one.
+func RedisSinkWithURL(url string) RedisSinkOptions {
+	return func(s *RedisSink) {
+		if url != "" {
+			s.url = url
+		}
+	}
+}
+
+// RedisSinkWithStream sets the stream, empty keeps DefaultRedisStream.
+func RedisSinkWithStream(stream string) RedisSinkOptions {
+	return func(s *RedisSink) {
+		if stream != "" {
+			s.stream = stream
+		}
+	}
+}
+
+// Publish appends the messages in a single round trip.
+func (s *RedisSink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	_, err := s.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, message := range messages {
+			pipe.XAdd(ctx, &redis.XAddArgs{
+				Stream: s.stream,
+				Values: map[string]any{
+					"id":      strconv.FormatInt(message.ID, 10),
+					"event":   message.Event,
+					"key":     message.Key,
+					"payload": message.Payload,
+				},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Warn("error publishing to redis", "error", err, "count", len(messages))
+		return err
+	}
+	return nil
+}
+
+func (s *RedisSink) Close() error {
+	return s.client.Close()
+}
+
+// NewRedisSink creates a client for a redis:// URL, connections are opened on the first publish.
+func NewRedisSink(opts ...RedisSinkOptions) (*RedisSink, error) {
+	s := &RedisSink{
+		url:    DefaultRedisURL,
+		stream: DefaultRedisStream,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	options, err := redis.ParseURL(s.url)
+	if err != nil {
+		return nil, err
+	}
+	s.client = redis.NewClient(options)
+	return s, nil
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultRedisKeyPrefix prefixes the keys of the cached responses when none is configured.
+	DefaultRedisKeyPrefix = "delegator:responses:"
+	// DefaultRedisStoreTTL is how long a response is kept when no TTL is configured.
+	DefaultRedisStoreTTL = time.Hour
+)
+
+// RedisStore keeps the rendered responses in Redis, shared by every instance.
+// The keys of the responses that can change hold the indexed level, so the
+// responses of older heads are never read again and only expire with the TTL.
+type RedisStore struct {
+	url    string
+	prefix string
+	ttl    time.Duration
+	client *redis.Client
+}
+
+type RedisStoreOptions func(*RedisStore)
+
+// RedisStoreWithURL sets the server URL, empty keeps the default one.
+func RedisStoreWithURL(url string) RedisStoreOptions {
+	return func(s *RedisStore) {
+		if url != "" {
+			s.url = url
+		}
+	}
+}
+
+// RedisStoreWithTTL sets how long a response is kept, non-positive TTLs keep
+// DefaultRedisStoreTTL.
+func RedisStoreWithTTL(ttl time.Duration) RedisStoreOptions {
+	return func(s *RedisStore) {
+		if ttl > 0 {
+			s.ttl = ttl
+		}
+	}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) (domain.CachedResponse, bool, error) {
+	data, err := s.client.Get(ctx, s.prefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return domain.CachedResponse{}, false, nil
+	}
+	if err != nil {
+		return domain.CachedResponse{}, false, err
+	}
+
+	var res domain.CachedResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return domain.CachedResponse{}, false, err
+	}
+	return res, true, nil
+}
+
+// Set stores a response for the TTL, or until it expires when that is sooner.
+func (s *RedisStore) Set(ctx context.Context, key string, res domain.CachedResponse) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	ttl := s.ttl
+	if !res.Expires.IsZero() {
+		ttl = min(ttl, time.Until(res.Expires))
+	}
+	if ttl <= 0 {
+		return nil
+	}
+	return s.client.Set(ctx, s.prefix+key, data, ttl).Err()
+}
+
+// Invalidate does nothing, the responses of older heads are keyed by their level.
+func (s *RedisStore) Invalidate(ctx context.Context, level int64) error {
+	return nil
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+// NewRedisStore creates a client for a redis:// URL, connections are opened on the first request.
+func NewRedisStore(opts ...RedisStoreOptions) (*RedisStore, error) {
+	s := &RedisStore{
+		url:    DefaultRedisURL,
+		prefix: DefaultRedisKeyPrefix,
+		ttl:    DefaultRedisStoreTTL,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	options, err := redis.ParseURL(s.url)
+	if err != nil {
+		return nil, err
+	}
+	s.client = redis.NewClient(options)
+	return s, nil
+}
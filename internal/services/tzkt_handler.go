@@ -1,18 +1,30 @@
 package services
 
 import (
+	"context"
+	"delegator/conf"
 	"delegator/pkg/domain"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
 	"net/http"
+	"strings"
+
+	"golang.org/x/time/rate"
 )
 
+// quoteCurrencies is the list of currencies TzKT is asked to quote each operation in.
+const quoteCurrencies = "btc,eur,usd,cny,jpy,krw,eth,gbp"
+
+// maxProtocols bounds the protocols fetched at once, far above the number of Tezos protocols.
+const maxProtocols = 1000
+
 type HTTPHandler struct {
 	logger  *slog.Logger
 	client  *http.Client
 	baseURL string
+	limiter *rate.Limiter
 }
 
 type HandlerOptions func(*HTTPHandler)
@@ -35,25 +47,112 @@ func HandlerWithBaseURL(baseURL string) HandlerOptions {
 	}
 }
 
-func (h *HTTPHandler) GetDelegations() ([]domain.TzktApiDelegationsResponse, error) {
-	return h.GetDelegationsFromLevel(0, 100)
+// HandlerWithRateLimit caps the number of requests per second sent to TzKT.
+// A limit of zero or less disables the cap.
+func HandlerWithRateLimit(limit float64, burst int) HandlerOptions {
+	return func(h *HTTPHandler) {
+		h.limiter = rate.NewLimiter(conf.RateLimit(limit), conf.RateBurst(burst))
+	}
+}
+
+// SetRateLimit updates the TzKT request rate limit at runtime.
+func (h *HTTPHandler) SetRateLimit(limit float64, burst int) {
+	if h.limiter == nil {
+		return
+	}
+	h.limiter.SetLimit(conf.RateLimit(limit))
+	h.limiter.SetBurst(conf.RateBurst(burst))
+	h.logger.Info("updated tzkt rate limit", "limit", limit, "burst", burst)
+}
+
+// Reload applies the TzKT rate limit from a freshly loaded configuration.
+func (h *HTTPHandler) Reload(cfg *conf.DelegatorConfig) {
+	h.SetRateLimit(cfg.Tzkt.RateLimit, cfg.Tzkt.RateBurst)
+}
+
+func (h *HTTPHandler) GetDelegations(ctx context.Context) ([]domain.TzktApiDelegationsResponse, error) {
+	return h.GetDelegationsFromLevel(ctx, 0, 100)
+}
+
+func (h *HTTPHandler) GetDelegationsFromLevel(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error) {
+	var response []domain.TzktApiDelegationsResponse
+	if err := h.getOperationsFromLevel(ctx, "delegations", lastLevel, limit, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetStakingFromLevel fetches the stake, unstake and finalize operations after lastLevel.
+func (h *HTTPHandler) GetStakingFromLevel(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiStakingResponse, error) {
+	var response []domain.TzktApiStakingResponse
+	if err := h.getOperationsFromLevel(ctx, "staking", lastLevel, limit, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
-func (h *HTTPHandler) GetDelegationsFromLevel(lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error) {
+// getOperationsFromLevel fetches operations of kind after lastLevel into response. When
+// lastLevel is 0, the most recent operations are fetched instead.
+func (h *HTTPHandler) getOperationsFromLevel(ctx context.Context, kind string, lastLevel int64, limit int, response any) error {
 	var url string
 	if lastLevel > 0 {
-		url = fmt.Sprintf("%soperations/delegations?level.gt=%d&limit=%d&sort.asc=level", h.baseURL, lastLevel, limit)
+		url = fmt.Sprintf("%soperations/%s?level.gt=%d&limit=%d&sort.asc=level&quote=%s", h.baseURL, kind, lastLevel, limit, quoteCurrencies)
 	} else {
-		url = fmt.Sprintf("%soperations/delegations?limit=%d&sort.desc=level", h.baseURL, limit)
+		url = fmt.Sprintf("%soperations/%s?limit=%d&sort.desc=level&quote=%s", h.baseURL, kind, limit, quoteCurrencies)
 	}
 
-	h.logger.Info("fetching delegations", "url", url, "lastLevel", lastLevel, "limit", limit)
+	h.logger.Info("fetching operations", "kind", kind, "url", url, "lastLevel", lastLevel, "limit", limit)
+	return h.get(ctx, kind, url, response)
+}
 
-	res, err := h.client.Get(url)
-	if err != nil {
-		h.logger.Warn("error getting delegations", "error", err)
+// GetAccounts fetches the balance of every address in a single request.
+func (h *HTTPHandler) GetAccounts(ctx context.Context, addresses []string) ([]domain.TzktApiAccountResponse, error) {
+	if len(addresses) == 0 {
+		return nil, nil
+	}
+
+	url := fmt.Sprintf("%saccounts?address.in=%s&select=address,balance&limit=%d", h.baseURL, strings.Join(addresses, ","), len(addresses))
+	h.logger.Info("fetching accounts", "count", len(addresses))
+
+	var response []domain.TzktApiAccountResponse
+	if err := h.get(ctx, "accounts", url, &response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetProtocols fetches every protocol with the constants cycles are computed from.
+func (h *HTTPHandler) GetProtocols(ctx context.Context) ([]domain.TzktApiProtocolResponse, error) {
+	url := fmt.Sprintf("%sprotocols?limit=%d", h.baseURL, maxProtocols)
+	h.logger.Info("fetching protocols")
+
+	var response []domain.TzktApiProtocolResponse
+	if err := h.get(ctx, "protocols", url, &response); err != nil {
 		return nil, err
 	}
+	return response, nil
+}
+
+// get decodes the JSON body of a TzKT GET request on url into response, kind names
+// the fetched resource in logs and errors. Cancelling ctx stops both the rate
+// limiter wait and the request.
+func (h *HTTPHandler) get(ctx context.Context, kind string, url string, response any) error {
+	if h.limiter != nil {
+		if err := h.limiter.Wait(ctx); err != nil {
+			h.logger.Warn("rate limiter wait failed", "error", err)
+			return err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	res, err := h.client.Do(req)
+	if err != nil {
+		h.logger.Warn("error getting from tzkt", "kind", kind, "error", err)
+		return err
+	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
 		if err != nil {
@@ -64,23 +163,22 @@ func (h *HTTPHandler) GetDelegationsFromLevel(lastLevel int64, limit int) ([]dom
 
 	if res.StatusCode != http.StatusOK {
 		h.logger.Warn("bad status code", "status", res.StatusCode)
-		return nil, fmt.Errorf("API returned status %d", res.StatusCode)
+		return fmt.Errorf("API returned status %d", res.StatusCode)
 	}
 
 	data, err := io.ReadAll(res.Body)
 	if err != nil {
-		h.logger.Warn("error reading delegations body", "error", err)
-		return nil, err
+		h.logger.Warn("error reading tzkt body", "kind", kind, "error", err)
+		return err
 	}
 
-	var response []domain.TzktApiDelegationsResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		h.logger.Warn("error unmarshaling delegations", "error", err)
-		return nil, fmt.Errorf("failed to unmarshal delegations: %w", err)
+	if err := json.Unmarshal(data, response); err != nil {
+		h.logger.Warn("error unmarshaling tzkt body", "kind", kind, "error", err)
+		return fmt.Errorf("failed to unmarshal %s: %w", kind, err)
 	}
 
-	h.logger.Info("fetched delegations", "count", len(response))
-	return response, nil
+	h.logger.Info("fetched from tzkt", "kind", kind)
+	return nil
 }
 
 func NewHTTPHandler(opts ...HandlerOptions) *HTTPHandler {
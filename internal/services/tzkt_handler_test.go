@@ -1,6 +1,8 @@
 package services
 
 import (
+	"context"
+	"delegator/conf"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
 )
 
 func TestNewHTTPHandler(t *testing.T) {
@@ -224,7 +227,7 @@ func TestHTTPHandler_GetDelegations(t *testing.T) {
 				HandlerWithBaseURL(server.URL+"/"),
 			)
 
-			result, err := handler.GetDelegations()
+			result, err := handler.GetDelegations(context.Background())
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -273,7 +276,7 @@ func TestHTTPHandler_GetDelegationsFromLevel(t *testing.T) {
 			expectedError:  false,
 			expectedCount:  1,
 			checkURL: func(url string) bool {
-				return strings.Contains(url, "level.gt=1000") && strings.Contains(url, "limit=50") && strings.Contains(url, "sort.asc=level")
+				return strings.Contains(url, "level.gt=1000") && strings.Contains(url, "limit=50") && strings.Contains(url, "sort.asc=level") && strings.Contains(url, "quote=btc,eur,usd")
 			},
 		},
 		{
@@ -339,7 +342,7 @@ func TestHTTPHandler_GetDelegationsFromLevel(t *testing.T) {
 				HandlerWithBaseURL(server.URL+"/"),
 			)
 
-			result, err := handler.GetDelegationsFromLevel(tt.args.lastLevel, tt.args.limit)
+			result, err := handler.GetDelegationsFromLevel(context.Background(), tt.args.lastLevel, tt.args.limit)
 
 			if tt.expectedError {
 				assert.Error(t, err)
@@ -355,6 +358,213 @@ func TestHTTPHandler_GetDelegationsFromLevel(t *testing.T) {
 	}
 }
 
+func TestHTTPHandler_GetStakingFromLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		expectedError  bool
+		expectedCount  int
+	}{
+		{
+			name: "Get_Staking_From_Level_Success",
+			mockResponse: `[
+				{
+					"type": "staking",
+					"id": 42,
+					"status": "applied",
+					"timestamp": "2024-06-01T12:00:00Z",
+					"level": 5001,
+					"hash": "ophash123",
+					"action": "stake",
+					"requestedAmount": 1000000,
+					"amount": 1000000,
+					"sender": {"address": "tz1staker"},
+					"baker": {"address": "tz1baker", "alias": "Baker"}
+				}
+			]`,
+			mockStatusCode: http.StatusOK,
+			expectedCount:  1,
+		},
+		{
+			name:           "Get_Staking_From_Level_Server_Error",
+			mockStatusCode: http.StatusInternalServerError,
+			expectedError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requestURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestURL = r.URL.String()
+				w.WriteHeader(tt.mockStatusCode)
+				if tt.mockResponse != "" {
+					w.Write([]byte(tt.mockResponse))
+				}
+			}))
+			defer server.Close()
+
+			handler := NewHTTPHandler(
+				HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				HandlerWithClient(server.Client()),
+				HandlerWithBaseURL(server.URL+"/"),
+			)
+
+			result, err := handler.GetStakingFromLevel(context.Background(), 5000, 100)
+
+			assert.True(t, strings.HasPrefix(requestURL, "/operations/staking?level.gt=5000&limit=100"), "URL check failed for: %s", requestURL)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, tt.expectedCount)
+			assert.Equal(t, "stake", result[0].Action)
+			assert.Equal(t, "Baker", result[0].Baker.Alias)
+		})
+	}
+}
+
+func TestHTTPHandler_GetAccounts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		addresses      []string
+		mockResponse   string
+		mockStatusCode int
+		expectedURL    string
+		expectedError  bool
+		expectedCount  int
+	}{
+		{
+			name:           "Get_Accounts_Success",
+			addresses:      []string{"tz1first", "tz1second"},
+			mockResponse:   `[{"address": "tz1first", "balance": 100}, {"address": "tz1second", "balance": 200}]`,
+			mockStatusCode: http.StatusOK,
+			expectedURL:    "/accounts?address.in=tz1first,tz1second&select=address,balance&limit=2",
+			expectedCount:  2,
+		},
+		{
+			name:           "Get_Accounts_Server_Error",
+			addresses:      []string{"tz1first"},
+			mockStatusCode: http.StatusInternalServerError,
+			expectedURL:    "/accounts?address.in=tz1first&select=address,balance&limit=1",
+			expectedError:  true,
+		},
+		{
+			name:      "Get_Accounts_Empty",
+			addresses: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requestURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestURL = r.URL.String()
+				w.WriteHeader(tt.mockStatusCode)
+				if tt.mockResponse != "" {
+					w.Write([]byte(tt.mockResponse))
+				}
+			}))
+			defer server.Close()
+
+			handler := NewHTTPHandler(
+				HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				HandlerWithClient(server.Client()),
+				HandlerWithBaseURL(server.URL+"/"),
+			)
+
+			result, err := handler.GetAccounts(context.Background(), tt.addresses)
+
+			assert.Equal(t, tt.expectedURL, requestURL)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, tt.expectedCount)
+			if tt.expectedCount > 0 {
+				assert.Equal(t, int64(200), result[1].Balance)
+			}
+		})
+	}
+}
+
+func TestHTTPHandler_GetProtocols(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		mockResponse   string
+		mockStatusCode int
+		expectedError  bool
+	}{
+		{
+			name: "Get_Protocols_Success",
+			mockResponse: `[
+				{"code": 1, "hash": "PtFirst", "firstLevel": 1, "firstCycle": 0, "firstCycleLevel": 1, "lastLevel": 1000, "constants": {"blocksPerCycle": 100}},
+				{"code": 2, "hash": "PtSecond", "firstLevel": 1001, "firstCycle": 10, "firstCycleLevel": 1001, "constants": {"blocksPerCycle": 50}}
+			]`,
+			mockStatusCode: http.StatusOK,
+		},
+		{
+			name:           "Get_Protocols_Server_Error",
+			mockStatusCode: http.StatusInternalServerError,
+			expectedError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requestURL string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestURL = r.URL.String()
+				w.WriteHeader(tt.mockStatusCode)
+				if tt.mockResponse != "" {
+					w.Write([]byte(tt.mockResponse))
+				}
+			}))
+			defer server.Close()
+
+			handler := NewHTTPHandler(
+				HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				HandlerWithClient(server.Client()),
+				HandlerWithBaseURL(server.URL+"/"),
+			)
+
+			result, err := handler.GetProtocols(context.Background())
+
+			assert.Equal(t, "/protocols?limit=1000", requestURL)
+			if tt.expectedError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, 2)
+			assert.Equal(t, int64(1000), *result[0].LastLevel)
+			assert.Nil(t, result[1].LastLevel)
+			assert.Equal(t, int64(50), result[1].Constants.BlocksPerCycle)
+		})
+	}
+}
+
 func TestHTTPHandler_GetDelegationsFromLevel_HTTPClientError(t *testing.T) {
 	t.Parallel()
 
@@ -377,7 +587,7 @@ func TestHTTPHandler_GetDelegationsFromLevel_HTTPClientError(t *testing.T) {
 				HandlerWithBaseURL("http://invalid-url-that-does-not-exist.local/"),
 			)
 
-			result, err := handler.GetDelegationsFromLevel(0, 100)
+			result, err := handler.GetDelegationsFromLevel(context.Background(), 0, 100)
 
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -414,7 +624,7 @@ func TestHTTPHandler_GetDelegationsFromLevel_BodyReadError(t *testing.T) {
 				HandlerWithBaseURL(server.URL+"/"),
 			)
 
-			result, err := handler.GetDelegationsFromLevel(0, 100)
+			result, err := handler.GetDelegationsFromLevel(context.Background(), 0, 100)
 
 			assert.Error(t, err)
 			assert.Nil(t, result)
@@ -458,4 +668,94 @@ func TestHTTPHandler_BaseURL_Configuration(t *testing.T) {
 			assert.Equal(t, tt.args.baseURL, handler.baseURL)
 		})
 	}
-}
\ No newline at end of file
+}
+
+func TestHTTPHandler_SetRateLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		opts          []HandlerOptions
+		limit         float64
+		burst         int
+		expectedLimit rate.Limit
+		expectedBurst int
+	}{
+		{
+			name: "Update_Configured_Limiter",
+			opts: []HandlerOptions{
+				HandlerWithRateLimit(10, 10),
+			},
+			limit:         2,
+			burst:         3,
+			expectedLimit: 2,
+			expectedBurst: 3,
+		},
+		{
+			name: "Disable_Limit",
+			opts: []HandlerOptions{
+				HandlerWithRateLimit(10, 10),
+			},
+			limit:         0,
+			burst:         0,
+			expectedLimit: rate.Inf,
+			expectedBurst: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := append([]HandlerOptions{
+				HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+			}, tt.opts...)
+			handler := NewHTTPHandler(opts...)
+
+			handler.SetRateLimit(tt.limit, tt.burst)
+
+			assert.Equal(t, tt.expectedLimit, handler.limiter.Limit())
+			assert.Equal(t, tt.expectedBurst, handler.limiter.Burst())
+		})
+	}
+}
+
+func TestHTTPHandler_Reload_WithoutLimiter(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHTTPHandler(
+		HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+	)
+
+	cfg := &conf.DelegatorConfig{}
+	cfg.Tzkt.RateLimit = 5
+
+	assert.NotPanics(t, func() {
+		handler.Reload(cfg)
+	})
+	assert.Nil(t, handler.limiter)
+}
+
+func TestHTTPHandler_Get_CancelledWait(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	handler := NewHTTPHandler(
+		HandlerWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+		HandlerWithClient(server.Client()),
+		HandlerWithBaseURL(server.URL+"/"),
+		HandlerWithRateLimit(0.001, 1),
+	)
+
+	_, err := handler.GetProtocols(context.Background())
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = handler.GetProtocols(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
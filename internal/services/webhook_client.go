@@ -0,0 +1,122 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"delegator/pkg/domain"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// DefaultWebhookTimeout bounds a delivery when no timeout is configured.
+const DefaultWebhookTimeout = 10 * time.Second
+
+// maxWebhookResponse bounds the response body drained from a webhook endpoint.
+const maxWebhookResponse = 64 << 10
+
+// WebhookClient posts the webhook events to their endpoints.
+type WebhookClient struct {
+	logger       *slog.Logger
+	client       *http.Client
+	allowPrivate bool
+}
+
+type WebhookClientOptions func(*WebhookClient)
+
+func WebhookClientWithLogger(logger *slog.Logger) WebhookClientOptions {
+	return func(w *WebhookClient) {
+		w.logger = logger
+	}
+}
+
+// WebhookClientWithTimeout bounds the duration of every delivery, zero keeps
+// DefaultWebhookTimeout.
+func WebhookClientWithTimeout(timeout time.Duration) WebhookClientOptions {
+	return func(w *WebhookClient) {
+		if timeout > 0 {
+			w.client.Timeout = timeout
+		}
+	}
+}
+
+// WebhookClientWithPrivateEndpoints allows connecting to loopback and private
+// addresses, which are refused by default.
+func WebhookClientWithPrivateEndpoints(allowed bool) WebhookClientOptions {
+	return func(w *WebhookClient) {
+		w.allowPrivate = allowed
+	}
+}
+
+// Send posts body with the headers to url and returns the response status code.
+// Redirects are not followed.
+func (w *WebhookClient) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		w.logger.Warn("error posting webhook", "url", url, "error", err)
+		return 0, err
+	}
+	defer func(Body io.ReadCloser) {
+		// Draining the body lets the connection be reused.
+		_, _ = io.Copy(io.Discard, io.LimitReader(Body, maxWebhookResponse))
+		if err := Body.Close(); err != nil {
+			w.logger.Warn("error closing body", "error", err)
+		}
+	}(res.Body)
+
+	return res.StatusCode, nil
+}
+
+func NewWebhookClient(opts ...WebhookClientOptions) *WebhookClient {
+	w := &WebhookClient{
+		client: &http.Client{
+			Timeout: DefaultWebhookTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !w.allowPrivate {
+		dialer.Control = dialPublicOnly
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the endpoint and defeat the address check.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	w.client.Transport = transport
+
+	return w
+}
+
+// dialPublicOnly refuses connections to non-public addresses. It runs once the
+// host is resolved, so an endpoint accepted at creation cannot be rebound to an
+// internal address afterwards.
+func dialPublicOnly(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || !domain.IsPublicAddr(addr) {
+		return fmt.Errorf("%w: %s is not a public address", domain.ErrInvalidEndpoint, host)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"delegator/pkg/domain"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookClient_Send(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		handler      http.HandlerFunc
+		timeout      time.Duration
+		expectedCode int
+		wantErr      bool
+	}{
+		{
+			name: "Posts_Body_And_Headers",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodPost || string(body) != `{"id":1}` || r.Header.Get("X-Delegator-Event") != "delegation.new" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Redirect_Not_Followed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "https://example.com", http.StatusFound)
+			},
+			expectedCode: http.StatusFound,
+		},
+		{
+			name: "Timeout",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(time.Second):
+				}
+			},
+			timeout: 10 * time.Millisecond,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			client := NewWebhookClient(
+				WebhookClientWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))),
+				WebhookClientWithTimeout(tt.timeout),
+				WebhookClientWithPrivateEndpoints(true),
+			)
+
+			statusCode, err := client.Send(context.Background(), server.URL, map[string]string{
+				"X-Delegator-Event": "delegation.new",
+			}, []byte(`{"id":1}`))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, statusCode)
+		})
+	}
+}
+
+func TestWebhookClient_Send_PrivateEndpoint(t *testing.T) {
+	t.Parallel()
+
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewWebhookClient(WebhookClientWithLogger(slog.New(slog.NewJSONHandler(os.Stdout, nil))))
+
+	_, err := client.Send(context.Background(), server.URL, nil, []byte(`{"id":1}`))
+	assert.ErrorIs(t, err, domain.ErrInvalidEndpoint)
+	assert.False(t, called)
+}
@@ -4,17 +4,35 @@ import (
 	"context"
 	"database/sql"
 	"delegator/conf"
+	"delegator/internal/core/apikey"
+	"delegator/internal/core/balance"
+	"delegator/internal/core/cache"
+	"delegator/internal/core/cycle"
 	"delegator/internal/core/delegator"
 	"delegator/internal/core/delegator/indexer"
+	"delegator/internal/core/events"
+	"delegator/internal/core/outbox"
+	"delegator/internal/core/staking"
+	"delegator/internal/core/stats"
+	"delegator/internal/core/webhook"
 	"delegator/internal/database"
+	"delegator/internal/grpcservice"
 	"delegator/internal/httpservice"
+	"delegator/internal/httpservice/apierror"
+	"delegator/internal/httpservice/auth"
+	"delegator/internal/httpservice/httpcache"
+	"delegator/internal/httpservice/middleware"
+	"delegator/internal/httpservice/openapi"
 	"delegator/internal/httpservice/routes"
+	"delegator/internal/reloader"
 	"delegator/internal/services"
+	"delegator/pkg/domain"
 	"embed"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -38,6 +56,93 @@ func buildConnectionString(config *conf.DelegatorConfig) string {
 	)
 }
 
+func tzktBaseURL(config *conf.DelegatorConfig) string {
+	if config.Tzkt.BaseURL == "" {
+		return "https://api.tzkt.io/v1/"
+	}
+	return config.Tzkt.BaseURL
+}
+
+// eventSink connects the sink the outbox is relayed to, nil when the outbox is disabled.
+func eventSink(config *conf.DelegatorConfig, logger *slog.Logger) (domain.EventSink, error) {
+	switch config.Outbox.Sink {
+	case "":
+		return nil, nil
+	case "nats":
+		return services.NewNATSSink(
+			services.NATSSinkWithLogger(logger),
+			services.NATSSinkWithURL(config.Outbox.URL),
+			services.NATSSinkWithSubject(config.Outbox.Topic),
+		)
+	case "kafka":
+		var brokers []string
+		if config.Outbox.URL != "" {
+			brokers = strings.Split(config.Outbox.URL, ",")
+		}
+		return services.NewKafkaSink(
+			services.KafkaSinkWithLogger(logger),
+			services.KafkaSinkWithBrokers(brokers...),
+			services.KafkaSinkWithTopic(config.Outbox.Topic),
+		), nil
+	case "redis":
+		return services.NewRedisSink(
+			services.RedisSinkWithLogger(logger),
+			services.RedisSinkWithURL(config.Outbox.URL),
+			services.RedisSinkWithStream(config.Outbox.Topic),
+		)
+	case "file":
+		return services.NewFileSink(config.Outbox.URL)
+	case "memory":
+		return services.NewMemorySink(), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox sink %q, expected nats, kafka, redis, file or memory", config.Outbox.Sink)
+	}
+}
+
+// responseStore creates the store of the cached responses, nil when the responses
+// are not stored.
+func responseStore(config *conf.DelegatorConfig) (domain.ResponseStore, error) {
+	switch config.Cache.Store {
+	case "":
+		return nil, nil
+	case "memory":
+		return cache.NewMemoryStore(cache.MemoryStoreWithSize(config.Cache.Size)), nil
+	case "redis":
+		store, err := services.NewRedisStore(
+			services.RedisStoreWithURL(config.Cache.RedisURL),
+			services.RedisStoreWithTTL(config.CacheTTL()),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown cache store %q, expected memory or redis", config.Cache.Store)
+	}
+}
+
+// configPath returns the config file to load and watch for changes, if there is one on disk.
+func configPath() string {
+	path := os.Getenv("CONFIG_PATH")
+	if path == "" {
+		return ""
+	}
+	if _, err := os.Stat(path); err != nil {
+		return ""
+	}
+	return path
+}
+
+func configLoader() reloader.Loader {
+	path := configPath()
+	if path == "" {
+		return conf.LoadConfig
+	}
+	return func() (*conf.DelegatorConfig, error) {
+		return conf.LoadConfigFromFile(path)
+	}
+}
+
 func main() {
 	logger, err := glog.NewDefault()
 	if err != nil {
@@ -49,12 +154,16 @@ func main() {
 
 	ctx := context.Background()
 
-	delegatorConf, err := conf.LoadConfig()
+	// The startup config is read like the reloaded ones, from CONFIG_PATH when
+	// it is set, so that the first reload only reports the actual edits.
+	loadConfig := configLoader()
+	delegatorConf, err := loadConfig()
 	if err != nil {
 		logger.Warn("failed to load delegator config", "error", err)
 		os.Exit(84)
 	}
 	logger.Info(fmt.Sprintf("%+v", delegatorConf))
+	reloader.LogLevel(logger).Reload(delegatorConf)
 
 	connectionString := buildConnectionString(delegatorConf)
 	logger.Info(fmt.Sprintf("connecting to postgres at %s", connectionString))
@@ -105,32 +214,231 @@ func main() {
 		os.Exit(84)
 	}
 
+	outboxSink, err := eventSink(delegatorConf, logger)
+	if err != nil {
+		logger.Warn("failed to init outbox sink", "error", err)
+		os.Exit(84)
+	}
+
 	delegatorRepository := delegator.NewRepository(
 		delegator.RepositoryWithLogger(logger),
 		delegator.RepositoryWithDBClient(gormDriver),
+		delegator.RepositoryWithOutbox(outboxSink != nil),
+	)
+
+	cycleMapper := cycle.NewMapper()
+
+	cacheStore, err := responseStore(delegatorConf)
+	if err != nil {
+		logger.Warn("failed to init cache store", "error", err)
+		os.Exit(84)
+	}
+
+	indexHead := cache.NewHead(
+		cache.HeadWithLogger(logger),
+		cache.HeadWithRepository(delegatorRepository),
+		cache.HeadWithStore(cacheStore),
+		cache.HeadWithRefreshInterval(delegatorConf.CacheRefreshInterval()),
+	)
+
+	delegationBroker := events.NewBroker(
+		events.BrokerWithLogger(logger),
+	)
+
+	webhookRepository := webhook.NewRepository(
+		webhook.RepositoryWithLogger(logger),
+		webhook.RepositoryWithDBClient(gormDriver),
+	)
+
+	webhookClient := services.NewWebhookClient(
+		services.WebhookClientWithLogger(logger),
+		services.WebhookClientWithTimeout(delegatorConf.WebhookTimeout()),
+		services.WebhookClientWithPrivateEndpoints(delegatorConf.Webhooks.AllowPrivateEndpoints),
+	)
+
+	webhookUseCase := webhook.NewUseCase(
+		webhook.UseCaseWithLogger(logger),
+		webhook.UseCaseWithRepository(webhookRepository),
+		webhook.UseCaseWithSender(webhookClient),
+		webhook.UseCaseWithMaxAttempts(delegatorConf.Webhooks.MaxAttempts),
+		webhook.UseCaseWithPrivateEndpoints(delegatorConf.Webhooks.AllowPrivateEndpoints),
+	)
+
+	webhookDispatcher := webhook.NewDispatcher(
+		webhook.DispatcherWithLogger(logger),
+		webhook.DispatcherWithUseCase(webhookUseCase),
+		webhook.DispatcherWithInterval(delegatorConf.WebhookDeliveryInterval()),
 	)
 
 	delegatorUseCase := delegator.NewUseCase(
 		delegator.UseCaseWithLogger(logger),
 		delegator.UseCaseWithRepository(delegatorRepository),
+		delegator.UseCaseWithIndexFailed(delegatorConf.Indexer.IndexFailed),
+		delegator.UseCaseWithCycleMapper(cycleMapper),
+		delegator.UseCaseWithBroker(delegationBroker),
+		delegator.UseCaseWithWebhooks(webhookUseCase),
+		delegator.UseCaseWithIndexHead(indexHead),
+	)
+
+	stakingRepository := staking.NewRepository(
+		staking.RepositoryWithLogger(logger),
+		staking.RepositoryWithDBClient(gormDriver),
+	)
+
+	stakingUseCase := staking.NewUseCase(
+		staking.UseCaseWithLogger(logger),
+		staking.UseCaseWithRepository(stakingRepository),
 	)
 
-	engine := gin.New()
 	httpClient := &http.Client{Timeout: time.Duration(delegatorConf.HTTP.ReadTimeout) * time.Second}
 
+	tzktHTTPHandler := services.NewHTTPHandler(
+		services.HandlerWithLogger(logger),
+		services.HandlerWithClient(httpClient),
+		services.HandlerWithBaseURL(tzktBaseURL(delegatorConf)),
+		services.HandlerWithRateLimit(delegatorConf.Tzkt.RateLimit, delegatorConf.Tzkt.RateBurst),
+	)
+
+	balanceRepository := balance.NewRepository(
+		balance.RepositoryWithLogger(logger),
+		balance.RepositoryWithDBClient(gormDriver),
+	)
+
+	balanceUseCase := balance.NewUseCase(
+		balance.UseCaseWithLogger(logger),
+		balance.UseCaseWithRepository(balanceRepository),
+		balance.UseCaseWithAccountService(tzktHTTPHandler),
+		balance.UseCaseWithBatchSize(delegatorConf.Balance.BatchSize),
+	)
+
+	balanceTracker := balance.NewTracker(
+		balance.TrackerWithLogger(logger),
+		balance.TrackerWithUseCase(balanceUseCase),
+		balance.TrackerWithInterval(delegatorConf.BalanceRefreshInterval()),
+	)
+
+	cycleRepository := cycle.NewRepository(
+		cycle.RepositoryWithLogger(logger),
+		cycle.RepositoryWithDBClient(gormDriver),
+	)
+
+	cycleUseCase := cycle.NewUseCase(
+		cycle.UseCaseWithLogger(logger),
+		cycle.UseCaseWithRepository(cycleRepository),
+		cycle.UseCaseWithProtocolService(tzktHTTPHandler),
+		cycle.UseCaseWithMapper(cycleMapper),
+	)
+
+	cycleSyncer := cycle.NewSyncer(
+		cycle.SyncerWithLogger(logger),
+		cycle.SyncerWithUseCase(cycleUseCase),
+	)
+
+	statsRepository := stats.NewRepository(
+		stats.RepositoryWithLogger(logger),
+		stats.RepositoryWithDBClient(gormDriver),
+	)
+
+	statsUseCase := stats.NewUseCase(
+		stats.UseCaseWithLogger(logger),
+		stats.UseCaseWithRepository(statsRepository),
+	)
+
+	whaleReporter := stats.NewWhaleReporter(
+		stats.WhaleReporterWithLogger(logger),
+		stats.WhaleReporterWithUseCase(statsUseCase),
+		stats.WhaleReporterWithInterval(delegatorConf.WhaleReportInterval()),
+		stats.WhaleReporterWithMinAmount(domain.Mutez(delegatorConf.Reports.WhaleMinAmount)),
+	)
+
+	apiKeyRepository := apikey.NewRepository(
+		apikey.RepositoryWithLogger(logger),
+		apikey.RepositoryWithDBClient(gormDriver),
+	)
+
+	apiKeyUseCase := apikey.NewUseCase(
+		apikey.UseCaseWithLogger(logger),
+		apikey.UseCaseWithRepository(apiKeyRepository),
+		apikey.UseCaseWithDefaults(delegatorConf.Auth.RateLimit, delegatorConf.Auth.RateBurst, delegatorConf.Auth.DailyQuota),
+	)
+	usageFlusher := apikey.NewFlusher(
+		apikey.FlusherWithLogger(logger),
+		apikey.FlusherWithUseCase(apiKeyUseCase),
+	)
+
+	authenticator := auth.NewAuthenticator(
+		auth.WithLogger(logger),
+		auth.WithUseCase(apiKeyUseCase),
+		auth.WithRequired(delegatorConf.Auth.Required),
+		auth.WithPublicRoutes("/health", "/openapi.json", "/docs", "/admin"),
+	)
+
+	legacyDeprecation, legacySunset, err := delegatorConf.LegacyDates()
+	if err != nil {
+		logger.Warn("invalid legacy api dates", "error", err)
+		os.Exit(84)
+	}
+	apiV1 := routes.NewVersion("v1", routes.VersionWithAlias("/xtz", legacyDeprecation, legacySunset))
+
+	responseCache := httpcache.NewCache(
+		httpcache.WithLogger(logger),
+		httpcache.WithEnabled(delegatorConf.Cache.Enabled),
+		httpcache.WithHead(indexHead),
+		httpcache.WithStore(cacheStore),
+		httpcache.WithMaxEntrySize(delegatorConf.Cache.MaxEntrySize),
+		httpcache.WithMaxAge(delegatorConf.CacheMaxAge(), delegatorConf.CacheFinalMaxAge()),
+		httpcache.WithPrivate(delegatorConf.Auth.Required),
+		httpcache.WithRoute("/v1/delegations", nil),
+		httpcache.WithRoute("/v1/cycles/:cycle/delegations", httpcache.FinalCycle(cycleMapper)),
+		httpcache.WithRoute("/xtz/delegations", nil),
+		httpcache.WithRoute("/xtz/cycles/:cycle/delegations", httpcache.FinalCycle(cycleMapper)),
+	)
+
+	apiSpec, err := openapi.Load()
+	if err != nil {
+		logger.Warn("failed to load openapi document", "error", err)
+		os.Exit(84)
+	}
+	for _, alias := range apiV1.Aliases {
+		openapi.Alias(apiSpec, alias.Prefix, apiV1.Prefix)
+	}
+
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(delegatorConf.Middleware.TrustedProxies); err != nil {
+		logger.Warn("invalid trusted proxies", "error", err)
+		os.Exit(84)
+	}
+	engine.HandleMethodNotAllowed = true
+	engine.NoRoute(apierror.NoRoute)
+	engine.NoMethod(apierror.NoMethod)
+
 	httpServer := httpservice.NewHTTPServer(
 		httpservice.WithEngine(engine),
 		httpservice.WithLogger(logger),
 		httpservice.WithHTTPServer(delegatorConf),
-		httpservice.WithRoutes(
-			routes.CreateDelegatorRegistrar(logger, delegatorUseCase),
+		httpservice.WithMiddleware(middleware.Stack(logger, delegatorConf)...),
+		httpservice.WithRateLimit(delegatorConf.API.RateLimit, delegatorConf.API.RateBurst),
+		httpservice.WithMiddleware(
+			apiV1.Middleware(),
+			openapi.Validator(apiSpec),
+			authenticator.Middleware(),
+			responseCache.Middleware(),
 		),
-	)
-
-	tzktHTTPHandler := services.NewHTTPHandler(
-		services.HandlerWithLogger(logger),
-		services.HandlerWithClient(httpClient),
-		services.HandlerWithBaseURL("https://api.tzkt.io/v1/"), // TODO: Add tzkt config
+		httpservice.WithRoutes(routes.CreateRouteRegistrar(
+			routes.CreateHealthRegistrar(),
+			routes.CreateVersionRegistrar(apiV1,
+				routes.CreateDelegatorRegistrar(logger, delegatorUseCase),
+				routes.CreateStreamRegistrar(logger, delegatorUseCase),
+				routes.CreateStakingRegistrar(logger, stakingUseCase),
+				routes.CreateBalanceRegistrar(logger, balanceUseCase),
+				routes.CreateCycleRegistrar(logger, delegatorUseCase, cycleUseCase),
+				routes.CreateStatsRegistrar(logger, statsUseCase),
+				routes.CreateWebhookRegistrar(logger, webhookUseCase),
+			),
+			routes.CreateGraphQLRegistrar(logger, delegatorUseCase),
+			routes.CreateOpenAPIRegistrar(apiSpec),
+			routes.CreateAPIKeyRegistrar(logger, apiKeyUseCase, delegatorConf.Auth.AdminToken),
+		)),
 	)
 
 	indexerComponent := indexer.NewDelegatorIndexer(
@@ -138,11 +446,52 @@ func main() {
 		indexer.WithDelegationHandler(tzktHTTPHandler),
 		indexer.WithDelegatorUseCase(delegatorUseCase),
 		indexer.WithRepository(delegatorRepository),
+		indexer.WithStakingUseCase(stakingUseCase),
+		indexer.WithStakingRepository(stakingRepository),
+		indexer.WithPollInterval(delegatorConf.PollInterval()),
+	)
+
+	configReloader := reloader.NewReloader(
+		reloader.WithLogger(logger),
+		reloader.WithConfig(delegatorConf),
+		reloader.WithConfigPath(configPath()),
+		reloader.WithLoader(loadConfig),
+		reloader.WithTargets(
+			reloader.LogLevel(logger),
+			indexerComponent,
+			tzktHTTPHandler,
+			httpServer,
+		),
 	)
 
+	// The usage is flushed on shutdown before the database client closes.
+	components := []domain.Handler{usageFlusher, pgClient, httpServer, indexerComponent, balanceTracker, cycleSyncer, whaleReporter, webhookDispatcher, configReloader}
+	if delegatorConf.GRPC.Port > 0 {
+		components = append(components, grpcservice.NewGRPCServer(
+			grpcservice.WithLogger(logger),
+			grpcservice.WithGRPCServer(delegatorConf),
+			grpcservice.WithAuthenticator(authenticator),
+			grpcservice.WithServices(grpcservice.RegisterDelegatorService(logger, delegatorUseCase)),
+		))
+	}
+	if outboxSink != nil {
+		outboxRepository := outbox.NewRepository(
+			outbox.RepositoryWithLogger(logger),
+			outbox.RepositoryWithDBClient(gormDriver),
+		)
+
+		components = append(components, outbox.NewRelay(
+			outbox.RelayWithLogger(logger),
+			outbox.RelayWithRepository(outboxRepository),
+			outbox.RelayWithSink(outboxSink),
+			outbox.RelayWithInterval(delegatorConf.OutboxInterval()),
+			outbox.RelayWithBatchSize(delegatorConf.Outbox.BatchSize),
+		))
+	}
+
 	delegatorService := delegator.NewDelegator(
 		delegator.WithLogger(logger),
-		delegator.WithComponents(pgClient, httpServer, indexerComponent),
+		delegator.WithComponents(components...),
 	)
 
 	app := serviceloader.New(
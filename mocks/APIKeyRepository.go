@@ -0,0 +1,510 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAPIKeyRepository creates a new instance of MockAPIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+type MockAPIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepository_Expecter {
+	return &MockAPIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.APIKey) (models.APIKey, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.APIKey) models.APIKey); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(models.APIKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.APIKey) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockAPIKeyRepository_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key models.APIKey
+func (_e *MockAPIKeyRepository_Expecter) CreateAPIKey(ctx interface{}, key interface{}) *MockAPIKeyRepository_CreateAPIKey_Call {
+	return &MockAPIKeyRepository_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, key)}
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) Run(run func(ctx context.Context, key models.APIKey)) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(models.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) Return(aPIKey models.APIKey, err error) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) RunAndReturn(run func(ctx context.Context, key models.APIKey) (models.APIKey, error)) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKey provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindAPIKey(ctx context.Context, id uuid.UUID) (models.APIKey, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKey")
+	}
+
+	var r0 models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (models.APIKey, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) models.APIKey); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.APIKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKey'
+type MockAPIKeyRepository_FindAPIKey_Call struct {
+	*mock.Call
+}
+
+// FindAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyRepository_Expecter) FindAPIKey(ctx interface{}, id interface{}) *MockAPIKeyRepository_FindAPIKey_Call {
+	return &MockAPIKeyRepository_FindAPIKey_Call{Call: _e.mock.On("FindAPIKey", ctx, id)}
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_FindAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKey_Call) Return(aPIKey models.APIKey, err error) *MockAPIKeyRepository_FindAPIKey_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (models.APIKey, error)) *MockAPIKeyRepository_FindAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByHash provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error) {
+	ret := _mock.Called(ctx, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByHash")
+	}
+
+	var r0 models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (models.APIKey, error)); ok {
+		return returnFunc(ctx, hash)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) models.APIKey); ok {
+		r0 = returnFunc(ctx, hash)
+	} else {
+		r0 = ret.Get(0).(models.APIKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByHash'
+type MockAPIKeyRepository_FindAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hash string
+func (_e *MockAPIKeyRepository_Expecter) FindAPIKeyByHash(ctx interface{}, hash interface{}) *MockAPIKeyRepository_FindAPIKeyByHash_Call {
+	return &MockAPIKeyRepository_FindAPIKeyByHash_Call{Call: _e.mock.On("FindAPIKeyByHash", ctx, hash)}
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeyByHash_Call) Run(run func(ctx context.Context, hash string)) *MockAPIKeyRepository_FindAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeyByHash_Call) Return(aPIKey models.APIKey, err error) *MockAPIKeyRepository_FindAPIKeyByHash_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeyByHash_Call) RunAndReturn(run func(ctx context.Context, hash string) (models.APIKey, error)) *MockAPIKeyRepository_FindAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeys provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeys")
+	}
+
+	var r0 []models.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.APIKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.APIKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.APIKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeys'
+type MockAPIKeyRepository_FindAPIKeys_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPIKeyRepository_Expecter) FindAPIKeys(ctx interface{}) *MockAPIKeyRepository_FindAPIKeys_Call {
+	return &MockAPIKeyRepository_FindAPIKeys_Call{Call: _e.mock.On("FindAPIKeys", ctx)}
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeys_Call) Run(run func(ctx context.Context)) *MockAPIKeyRepository_FindAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeys_Call) Return(aPIKeys []models.APIKey, err error) *MockAPIKeyRepository_FindAPIKeys_Call {
+	_c.Call.Return(aPIKeys, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindAPIKeys_Call) RunAndReturn(run func(ctx context.Context) ([]models.APIKey, error)) *MockAPIKeyRepository_FindAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUsage provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) FindUsage(ctx context.Context, id uuid.UUID, from time.Time) ([]models.APIKeyUsage, error) {
+	ret := _mock.Called(ctx, id, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUsage")
+	}
+
+	var r0 []models.APIKeyUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) ([]models.APIKeyUsage, error)); ok {
+		return returnFunc(ctx, id, from)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) []models.APIKeyUsage); ok {
+		r0 = returnFunc(ctx, id, from)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.APIKeyUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r1 = returnFunc(ctx, id, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_FindUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUsage'
+type MockAPIKeyRepository_FindUsage_Call struct {
+	*mock.Call
+}
+
+// FindUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - from time.Time
+func (_e *MockAPIKeyRepository_Expecter) FindUsage(ctx interface{}, id interface{}, from interface{}) *MockAPIKeyRepository_FindUsage_Call {
+	return &MockAPIKeyRepository_FindUsage_Call{Call: _e.mock.On("FindUsage", ctx, id, from)}
+}
+
+func (_c *MockAPIKeyRepository_FindUsage_Call) Run(run func(ctx context.Context, id uuid.UUID, from time.Time)) *MockAPIKeyRepository_FindUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindUsage_Call) Return(aPIKeyUsages []models.APIKeyUsage, err error) *MockAPIKeyRepository_FindUsage_Call {
+	_c.Call.Return(aPIKeyUsages, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_FindUsage_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, from time.Time) ([]models.APIKeyUsage, error)) *MockAPIKeyRepository_FindUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IncrementUsage provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) IncrementUsage(ctx context.Context, id uuid.UUID, day time.Time, requests int64) (int64, error) {
+	ret := _mock.Called(ctx, id, day, requests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncrementUsage")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int64) (int64, error)); ok {
+		return returnFunc(ctx, id, day, requests)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, int64) int64); ok {
+		r0 = returnFunc(ctx, id, day, requests)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, time.Time, int64) error); ok {
+		r1 = returnFunc(ctx, id, day, requests)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyRepository_IncrementUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementUsage'
+type MockAPIKeyRepository_IncrementUsage_Call struct {
+	*mock.Call
+}
+
+// IncrementUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - day time.Time
+//   - requests int64
+func (_e *MockAPIKeyRepository_Expecter) IncrementUsage(ctx interface{}, id interface{}, day interface{}, requests interface{}) *MockAPIKeyRepository_IncrementUsage_Call {
+	return &MockAPIKeyRepository_IncrementUsage_Call{Call: _e.mock.On("IncrementUsage", ctx, id, day, requests)}
+}
+
+func (_c *MockAPIKeyRepository_IncrementUsage_Call) Run(run func(ctx context.Context, id uuid.UUID, day time.Time, requests int64)) *MockAPIKeyRepository_IncrementUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int64
+		if args[3] != nil {
+			arg3 = args[3].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_IncrementUsage_Call) Return(n int64, err error) *MockAPIKeyRepository_IncrementUsage_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_IncrementUsage_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, day time.Time, requests int64) (int64, error)) *MockAPIKeyRepository_IncrementUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function for the type MockAPIKeyRepository
+func (_mock *MockAPIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyRepository_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockAPIKeyRepository_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyRepository_Expecter) RevokeAPIKey(ctx interface{}, id interface{}) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	return &MockAPIKeyRepository_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, id)}
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) Return(err error) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
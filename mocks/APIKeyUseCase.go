@@ -0,0 +1,544 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAPIKeyUseCase creates a new instance of MockAPIKeyUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyUseCase {
+	mock := &MockAPIKeyUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAPIKeyUseCase is an autogenerated mock type for the APIKeyUseCase type
+type MockAPIKeyUseCase struct {
+	mock.Mock
+}
+
+type MockAPIKeyUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyUseCase) EXPECT() *MockAPIKeyUseCase_Expecter {
+	return &MockAPIKeyUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) Authenticate(ctx context.Context, key string) (domain.APIKey, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 domain.APIKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.APIKey, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.APIKey); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(domain.APIKey)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAPIKeyUseCase_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockAPIKeyUseCase_Expecter) Authenticate(ctx interface{}, key interface{}) *MockAPIKeyUseCase_Authenticate_Call {
+	return &MockAPIKeyUseCase_Authenticate_Call{Call: _e.mock.On("Authenticate", ctx, key)}
+}
+
+func (_c *MockAPIKeyUseCase_Authenticate_Call) Run(run func(ctx context.Context, key string)) *MockAPIKeyUseCase_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_Authenticate_Call) Return(aPIKey domain.APIKey, err error) *MockAPIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(aPIKey, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_Authenticate_Call) RunAndReturn(run func(ctx context.Context, key string) (domain.APIKey, error)) *MockAPIKeyUseCase_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FlushUsage provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) FlushUsage(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FlushUsage")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyUseCase_FlushUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FlushUsage'
+type MockAPIKeyUseCase_FlushUsage_Call struct {
+	*mock.Call
+}
+
+// FlushUsage is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPIKeyUseCase_Expecter) FlushUsage(ctx interface{}) *MockAPIKeyUseCase_FlushUsage_Call {
+	return &MockAPIKeyUseCase_FlushUsage_Call{Call: _e.mock.On("FlushUsage", ctx)}
+}
+
+func (_c *MockAPIKeyUseCase_FlushUsage_Call) Run(run func(ctx context.Context)) *MockAPIKeyUseCase_FlushUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_FlushUsage_Call) Return(err error) *MockAPIKeyUseCase_FlushUsage_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_FlushUsage_Call) RunAndReturn(run func(ctx context.Context) error) *MockAPIKeyUseCase_FlushUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKey provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) GetAPIKey(ctx context.Context, id uuid.UUID) (domain.APIKeyResponseType, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKey")
+	}
+
+	var r0 domain.APIKeyResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) (domain.APIKeyResponseType, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) domain.APIKeyResponseType); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(domain.APIKeyResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type MockAPIKeyUseCase_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyUseCase_Expecter) GetAPIKey(ctx interface{}, id interface{}) *MockAPIKeyUseCase_GetAPIKey_Call {
+	return &MockAPIKeyUseCase_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, id)}
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyUseCase_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKey_Call) Return(aPIKeyResponseType domain.APIKeyResponseType, err error) *MockAPIKeyUseCase_GetAPIKey_Call {
+	_c.Call.Return(aPIKeyResponseType, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) (domain.APIKeyResponseType, error)) *MockAPIKeyUseCase_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeys provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) GetAPIKeys(ctx context.Context) (domain.ApiResponse[domain.APIKeyResponseType], error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeys")
+	}
+
+	var r0 domain.ApiResponse[domain.APIKeyResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.ApiResponse[domain.APIKeyResponseType], error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.ApiResponse[domain.APIKeyResponseType]); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.APIKeyResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_GetAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeys'
+type MockAPIKeyUseCase_GetAPIKeys_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAPIKeyUseCase_Expecter) GetAPIKeys(ctx interface{}) *MockAPIKeyUseCase_GetAPIKeys_Call {
+	return &MockAPIKeyUseCase_GetAPIKeys_Call{Call: _e.mock.On("GetAPIKeys", ctx)}
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKeys_Call) Run(run func(ctx context.Context)) *MockAPIKeyUseCase_GetAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKeys_Call) Return(apiResponse domain.ApiResponse[domain.APIKeyResponseType], err error) *MockAPIKeyUseCase_GetAPIKeys_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetAPIKeys_Call) RunAndReturn(run func(ctx context.Context) (domain.ApiResponse[domain.APIKeyResponseType], error)) *MockAPIKeyUseCase_GetAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUsage provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) GetUsage(ctx context.Context, id uuid.UUID, days int) (domain.ApiResponse[domain.APIKeyUsageResponseType], error) {
+	ret := _mock.Called(ctx, id, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 domain.ApiResponse[domain.APIKeyUsageResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) (domain.ApiResponse[domain.APIKeyUsageResponseType], error)); ok {
+		return returnFunc(ctx, id, days)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) domain.ApiResponse[domain.APIKeyUsageResponseType]); ok {
+		r0 = returnFunc(ctx, id, days)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.APIKeyUsageResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, id, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type MockAPIKeyUseCase_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - days int
+func (_e *MockAPIKeyUseCase_Expecter) GetUsage(ctx interface{}, id interface{}, days interface{}) *MockAPIKeyUseCase_GetUsage_Call {
+	return &MockAPIKeyUseCase_GetUsage_Call{Call: _e.mock.On("GetUsage", ctx, id, days)}
+}
+
+func (_c *MockAPIKeyUseCase_GetUsage_Call) Run(run func(ctx context.Context, id uuid.UUID, days int)) *MockAPIKeyUseCase_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetUsage_Call) Return(apiResponse domain.ApiResponse[domain.APIKeyUsageResponseType], err error) *MockAPIKeyUseCase_GetUsage_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_GetUsage_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, days int) (domain.ApiResponse[domain.APIKeyUsageResponseType], error)) *MockAPIKeyUseCase_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueAPIKey provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) IssueAPIKey(ctx context.Context, dto domain.IssueAPIKeyDTO) (domain.APIKeyResponseType, error) {
+	ret := _mock.Called(ctx, dto)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueAPIKey")
+	}
+
+	var r0 domain.APIKeyResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.IssueAPIKeyDTO) (domain.APIKeyResponseType, error)); ok {
+		return returnFunc(ctx, dto)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.IssueAPIKeyDTO) domain.APIKeyResponseType); ok {
+		r0 = returnFunc(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(domain.APIKeyResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.IssueAPIKeyDTO) error); ok {
+		r1 = returnFunc(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_IssueAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueAPIKey'
+type MockAPIKeyUseCase_IssueAPIKey_Call struct {
+	*mock.Call
+}
+
+// IssueAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto domain.IssueAPIKeyDTO
+func (_e *MockAPIKeyUseCase_Expecter) IssueAPIKey(ctx interface{}, dto interface{}) *MockAPIKeyUseCase_IssueAPIKey_Call {
+	return &MockAPIKeyUseCase_IssueAPIKey_Call{Call: _e.mock.On("IssueAPIKey", ctx, dto)}
+}
+
+func (_c *MockAPIKeyUseCase_IssueAPIKey_Call) Run(run func(ctx context.Context, dto domain.IssueAPIKeyDTO)) *MockAPIKeyUseCase_IssueAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.IssueAPIKeyDTO
+		if args[1] != nil {
+			arg1 = args[1].(domain.IssueAPIKeyDTO)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_IssueAPIKey_Call) Return(aPIKeyResponseType domain.APIKeyResponseType, err error) *MockAPIKeyUseCase_IssueAPIKey_Call {
+	_c.Call.Return(aPIKeyResponseType, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_IssueAPIKey_Call) RunAndReturn(run func(ctx context.Context, dto domain.IssueAPIKeyDTO) (domain.APIKeyResponseType, error)) *MockAPIKeyUseCase_IssueAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordUsage provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) RecordUsage(ctx context.Context, key domain.APIKey) (domain.APIKeyQuota, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordUsage")
+	}
+
+	var r0 domain.APIKeyQuota
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.APIKey) (domain.APIKeyQuota, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.APIKey) domain.APIKeyQuota); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(domain.APIKeyQuota)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.APIKey) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAPIKeyUseCase_RecordUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordUsage'
+type MockAPIKeyUseCase_RecordUsage_Call struct {
+	*mock.Call
+}
+
+// RecordUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key domain.APIKey
+func (_e *MockAPIKeyUseCase_Expecter) RecordUsage(ctx interface{}, key interface{}) *MockAPIKeyUseCase_RecordUsage_Call {
+	return &MockAPIKeyUseCase_RecordUsage_Call{Call: _e.mock.On("RecordUsage", ctx, key)}
+}
+
+func (_c *MockAPIKeyUseCase_RecordUsage_Call) Run(run func(ctx context.Context, key domain.APIKey)) *MockAPIKeyUseCase_RecordUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.APIKey
+		if args[1] != nil {
+			arg1 = args[1].(domain.APIKey)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_RecordUsage_Call) Return(aPIKeyQuota domain.APIKeyQuota, err error) *MockAPIKeyUseCase_RecordUsage_Call {
+	_c.Call.Return(aPIKeyQuota, err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_RecordUsage_Call) RunAndReturn(run func(ctx context.Context, key domain.APIKey) (domain.APIKeyQuota, error)) *MockAPIKeyUseCase_RecordUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function for the type MockAPIKeyUseCase
+func (_mock *MockAPIKeyUseCase) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAPIKeyUseCase_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockAPIKeyUseCase_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockAPIKeyUseCase_Expecter) RevokeAPIKey(ctx interface{}, id interface{}) *MockAPIKeyUseCase_RevokeAPIKey_Call {
+	return &MockAPIKeyUseCase_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, id)}
+}
+
+func (_c *MockAPIKeyUseCase_RevokeAPIKey_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockAPIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_RevokeAPIKey_Call) Return(err error) *MockAPIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAPIKeyUseCase_RevokeAPIKey_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockAPIKeyUseCase_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
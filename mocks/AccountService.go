@@ -0,0 +1,107 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockAccountService creates a new instance of MockAccountService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAccountService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAccountService {
+	mock := &MockAccountService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockAccountService is an autogenerated mock type for the AccountService type
+type MockAccountService struct {
+	mock.Mock
+}
+
+type MockAccountService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAccountService) EXPECT() *MockAccountService_Expecter {
+	return &MockAccountService_Expecter{mock: &_m.Mock}
+}
+
+// GetAccounts provides a mock function for the type MockAccountService
+func (_mock *MockAccountService) GetAccounts(ctx context.Context, addresses []string) ([]domain.TzktApiAccountResponse, error) {
+	ret := _mock.Called(ctx, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccounts")
+	}
+
+	var r0 []domain.TzktApiAccountResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]domain.TzktApiAccountResponse, error)); ok {
+		return returnFunc(ctx, addresses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []domain.TzktApiAccountResponse); ok {
+		r0 = returnFunc(ctx, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TzktApiAccountResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAccountService_GetAccounts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccounts'
+type MockAccountService_GetAccounts_Call struct {
+	*mock.Call
+}
+
+// GetAccounts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []string
+func (_e *MockAccountService_Expecter) GetAccounts(ctx interface{}, addresses interface{}) *MockAccountService_GetAccounts_Call {
+	return &MockAccountService_GetAccounts_Call{Call: _e.mock.On("GetAccounts", ctx, addresses)}
+}
+
+func (_c *MockAccountService_GetAccounts_Call) Run(run func(ctx context.Context, addresses []string)) *MockAccountService_GetAccounts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAccountService_GetAccounts_Call) Return(tzktApiAccountResponses []domain.TzktApiAccountResponse, err error) *MockAccountService_GetAccounts_Call {
+	_c.Call.Return(tzktApiAccountResponses, err)
+	return _c
+}
+
+func (_c *MockAccountService_GetAccounts_Call) RunAndReturn(run func(ctx context.Context, addresses []string) ([]domain.TzktApiAccountResponse, error)) *MockAccountService_GetAccounts_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,357 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockBalanceRepository creates a new instance of MockBalanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBalanceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBalanceRepository {
+	mock := &MockBalanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBalanceRepository is an autogenerated mock type for the BalanceRepository type
+type MockBalanceRepository struct {
+	mock.Mock
+}
+
+type MockBalanceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBalanceRepository) EXPECT() *MockBalanceRepository_Expecter {
+	return &MockBalanceRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindActiveDelegators provides a mock function for the type MockBalanceRepository
+func (_mock *MockBalanceRepository) FindActiveDelegators(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindActiveDelegators")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBalanceRepository_FindActiveDelegators_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindActiveDelegators'
+type MockBalanceRepository_FindActiveDelegators_Call struct {
+	*mock.Call
+}
+
+// FindActiveDelegators is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalanceRepository_Expecter) FindActiveDelegators(ctx interface{}) *MockBalanceRepository_FindActiveDelegators_Call {
+	return &MockBalanceRepository_FindActiveDelegators_Call{Call: _e.mock.On("FindActiveDelegators", ctx)}
+}
+
+func (_c *MockBalanceRepository_FindActiveDelegators_Call) Run(run func(ctx context.Context)) *MockBalanceRepository_FindActiveDelegators_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindActiveDelegators_Call) Return(strings []string, err error) *MockBalanceRepository_FindActiveDelegators_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindActiveDelegators_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockBalanceRepository_FindActiveDelegators_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBakerBalances provides a mock function for the type MockBalanceRepository
+func (_mock *MockBalanceRepository) FindBakerBalances(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter) ([]models.BakerBalanceSnapshot, error) {
+	ret := _mock.Called(ctx, address, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBakerBalances")
+	}
+
+	var r0 []models.BakerBalanceSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.BalanceHistoryFilter) ([]models.BakerBalanceSnapshot, error)); ok {
+		return returnFunc(ctx, address, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.BalanceHistoryFilter) []models.BakerBalanceSnapshot); ok {
+		r0 = returnFunc(ctx, address, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BakerBalanceSnapshot)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address, domain.BalanceHistoryFilter) error); ok {
+		r1 = returnFunc(ctx, address, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBalanceRepository_FindBakerBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBakerBalances'
+type MockBalanceRepository_FindBakerBalances_Call struct {
+	*mock.Call
+}
+
+// FindBakerBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address domain.Address
+//   - filter domain.BalanceHistoryFilter
+func (_e *MockBalanceRepository_Expecter) FindBakerBalances(ctx interface{}, address interface{}, filter interface{}) *MockBalanceRepository_FindBakerBalances_Call {
+	return &MockBalanceRepository_FindBakerBalances_Call{Call: _e.mock.On("FindBakerBalances", ctx, address, filter)}
+}
+
+func (_c *MockBalanceRepository_FindBakerBalances_Call) Run(run func(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter)) *MockBalanceRepository_FindBakerBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		var arg2 domain.BalanceHistoryFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.BalanceHistoryFilter)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindBakerBalances_Call) Return(bakerBalanceSnapshots []models.BakerBalanceSnapshot, err error) *MockBalanceRepository_FindBakerBalances_Call {
+	_c.Call.Return(bakerBalanceSnapshots, err)
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindBakerBalances_Call) RunAndReturn(run func(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter) ([]models.BakerBalanceSnapshot, error)) *MockBalanceRepository_FindBakerBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLatestBakerBalance provides a mock function for the type MockBalanceRepository
+func (_mock *MockBalanceRepository) FindLatestBakerBalance(ctx context.Context, address domain.Address) (models.BakerBalanceSnapshot, error) {
+	ret := _mock.Called(ctx, address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatestBakerBalance")
+	}
+
+	var r0 models.BakerBalanceSnapshot
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address) (models.BakerBalanceSnapshot, error)); ok {
+		return returnFunc(ctx, address)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address) models.BakerBalanceSnapshot); ok {
+		r0 = returnFunc(ctx, address)
+	} else {
+		r0 = ret.Get(0).(models.BakerBalanceSnapshot)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address) error); ok {
+		r1 = returnFunc(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBalanceRepository_FindLatestBakerBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatestBakerBalance'
+type MockBalanceRepository_FindLatestBakerBalance_Call struct {
+	*mock.Call
+}
+
+// FindLatestBakerBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address domain.Address
+func (_e *MockBalanceRepository_Expecter) FindLatestBakerBalance(ctx interface{}, address interface{}) *MockBalanceRepository_FindLatestBakerBalance_Call {
+	return &MockBalanceRepository_FindLatestBakerBalance_Call{Call: _e.mock.On("FindLatestBakerBalance", ctx, address)}
+}
+
+func (_c *MockBalanceRepository_FindLatestBakerBalance_Call) Run(run func(ctx context.Context, address domain.Address)) *MockBalanceRepository_FindLatestBakerBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindLatestBakerBalance_Call) Return(bakerBalanceSnapshot models.BakerBalanceSnapshot, err error) *MockBalanceRepository_FindLatestBakerBalance_Call {
+	_c.Call.Return(bakerBalanceSnapshot, err)
+	return _c
+}
+
+func (_c *MockBalanceRepository_FindLatestBakerBalance_Call) RunAndReturn(run func(ctx context.Context, address domain.Address) (models.BakerBalanceSnapshot, error)) *MockBalanceRepository_FindLatestBakerBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveBalances provides a mock function for the type MockBalanceRepository
+func (_mock *MockBalanceRepository) SaveBalances(ctx context.Context, balances []models.DelegatorBalance) error {
+	ret := _mock.Called(ctx, balances)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveBalances")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.DelegatorBalance) error); ok {
+		r0 = returnFunc(ctx, balances)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBalanceRepository_SaveBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBalances'
+type MockBalanceRepository_SaveBalances_Call struct {
+	*mock.Call
+}
+
+// SaveBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - balances []models.DelegatorBalance
+func (_e *MockBalanceRepository_Expecter) SaveBalances(ctx interface{}, balances interface{}) *MockBalanceRepository_SaveBalances_Call {
+	return &MockBalanceRepository_SaveBalances_Call{Call: _e.mock.On("SaveBalances", ctx, balances)}
+}
+
+func (_c *MockBalanceRepository_SaveBalances_Call) Run(run func(ctx context.Context, balances []models.DelegatorBalance)) *MockBalanceRepository_SaveBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []models.DelegatorBalance
+		if args[1] != nil {
+			arg1 = args[1].([]models.DelegatorBalance)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceRepository_SaveBalances_Call) Return(err error) *MockBalanceRepository_SaveBalances_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBalanceRepository_SaveBalances_Call) RunAndReturn(run func(ctx context.Context, balances []models.DelegatorBalance) error) *MockBalanceRepository_SaveBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SnapshotBakerBalances provides a mock function for the type MockBalanceRepository
+func (_mock *MockBalanceRepository) SnapshotBakerBalances(ctx context.Context, takenAt time.Time) error {
+	ret := _mock.Called(ctx, takenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SnapshotBakerBalances")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = returnFunc(ctx, takenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBalanceRepository_SnapshotBakerBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SnapshotBakerBalances'
+type MockBalanceRepository_SnapshotBakerBalances_Call struct {
+	*mock.Call
+}
+
+// SnapshotBakerBalances is a helper method to define mock.On call
+//   - ctx context.Context
+//   - takenAt time.Time
+func (_e *MockBalanceRepository_Expecter) SnapshotBakerBalances(ctx interface{}, takenAt interface{}) *MockBalanceRepository_SnapshotBakerBalances_Call {
+	return &MockBalanceRepository_SnapshotBakerBalances_Call{Call: _e.mock.On("SnapshotBakerBalances", ctx, takenAt)}
+}
+
+func (_c *MockBalanceRepository_SnapshotBakerBalances_Call) Run(run func(ctx context.Context, takenAt time.Time)) *MockBalanceRepository_SnapshotBakerBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceRepository_SnapshotBakerBalances_Call) Return(err error) *MockBalanceRepository_SnapshotBakerBalances_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBalanceRepository_SnapshotBakerBalances_Call) RunAndReturn(run func(ctx context.Context, takenAt time.Time) error) *MockBalanceRepository_SnapshotBakerBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,168 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockBalanceUseCase creates a new instance of MockBalanceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockBalanceUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockBalanceUseCase {
+	mock := &MockBalanceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockBalanceUseCase is an autogenerated mock type for the BalanceUseCase type
+type MockBalanceUseCase struct {
+	mock.Mock
+}
+
+type MockBalanceUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockBalanceUseCase) EXPECT() *MockBalanceUseCase_Expecter {
+	return &MockBalanceUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetBakerBalance provides a mock function for the type MockBalanceUseCase
+func (_mock *MockBalanceUseCase) GetBakerBalance(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter, opts domain.ResponseOptions) (domain.BakerBalanceResponseType, error) {
+	ret := _mock.Called(ctx, address, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBakerBalance")
+	}
+
+	var r0 domain.BakerBalanceResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.BalanceHistoryFilter, domain.ResponseOptions) (domain.BakerBalanceResponseType, error)); ok {
+		return returnFunc(ctx, address, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.BalanceHistoryFilter, domain.ResponseOptions) domain.BakerBalanceResponseType); ok {
+		r0 = returnFunc(ctx, address, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.BakerBalanceResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address, domain.BalanceHistoryFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, address, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockBalanceUseCase_GetBakerBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBakerBalance'
+type MockBalanceUseCase_GetBakerBalance_Call struct {
+	*mock.Call
+}
+
+// GetBakerBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address domain.Address
+//   - filter domain.BalanceHistoryFilter
+//   - opts domain.ResponseOptions
+func (_e *MockBalanceUseCase_Expecter) GetBakerBalance(ctx interface{}, address interface{}, filter interface{}, opts interface{}) *MockBalanceUseCase_GetBakerBalance_Call {
+	return &MockBalanceUseCase_GetBakerBalance_Call{Call: _e.mock.On("GetBakerBalance", ctx, address, filter, opts)}
+}
+
+func (_c *MockBalanceUseCase_GetBakerBalance_Call) Run(run func(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter, opts domain.ResponseOptions)) *MockBalanceUseCase_GetBakerBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		var arg2 domain.BalanceHistoryFilter
+		if args[2] != nil {
+			arg2 = args[2].(domain.BalanceHistoryFilter)
+		}
+		var arg3 domain.ResponseOptions
+		if args[3] != nil {
+			arg3 = args[3].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceUseCase_GetBakerBalance_Call) Return(bakerBalanceResponseType domain.BakerBalanceResponseType, err error) *MockBalanceUseCase_GetBakerBalance_Call {
+	_c.Call.Return(bakerBalanceResponseType, err)
+	return _c
+}
+
+func (_c *MockBalanceUseCase_GetBakerBalance_Call) RunAndReturn(run func(ctx context.Context, address domain.Address, filter domain.BalanceHistoryFilter, opts domain.ResponseOptions) (domain.BakerBalanceResponseType, error)) *MockBalanceUseCase_GetBakerBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Refresh provides a mock function for the type MockBalanceUseCase
+func (_mock *MockBalanceUseCase) Refresh(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockBalanceUseCase_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type MockBalanceUseCase_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockBalanceUseCase_Expecter) Refresh(ctx interface{}) *MockBalanceUseCase_Refresh_Call {
+	return &MockBalanceUseCase_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *MockBalanceUseCase_Refresh_Call) Run(run func(ctx context.Context)) *MockBalanceUseCase_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockBalanceUseCase_Refresh_Call) Return(err error) *MockBalanceUseCase_Refresh_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockBalanceUseCase_Refresh_Call) RunAndReturn(run func(ctx context.Context) error) *MockBalanceUseCase_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
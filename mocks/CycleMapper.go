@@ -0,0 +1,96 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCycleMapper creates a new instance of MockCycleMapper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCycleMapper(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCycleMapper {
+	mock := &MockCycleMapper{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCycleMapper is an autogenerated mock type for the CycleMapper type
+type MockCycleMapper struct {
+	mock.Mock
+}
+
+type MockCycleMapper_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCycleMapper) EXPECT() *MockCycleMapper_Expecter {
+	return &MockCycleMapper_Expecter{mock: &_m.Mock}
+}
+
+// CycleOf provides a mock function for the type MockCycleMapper
+func (_mock *MockCycleMapper) CycleOf(level int64) (int64, bool) {
+	ret := _mock.Called(level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CycleOf")
+	}
+
+	var r0 int64
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(int64) (int64, bool)); ok {
+		return returnFunc(level)
+	}
+	if returnFunc, ok := ret.Get(0).(func(int64) int64); ok {
+		r0 = returnFunc(level)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(int64) bool); ok {
+		r1 = returnFunc(level)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockCycleMapper_CycleOf_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CycleOf'
+type MockCycleMapper_CycleOf_Call struct {
+	*mock.Call
+}
+
+// CycleOf is a helper method to define mock.On call
+//   - level int64
+func (_e *MockCycleMapper_Expecter) CycleOf(level interface{}) *MockCycleMapper_CycleOf_Call {
+	return &MockCycleMapper_CycleOf_Call{Call: _e.mock.On("CycleOf", level)}
+}
+
+func (_c *MockCycleMapper_CycleOf_Call) Run(run func(level int64)) *MockCycleMapper_CycleOf_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 int64
+		if args[0] != nil {
+			arg0 = args[0].(int64)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleMapper_CycleOf_Call) Return(n int64, b bool) *MockCycleMapper_CycleOf_Call {
+	_c.Call.Return(n, b)
+	return _c
+}
+
+func (_c *MockCycleMapper_CycleOf_Call) RunAndReturn(run func(level int64) (int64, bool)) *MockCycleMapper_CycleOf_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,286 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCycleRepository creates a new instance of MockCycleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCycleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCycleRepository {
+	mock := &MockCycleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCycleRepository is an autogenerated mock type for the CycleRepository type
+type MockCycleRepository struct {
+	mock.Mock
+}
+
+type MockCycleRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCycleRepository) EXPECT() *MockCycleRepository_Expecter {
+	return &MockCycleRepository_Expecter{mock: &_m.Mock}
+}
+
+// AssignCycles provides a mock function for the type MockCycleRepository
+func (_mock *MockCycleRepository) AssignCycles(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignCycles")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCycleRepository_AssignCycles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignCycles'
+type MockCycleRepository_AssignCycles_Call struct {
+	*mock.Call
+}
+
+// AssignCycles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCycleRepository_Expecter) AssignCycles(ctx interface{}) *MockCycleRepository_AssignCycles_Call {
+	return &MockCycleRepository_AssignCycles_Call{Call: _e.mock.On("AssignCycles", ctx)}
+}
+
+func (_c *MockCycleRepository_AssignCycles_Call) Run(run func(ctx context.Context)) *MockCycleRepository_AssignCycles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleRepository_AssignCycles_Call) Return(n int64, err error) *MockCycleRepository_AssignCycles_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockCycleRepository_AssignCycles_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockCycleRepository_AssignCycles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBakers provides a mock function for the type MockCycleRepository
+func (_mock *MockCycleRepository) FindBakers(ctx context.Context, cycle int64) ([]models.CycleBakerStats, error) {
+	ret := _mock.Called(ctx, cycle)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBakers")
+	}
+
+	var r0 []models.CycleBakerStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) ([]models.CycleBakerStats, error)); ok {
+		return returnFunc(ctx, cycle)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) []models.CycleBakerStats); ok {
+		r0 = returnFunc(ctx, cycle)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CycleBakerStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = returnFunc(ctx, cycle)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCycleRepository_FindBakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBakers'
+type MockCycleRepository_FindBakers_Call struct {
+	*mock.Call
+}
+
+// FindBakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cycle int64
+func (_e *MockCycleRepository_Expecter) FindBakers(ctx interface{}, cycle interface{}) *MockCycleRepository_FindBakers_Call {
+	return &MockCycleRepository_FindBakers_Call{Call: _e.mock.On("FindBakers", ctx, cycle)}
+}
+
+func (_c *MockCycleRepository_FindBakers_Call) Run(run func(ctx context.Context, cycle int64)) *MockCycleRepository_FindBakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleRepository_FindBakers_Call) Return(cycleBakerStatss []models.CycleBakerStats, err error) *MockCycleRepository_FindBakers_Call {
+	_c.Call.Return(cycleBakerStatss, err)
+	return _c
+}
+
+func (_c *MockCycleRepository_FindBakers_Call) RunAndReturn(run func(ctx context.Context, cycle int64) ([]models.CycleBakerStats, error)) *MockCycleRepository_FindBakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindProtocols provides a mock function for the type MockCycleRepository
+func (_mock *MockCycleRepository) FindProtocols(ctx context.Context) ([]models.Protocol, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindProtocols")
+	}
+
+	var r0 []models.Protocol
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.Protocol, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.Protocol); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Protocol)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCycleRepository_FindProtocols_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindProtocols'
+type MockCycleRepository_FindProtocols_Call struct {
+	*mock.Call
+}
+
+// FindProtocols is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCycleRepository_Expecter) FindProtocols(ctx interface{}) *MockCycleRepository_FindProtocols_Call {
+	return &MockCycleRepository_FindProtocols_Call{Call: _e.mock.On("FindProtocols", ctx)}
+}
+
+func (_c *MockCycleRepository_FindProtocols_Call) Run(run func(ctx context.Context)) *MockCycleRepository_FindProtocols_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleRepository_FindProtocols_Call) Return(protocols []models.Protocol, err error) *MockCycleRepository_FindProtocols_Call {
+	_c.Call.Return(protocols, err)
+	return _c
+}
+
+func (_c *MockCycleRepository_FindProtocols_Call) RunAndReturn(run func(ctx context.Context) ([]models.Protocol, error)) *MockCycleRepository_FindProtocols_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveProtocols provides a mock function for the type MockCycleRepository
+func (_mock *MockCycleRepository) SaveProtocols(ctx context.Context, protocols []models.Protocol) error {
+	ret := _mock.Called(ctx, protocols)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveProtocols")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.Protocol) error); ok {
+		r0 = returnFunc(ctx, protocols)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCycleRepository_SaveProtocols_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveProtocols'
+type MockCycleRepository_SaveProtocols_Call struct {
+	*mock.Call
+}
+
+// SaveProtocols is a helper method to define mock.On call
+//   - ctx context.Context
+//   - protocols []models.Protocol
+func (_e *MockCycleRepository_Expecter) SaveProtocols(ctx interface{}, protocols interface{}) *MockCycleRepository_SaveProtocols_Call {
+	return &MockCycleRepository_SaveProtocols_Call{Call: _e.mock.On("SaveProtocols", ctx, protocols)}
+}
+
+func (_c *MockCycleRepository_SaveProtocols_Call) Run(run func(ctx context.Context, protocols []models.Protocol)) *MockCycleRepository_SaveProtocols_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []models.Protocol
+		if args[1] != nil {
+			arg1 = args[1].([]models.Protocol)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleRepository_SaveProtocols_Call) Return(err error) *MockCycleRepository_SaveProtocols_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCycleRepository_SaveProtocols_Call) RunAndReturn(run func(ctx context.Context, protocols []models.Protocol) error) *MockCycleRepository_SaveProtocols_Call {
+	_c.Call.Return(run)
+	return _c
+}
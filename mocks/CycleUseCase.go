@@ -0,0 +1,162 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockCycleUseCase creates a new instance of MockCycleUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCycleUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCycleUseCase {
+	mock := &MockCycleUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockCycleUseCase is an autogenerated mock type for the CycleUseCase type
+type MockCycleUseCase struct {
+	mock.Mock
+}
+
+type MockCycleUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCycleUseCase) EXPECT() *MockCycleUseCase_Expecter {
+	return &MockCycleUseCase_Expecter{mock: &_m.Mock}
+}
+
+// GetBakers provides a mock function for the type MockCycleUseCase
+func (_mock *MockCycleUseCase) GetBakers(ctx context.Context, cycle int64, opts domain.ResponseOptions) (domain.ApiResponse[domain.CycleBakerResponseType], error) {
+	ret := _mock.Called(ctx, cycle, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBakers")
+	}
+
+	var r0 domain.ApiResponse[domain.CycleBakerResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.ResponseOptions) (domain.ApiResponse[domain.CycleBakerResponseType], error)); ok {
+		return returnFunc(ctx, cycle, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, domain.ResponseOptions) domain.ApiResponse[domain.CycleBakerResponseType]); ok {
+		r0 = returnFunc(ctx, cycle, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.CycleBakerResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, cycle, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCycleUseCase_GetBakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBakers'
+type MockCycleUseCase_GetBakers_Call struct {
+	*mock.Call
+}
+
+// GetBakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cycle int64
+//   - opts domain.ResponseOptions
+func (_e *MockCycleUseCase_Expecter) GetBakers(ctx interface{}, cycle interface{}, opts interface{}) *MockCycleUseCase_GetBakers_Call {
+	return &MockCycleUseCase_GetBakers_Call{Call: _e.mock.On("GetBakers", ctx, cycle, opts)}
+}
+
+func (_c *MockCycleUseCase_GetBakers_Call) Run(run func(ctx context.Context, cycle int64, opts domain.ResponseOptions)) *MockCycleUseCase_GetBakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleUseCase_GetBakers_Call) Return(apiResponse domain.ApiResponse[domain.CycleBakerResponseType], err error) *MockCycleUseCase_GetBakers_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockCycleUseCase_GetBakers_Call) RunAndReturn(run func(ctx context.Context, cycle int64, opts domain.ResponseOptions) (domain.ApiResponse[domain.CycleBakerResponseType], error)) *MockCycleUseCase_GetBakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sync provides a mock function for the type MockCycleUseCase
+func (_mock *MockCycleUseCase) Sync(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockCycleUseCase_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
+type MockCycleUseCase_Sync_Call struct {
+	*mock.Call
+}
+
+// Sync is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCycleUseCase_Expecter) Sync(ctx interface{}) *MockCycleUseCase_Sync_Call {
+	return &MockCycleUseCase_Sync_Call{Call: _e.mock.On("Sync", ctx)}
+}
+
+func (_c *MockCycleUseCase_Sync_Call) Run(run func(ctx context.Context)) *MockCycleUseCase_Sync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCycleUseCase_Sync_Call) Return(err error) *MockCycleUseCase_Sync_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockCycleUseCase_Sync_Call) RunAndReturn(run func(ctx context.Context) error) *MockCycleUseCase_Sync_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,143 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockDelegationBroker creates a new instance of MockDelegationBroker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockDelegationBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockDelegationBroker {
+	mock := &MockDelegationBroker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockDelegationBroker is an autogenerated mock type for the DelegationBroker type
+type MockDelegationBroker struct {
+	mock.Mock
+}
+
+type MockDelegationBroker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockDelegationBroker) EXPECT() *MockDelegationBroker_Expecter {
+	return &MockDelegationBroker_Expecter{mock: &_m.Mock}
+}
+
+// Publish provides a mock function for the type MockDelegationBroker
+func (_mock *MockDelegationBroker) Publish(delegations []models.Delegation) {
+	_mock.Called(delegations)
+	return
+}
+
+// MockDelegationBroker_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockDelegationBroker_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - delegations []models.Delegation
+func (_e *MockDelegationBroker_Expecter) Publish(delegations interface{}) *MockDelegationBroker_Publish_Call {
+	return &MockDelegationBroker_Publish_Call{Call: _e.mock.On("Publish", delegations)}
+}
+
+func (_c *MockDelegationBroker_Publish_Call) Run(run func(delegations []models.Delegation)) *MockDelegationBroker_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []models.Delegation
+		if args[0] != nil {
+			arg0 = args[0].([]models.Delegation)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelegationBroker_Publish_Call) Return() *MockDelegationBroker_Publish_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockDelegationBroker_Publish_Call) RunAndReturn(run func(delegations []models.Delegation)) *MockDelegationBroker_Publish_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Subscribe provides a mock function for the type MockDelegationBroker
+func (_mock *MockDelegationBroker) Subscribe(filter domain.DelegationFilter) (<-chan models.Delegation, func()) {
+	ret := _mock.Called(filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 <-chan models.Delegation
+	var r1 func()
+	if returnFunc, ok := ret.Get(0).(func(domain.DelegationFilter) (<-chan models.Delegation, func())); ok {
+		return returnFunc(filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(domain.DelegationFilter) <-chan models.Delegation); ok {
+		r0 = returnFunc(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(domain.DelegationFilter) func()); ok {
+		r1 = returnFunc(filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+	return r0, r1
+}
+
+// MockDelegationBroker_Subscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Subscribe'
+type MockDelegationBroker_Subscribe_Call struct {
+	*mock.Call
+}
+
+// Subscribe is a helper method to define mock.On call
+//   - filter domain.DelegationFilter
+func (_e *MockDelegationBroker_Expecter) Subscribe(filter interface{}) *MockDelegationBroker_Subscribe_Call {
+	return &MockDelegationBroker_Subscribe_Call{Call: _e.mock.On("Subscribe", filter)}
+}
+
+func (_c *MockDelegationBroker_Subscribe_Call) Run(run func(filter domain.DelegationFilter)) *MockDelegationBroker_Subscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 domain.DelegationFilter
+		if args[0] != nil {
+			arg0 = args[0].(domain.DelegationFilter)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelegationBroker_Subscribe_Call) Return(delegationCh <-chan models.Delegation, fn func()) *MockDelegationBroker_Subscribe_Call {
+	_c.Call.Return(delegationCh, fn)
+	return _c
+}
+
+func (_c *MockDelegationBroker_Subscribe_Call) RunAndReturn(run func(filter domain.DelegationFilter) (<-chan models.Delegation, func())) *MockDelegationBroker_Subscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"context"
 	"delegator/pkg/domain"
 
 	mock "github.com/stretchr/testify/mock"
@@ -38,8 +39,8 @@ func (_m *MockDelegationService) EXPECT() *MockDelegationService_Expecter {
 }
 
 // GetDelegations provides a mock function for the type MockDelegationService
-func (_mock *MockDelegationService) GetDelegations() ([]domain.TzktApiDelegationsResponse, error) {
-	ret := _mock.Called()
+func (_mock *MockDelegationService) GetDelegations(ctx context.Context) ([]domain.TzktApiDelegationsResponse, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDelegations")
@@ -47,18 +48,18 @@ func (_mock *MockDelegationService) GetDelegations() ([]domain.TzktApiDelegation
 
 	var r0 []domain.TzktApiDelegationsResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]domain.TzktApiDelegationsResponse, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.TzktApiDelegationsResponse, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []domain.TzktApiDelegationsResponse); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.TzktApiDelegationsResponse); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.TzktApiDelegationsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,13 +72,20 @@ type MockDelegationService_GetDelegations_Call struct {
 }
 
 // GetDelegations is a helper method to define mock.On call
-func (_e *MockDelegationService_Expecter) GetDelegations() *MockDelegationService_GetDelegations_Call {
-	return &MockDelegationService_GetDelegations_Call{Call: _e.mock.On("GetDelegations")}
+//   - ctx context.Context
+func (_e *MockDelegationService_Expecter) GetDelegations(ctx interface{}) *MockDelegationService_GetDelegations_Call {
+	return &MockDelegationService_GetDelegations_Call{Call: _e.mock.On("GetDelegations", ctx)}
 }
 
-func (_c *MockDelegationService_GetDelegations_Call) Run(run func()) *MockDelegationService_GetDelegations_Call {
+func (_c *MockDelegationService_GetDelegations_Call) Run(run func(ctx context.Context)) *MockDelegationService_GetDelegations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -87,14 +95,14 @@ func (_c *MockDelegationService_GetDelegations_Call) Return(tzktApiDelegationsRe
 	return _c
 }
 
-func (_c *MockDelegationService_GetDelegations_Call) RunAndReturn(run func() ([]domain.TzktApiDelegationsResponse, error)) *MockDelegationService_GetDelegations_Call {
+func (_c *MockDelegationService_GetDelegations_Call) RunAndReturn(run func(ctx context.Context) ([]domain.TzktApiDelegationsResponse, error)) *MockDelegationService_GetDelegations_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // GetDelegationsFromLevel provides a mock function for the type MockDelegationService
-func (_mock *MockDelegationService) GetDelegationsFromLevel(lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error) {
-	ret := _mock.Called(lastLevel, limit)
+func (_mock *MockDelegationService) GetDelegationsFromLevel(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error) {
+	ret := _mock.Called(ctx, lastLevel, limit)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDelegationsFromLevel")
@@ -102,18 +110,18 @@ func (_mock *MockDelegationService) GetDelegationsFromLevel(lastLevel int64, lim
 
 	var r0 []domain.TzktApiDelegationsResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(int64, int) ([]domain.TzktApiDelegationsResponse, error)); ok {
-		return returnFunc(lastLevel, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) ([]domain.TzktApiDelegationsResponse, error)); ok {
+		return returnFunc(ctx, lastLevel, limit)
 	}
-	if returnFunc, ok := ret.Get(0).(func(int64, int) []domain.TzktApiDelegationsResponse); ok {
-		r0 = returnFunc(lastLevel, limit)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) []domain.TzktApiDelegationsResponse); ok {
+		r0 = returnFunc(ctx, lastLevel, limit)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]domain.TzktApiDelegationsResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(int64, int) error); ok {
-		r1 = returnFunc(lastLevel, limit)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, lastLevel, limit)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -126,25 +134,31 @@ type MockDelegationService_GetDelegationsFromLevel_Call struct {
 }
 
 // GetDelegationsFromLevel is a helper method to define mock.On call
+//   - ctx context.Context
 //   - lastLevel int64
 //   - limit int
-func (_e *MockDelegationService_Expecter) GetDelegationsFromLevel(lastLevel interface{}, limit interface{}) *MockDelegationService_GetDelegationsFromLevel_Call {
-	return &MockDelegationService_GetDelegationsFromLevel_Call{Call: _e.mock.On("GetDelegationsFromLevel", lastLevel, limit)}
+func (_e *MockDelegationService_Expecter) GetDelegationsFromLevel(ctx interface{}, lastLevel interface{}, limit interface{}) *MockDelegationService_GetDelegationsFromLevel_Call {
+	return &MockDelegationService_GetDelegationsFromLevel_Call{Call: _e.mock.On("GetDelegationsFromLevel", ctx, lastLevel, limit)}
 }
 
-func (_c *MockDelegationService_GetDelegationsFromLevel_Call) Run(run func(lastLevel int64, limit int)) *MockDelegationService_GetDelegationsFromLevel_Call {
+func (_c *MockDelegationService_GetDelegationsFromLevel_Call) Run(run func(ctx context.Context, lastLevel int64, limit int)) *MockDelegationService_GetDelegationsFromLevel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 int64
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(int64)
+			arg0 = args[0].(context.Context)
 		}
-		var arg1 int
+		var arg1 int64
 		if args[1] != nil {
-			arg1 = args[1].(int)
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -155,7 +169,81 @@ func (_c *MockDelegationService_GetDelegationsFromLevel_Call) Return(tzktApiDele
 	return _c
 }
 
-func (_c *MockDelegationService_GetDelegationsFromLevel_Call) RunAndReturn(run func(lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error)) *MockDelegationService_GetDelegationsFromLevel_Call {
+func (_c *MockDelegationService_GetDelegationsFromLevel_Call) RunAndReturn(run func(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiDelegationsResponse, error)) *MockDelegationService_GetDelegationsFromLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStakingFromLevel provides a mock function for the type MockDelegationService
+func (_mock *MockDelegationService) GetStakingFromLevel(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiStakingResponse, error) {
+	ret := _mock.Called(ctx, lastLevel, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStakingFromLevel")
+	}
+
+	var r0 []domain.TzktApiStakingResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) ([]domain.TzktApiStakingResponse, error)); ok {
+		return returnFunc(ctx, lastLevel, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64, int) []domain.TzktApiStakingResponse); ok {
+		r0 = returnFunc(ctx, lastLevel, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TzktApiStakingResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = returnFunc(ctx, lastLevel, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDelegationService_GetStakingFromLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStakingFromLevel'
+type MockDelegationService_GetStakingFromLevel_Call struct {
+	*mock.Call
+}
+
+// GetStakingFromLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - lastLevel int64
+//   - limit int
+func (_e *MockDelegationService_Expecter) GetStakingFromLevel(ctx interface{}, lastLevel interface{}, limit interface{}) *MockDelegationService_GetStakingFromLevel_Call {
+	return &MockDelegationService_GetStakingFromLevel_Call{Call: _e.mock.On("GetStakingFromLevel", ctx, lastLevel, limit)}
+}
+
+func (_c *MockDelegationService_GetStakingFromLevel_Call) Run(run func(ctx context.Context, lastLevel int64, limit int)) *MockDelegationService_GetStakingFromLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDelegationService_GetStakingFromLevel_Call) Return(tzktApiStakingResponses []domain.TzktApiStakingResponse, err error) *MockDelegationService_GetStakingFromLevel_Call {
+	_c.Call.Return(tzktApiStakingResponses, err)
+	return _c
+}
+
+func (_c *MockDelegationService_GetStakingFromLevel_Call) RunAndReturn(run func(ctx context.Context, lastLevel int64, limit int) ([]domain.TzktApiStakingResponse, error)) *MockDelegationService_GetStakingFromLevel_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,140 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockEventSink creates a new instance of MockEventSink. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockEventSink(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockEventSink {
+	mock := &MockEventSink{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockEventSink is an autogenerated mock type for the EventSink type
+type MockEventSink struct {
+	mock.Mock
+}
+
+type MockEventSink_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockEventSink) EXPECT() *MockEventSink_Expecter {
+	return &MockEventSink_Expecter{mock: &_m.Mock}
+}
+
+// Close provides a mock function for the type MockEventSink
+func (_mock *MockEventSink) Close() error {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func() error); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventSink_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type MockEventSink_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *MockEventSink_Expecter) Close() *MockEventSink_Close_Call {
+	return &MockEventSink_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *MockEventSink_Close_Call) Run(run func()) *MockEventSink_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockEventSink_Close_Call) Return(err error) *MockEventSink_Close_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventSink_Close_Call) RunAndReturn(run func() error) *MockEventSink_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function for the type MockEventSink
+func (_mock *MockEventSink) Publish(ctx context.Context, messages []domain.OutboxMessage) error {
+	ret := _mock.Called(ctx, messages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.OutboxMessage) error); ok {
+		r0 = returnFunc(ctx, messages)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEventSink_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type MockEventSink_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - messages []domain.OutboxMessage
+func (_e *MockEventSink_Expecter) Publish(ctx interface{}, messages interface{}) *MockEventSink_Publish_Call {
+	return &MockEventSink_Publish_Call{Call: _e.mock.On("Publish", ctx, messages)}
+}
+
+func (_c *MockEventSink_Publish_Call) Run(run func(ctx context.Context, messages []domain.OutboxMessage)) *MockEventSink_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.OutboxMessage
+		if args[1] != nil {
+			arg1 = args[1].([]domain.OutboxMessage)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEventSink_Publish_Call) Return(err error) *MockEventSink_Publish_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEventSink_Publish_Call) RunAndReturn(run func(ctx context.Context, messages []domain.OutboxMessage) error) *MockEventSink_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
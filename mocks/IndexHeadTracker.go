@@ -0,0 +1,145 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockIndexHeadTracker creates a new instance of MockIndexHeadTracker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIndexHeadTracker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIndexHeadTracker {
+	mock := &MockIndexHeadTracker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIndexHeadTracker is an autogenerated mock type for the IndexHeadTracker type
+type MockIndexHeadTracker struct {
+	mock.Mock
+}
+
+type MockIndexHeadTracker_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIndexHeadTracker) EXPECT() *MockIndexHeadTracker_Expecter {
+	return &MockIndexHeadTracker_Expecter{mock: &_m.Mock}
+}
+
+// Advance provides a mock function for the type MockIndexHeadTracker
+func (_mock *MockIndexHeadTracker) Advance(ctx context.Context, head domain.IndexHead) {
+	_mock.Called(ctx, head)
+	return
+}
+
+// MockIndexHeadTracker_Advance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Advance'
+type MockIndexHeadTracker_Advance_Call struct {
+	*mock.Call
+}
+
+// Advance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - head domain.IndexHead
+func (_e *MockIndexHeadTracker_Expecter) Advance(ctx interface{}, head interface{}) *MockIndexHeadTracker_Advance_Call {
+	return &MockIndexHeadTracker_Advance_Call{Call: _e.mock.On("Advance", ctx, head)}
+}
+
+func (_c *MockIndexHeadTracker_Advance_Call) Run(run func(ctx context.Context, head domain.IndexHead)) *MockIndexHeadTracker_Advance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.IndexHead
+		if args[1] != nil {
+			arg1 = args[1].(domain.IndexHead)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIndexHeadTracker_Advance_Call) Return() *MockIndexHeadTracker_Advance_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIndexHeadTracker_Advance_Call) RunAndReturn(run func(ctx context.Context, head domain.IndexHead)) *MockIndexHeadTracker_Advance_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Current provides a mock function for the type MockIndexHeadTracker
+func (_mock *MockIndexHeadTracker) Current(ctx context.Context) (domain.IndexHead, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Current")
+	}
+
+	var r0 domain.IndexHead
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.IndexHead, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.IndexHead); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.IndexHead)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIndexHeadTracker_Current_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Current'
+type MockIndexHeadTracker_Current_Call struct {
+	*mock.Call
+}
+
+// Current is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIndexHeadTracker_Expecter) Current(ctx interface{}) *MockIndexHeadTracker_Current_Call {
+	return &MockIndexHeadTracker_Current_Call{Call: _e.mock.On("Current", ctx)}
+}
+
+func (_c *MockIndexHeadTracker_Current_Call) Run(run func(ctx context.Context)) *MockIndexHeadTracker_Current_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIndexHeadTracker_Current_Call) Return(indexHead domain.IndexHead, err error) *MockIndexHeadTracker_Current_Call {
+	_c.Call.Return(indexHead, err)
+	return _c
+}
+
+func (_c *MockIndexHeadTracker_Current_Call) RunAndReturn(run func(ctx context.Context) (domain.IndexHead, error)) *MockIndexHeadTracker_Current_Call {
+	_c.Call.Return(run)
+	return _c
+}
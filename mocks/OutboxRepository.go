@@ -0,0 +1,171 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockOutboxRepository creates a new instance of MockOutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockOutboxRepository {
+	mock := &MockOutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockOutboxRepository is an autogenerated mock type for the OutboxRepository type
+type MockOutboxRepository struct {
+	mock.Mock
+}
+
+type MockOutboxRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockOutboxRepository) EXPECT() *MockOutboxRepository_Expecter {
+	return &MockOutboxRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindPending provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) FindPending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPending")
+	}
+
+	var r0 []models.OutboxEvent
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]models.OutboxEvent, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []models.OutboxEvent); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OutboxEvent)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockOutboxRepository_FindPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPending'
+type MockOutboxRepository_FindPending_Call struct {
+	*mock.Call
+}
+
+// FindPending is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockOutboxRepository_Expecter) FindPending(ctx interface{}, limit interface{}) *MockOutboxRepository_FindPending_Call {
+	return &MockOutboxRepository_FindPending_Call{Call: _e.mock.On("FindPending", ctx, limit)}
+}
+
+func (_c *MockOutboxRepository_FindPending_Call) Run(run func(ctx context.Context, limit int)) *MockOutboxRepository_FindPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindPending_Call) Return(outboxEvents []models.OutboxEvent, err error) *MockOutboxRepository_FindPending_Call {
+	_c.Call.Return(outboxEvents, err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_FindPending_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]models.OutboxEvent, error)) *MockOutboxRepository_FindPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPublished provides a mock function for the type MockOutboxRepository
+func (_mock *MockOutboxRepository) MarkPublished(ctx context.Context, ids []int64, publishedAt time.Time) error {
+	ret := _mock.Called(ctx, ids, publishedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPublished")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []int64, time.Time) error); ok {
+		r0 = returnFunc(ctx, ids, publishedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockOutboxRepository_MarkPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPublished'
+type MockOutboxRepository_MarkPublished_Call struct {
+	*mock.Call
+}
+
+// MarkPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+//   - publishedAt time.Time
+func (_e *MockOutboxRepository_Expecter) MarkPublished(ctx interface{}, ids interface{}, publishedAt interface{}) *MockOutboxRepository_MarkPublished_Call {
+	return &MockOutboxRepository_MarkPublished_Call{Call: _e.mock.On("MarkPublished", ctx, ids, publishedAt)}
+}
+
+func (_c *MockOutboxRepository_MarkPublished_Call) Run(run func(ctx context.Context, ids []int64, publishedAt time.Time)) *MockOutboxRepository_MarkPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []int64
+		if args[1] != nil {
+			arg1 = args[1].([]int64)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkPublished_Call) Return(err error) *MockOutboxRepository_MarkPublished_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockOutboxRepository_MarkPublished_Call) RunAndReturn(run func(ctx context.Context, ids []int64, publishedAt time.Time) error) *MockOutboxRepository_MarkPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,101 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockProtocolService creates a new instance of MockProtocolService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockProtocolService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockProtocolService {
+	mock := &MockProtocolService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockProtocolService is an autogenerated mock type for the ProtocolService type
+type MockProtocolService struct {
+	mock.Mock
+}
+
+type MockProtocolService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockProtocolService) EXPECT() *MockProtocolService_Expecter {
+	return &MockProtocolService_Expecter{mock: &_m.Mock}
+}
+
+// GetProtocols provides a mock function for the type MockProtocolService
+func (_mock *MockProtocolService) GetProtocols(ctx context.Context) ([]domain.TzktApiProtocolResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProtocols")
+	}
+
+	var r0 []domain.TzktApiProtocolResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.TzktApiProtocolResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.TzktApiProtocolResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TzktApiProtocolResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProtocolService_GetProtocols_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProtocols'
+type MockProtocolService_GetProtocols_Call struct {
+	*mock.Call
+}
+
+// GetProtocols is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProtocolService_Expecter) GetProtocols(ctx interface{}) *MockProtocolService_GetProtocols_Call {
+	return &MockProtocolService_GetProtocols_Call{Call: _e.mock.On("GetProtocols", ctx)}
+}
+
+func (_c *MockProtocolService_GetProtocols_Call) Run(run func(ctx context.Context)) *MockProtocolService_GetProtocols_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProtocolService_GetProtocols_Call) Return(tzktApiProtocolResponses []domain.TzktApiProtocolResponse, err error) *MockProtocolService_GetProtocols_Call {
+	_c.Call.Return(tzktApiProtocolResponses, err)
+	return _c
+}
+
+func (_c *MockProtocolService_GetProtocols_Call) RunAndReturn(run func(ctx context.Context) ([]domain.TzktApiProtocolResponse, error)) *MockProtocolService_GetProtocols_Call {
+	_c.Call.Return(run)
+	return _c
+}
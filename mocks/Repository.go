@@ -100,16 +100,16 @@ func (_c *MockRepository_CountDelegations_Call) RunAndReturn(run func(ctx contex
 }
 
 // Create provides a mock function for the type MockRepository
-func (_mock *MockRepository) Create(ctx context.Context, delegationToCreate []domain.CreateDelegationDTO) error {
-	ret := _mock.Called(ctx, delegationToCreate)
+func (_mock *MockRepository) Create(ctx context.Context, batch domain.DelegationBatch) error {
+	ret := _mock.Called(ctx, batch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.CreateDelegationDTO) error); ok {
-		r0 = returnFunc(ctx, delegationToCreate)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationBatch) error); ok {
+		r0 = returnFunc(ctx, batch)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -123,20 +123,20 @@ type MockRepository_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - ctx context.Context
-//   - delegationToCreate []domain.CreateDelegationDTO
-func (_e *MockRepository_Expecter) Create(ctx interface{}, delegationToCreate interface{}) *MockRepository_Create_Call {
-	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, delegationToCreate)}
+//   - batch domain.DelegationBatch
+func (_e *MockRepository_Expecter) Create(ctx interface{}, batch interface{}) *MockRepository_Create_Call {
+	return &MockRepository_Create_Call{Call: _e.mock.On("Create", ctx, batch)}
 }
 
-func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, delegationToCreate []domain.CreateDelegationDTO)) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) Run(run func(ctx context.Context, batch domain.DelegationBatch)) *MockRepository_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 []domain.CreateDelegationDTO
+		var arg1 domain.DelegationBatch
 		if args[1] != nil {
-			arg1 = args[1].([]domain.CreateDelegationDTO)
+			arg1 = args[1].(domain.DelegationBatch)
 		}
 		run(
 			arg0,
@@ -151,14 +151,14 @@ func (_c *MockRepository_Create_Call) Return(err error) *MockRepository_Create_C
 	return _c
 }
 
-func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, delegationToCreate []domain.CreateDelegationDTO) error) *MockRepository_Create_Call {
+func (_c *MockRepository_Create_Call) RunAndReturn(run func(ctx context.Context, batch domain.DelegationBatch) error) *MockRepository_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // FindAll provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindAll(ctx context.Context) ([]models.Delegation, error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockRepository) FindAll(ctx context.Context, filter domain.DelegationFilter) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FindAll")
@@ -166,18 +166,18 @@ func (_mock *MockRepository) FindAll(ctx context.Context) ([]models.Delegation,
 
 	var r0 []models.Delegation
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.Delegation, error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, filter)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.Delegation); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter) []models.Delegation); ok {
+		r0 = returnFunc(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Delegation)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -191,18 +191,24 @@ type MockRepository_FindAll_Call struct {
 
 // FindAll is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockRepository_Expecter) FindAll(ctx interface{}) *MockRepository_FindAll_Call {
-	return &MockRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx)}
+//   - filter domain.DelegationFilter
+func (_e *MockRepository_Expecter) FindAll(ctx interface{}, filter interface{}) *MockRepository_FindAll_Call {
+	return &MockRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filter)}
 }
 
-func (_c *MockRepository_FindAll_Call) Run(run func(ctx context.Context)) *MockRepository_FindAll_Call {
+func (_c *MockRepository_FindAll_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter)) *MockRepository_FindAll_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -213,49 +219,60 @@ func (_c *MockRepository_FindAll_Call) Return(delegations []models.Delegation, e
 	return _c
 }
 
-func (_c *MockRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context) ([]models.Delegation, error)) *MockRepository_FindAll_Call {
+func (_c *MockRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter) ([]models.Delegation, error)) *MockRepository_FindAll_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FindOneByID provides a mock function for the type MockRepository
-func (_mock *MockRepository) FindOneByID(ctx context.Context, id domain.ID) error {
-	ret := _mock.Called(ctx, id)
+// FindBakers provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBakers(ctx context.Context, address *domain.Address) ([]models.BakerStats, error) {
+	ret := _mock.Called(ctx, address)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FindOneByID")
+		panic("no return value specified for FindBakers")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ID) error); ok {
-		r0 = returnFunc(ctx, id)
+	var r0 []models.BakerStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Address) ([]models.BakerStats, error)); ok {
+		return returnFunc(ctx, address)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.Address) []models.BakerStats); ok {
+		r0 = returnFunc(ctx, address)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BakerStats)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *domain.Address) error); ok {
+		r1 = returnFunc(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockRepository_FindOneByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindOneByID'
-type MockRepository_FindOneByID_Call struct {
+// MockRepository_FindBakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBakers'
+type MockRepository_FindBakers_Call struct {
 	*mock.Call
 }
 
-// FindOneByID is a helper method to define mock.On call
+// FindBakers is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id domain.ID
-func (_e *MockRepository_Expecter) FindOneByID(ctx interface{}, id interface{}) *MockRepository_FindOneByID_Call {
-	return &MockRepository_FindOneByID_Call{Call: _e.mock.On("FindOneByID", ctx, id)}
+//   - address *domain.Address
+func (_e *MockRepository_Expecter) FindBakers(ctx interface{}, address interface{}) *MockRepository_FindBakers_Call {
+	return &MockRepository_FindBakers_Call{Call: _e.mock.On("FindBakers", ctx, address)}
 }
 
-func (_c *MockRepository_FindOneByID_Call) Run(run func(ctx context.Context, id domain.ID)) *MockRepository_FindOneByID_Call {
+func (_c *MockRepository_FindBakers_Call) Run(run func(ctx context.Context, address *domain.Address)) *MockRepository_FindBakers_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 domain.ID
+		var arg1 *domain.Address
 		if args[1] != nil {
-			arg1 = args[1].(domain.ID)
+			arg1 = args[1].(*domain.Address)
 		}
 		run(
 			arg0,
@@ -265,12 +282,602 @@ func (_c *MockRepository_FindOneByID_Call) Run(run func(ctx context.Context, id
 	return _c
 }
 
-func (_c *MockRepository_FindOneByID_Call) Return(err error) *MockRepository_FindOneByID_Call {
-	_c.Call.Return(err)
+func (_c *MockRepository_FindBakers_Call) Return(bakerStatss []models.BakerStats, err error) *MockRepository_FindBakers_Call {
+	_c.Call.Return(bakerStatss, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBakers_Call) RunAndReturn(run func(ctx context.Context, address *domain.Address) ([]models.BakerStats, error)) *MockRepository_FindBakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindBakersByAddress provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindBakersByAddress(ctx context.Context, addresses []domain.Address) ([]models.BakerStats, error) {
+	ret := _mock.Called(ctx, addresses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindBakersByAddress")
+	}
+
+	var r0 []models.BakerStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address) ([]models.BakerStats, error)); ok {
+		return returnFunc(ctx, addresses)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address) []models.BakerStats); ok {
+		r0 = returnFunc(ctx, addresses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BakerStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Address) error); ok {
+		r1 = returnFunc(ctx, addresses)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindBakersByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindBakersByAddress'
+type MockRepository_FindBakersByAddress_Call struct {
+	*mock.Call
+}
+
+// FindBakersByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []domain.Address
+func (_e *MockRepository_Expecter) FindBakersByAddress(ctx interface{}, addresses interface{}) *MockRepository_FindBakersByAddress_Call {
+	return &MockRepository_FindBakersByAddress_Call{Call: _e.mock.On("FindBakersByAddress", ctx, addresses)}
+}
+
+func (_c *MockRepository_FindBakersByAddress_Call) Run(run func(ctx context.Context, addresses []domain.Address)) *MockRepository_FindBakersByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Address
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Address)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindBakersByAddress_Call) Return(bakerStatss []models.BakerStats, err error) *MockRepository_FindBakersByAddress_Call {
+	_c.Call.Return(bakerStatss, err)
+	return _c
+}
+
+func (_c *MockRepository_FindBakersByAddress_Call) RunAndReturn(run func(ctx context.Context, addresses []domain.Address) ([]models.BakerStats, error)) *MockRepository_FindBakersByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindCurrentDelegations provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindCurrentDelegations(ctx context.Context, baker domain.Address, after *domain.Address, limit int) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, baker, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindCurrentDelegations")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, *domain.Address, int) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, baker, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, *domain.Address, int) []models.Delegation); ok {
+		r0 = returnFunc(ctx, baker, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address, *domain.Address, int) error); ok {
+		r1 = returnFunc(ctx, baker, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindCurrentDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindCurrentDelegations'
+type MockRepository_FindCurrentDelegations_Call struct {
+	*mock.Call
+}
+
+// FindCurrentDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baker domain.Address
+//   - after *domain.Address
+//   - limit int
+func (_e *MockRepository_Expecter) FindCurrentDelegations(ctx interface{}, baker interface{}, after interface{}, limit interface{}) *MockRepository_FindCurrentDelegations_Call {
+	return &MockRepository_FindCurrentDelegations_Call{Call: _e.mock.On("FindCurrentDelegations", ctx, baker, after, limit)}
+}
+
+func (_c *MockRepository_FindCurrentDelegations_Call) Run(run func(ctx context.Context, baker domain.Address, after *domain.Address, limit int)) *MockRepository_FindCurrentDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		var arg2 *domain.Address
+		if args[2] != nil {
+			arg2 = args[2].(*domain.Address)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindCurrentDelegations_Call) Return(delegations []models.Delegation, err error) *MockRepository_FindCurrentDelegations_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockRepository_FindCurrentDelegations_Call) RunAndReturn(run func(ctx context.Context, baker domain.Address, after *domain.Address, limit int) ([]models.Delegation, error)) *MockRepository_FindCurrentDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFailed provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindFailed(ctx context.Context, filter domain.DelegationFilter) ([]models.FailedDelegation, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFailed")
+	}
+
+	var r0 []models.FailedDelegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter) ([]models.FailedDelegation, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter) []models.FailedDelegation); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FailedDelegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFailed'
+type MockRepository_FindFailed_Call struct {
+	*mock.Call
+}
+
+// FindFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+func (_e *MockRepository_Expecter) FindFailed(ctx interface{}, filter interface{}) *MockRepository_FindFailed_Call {
+	return &MockRepository_FindFailed_Call{Call: _e.mock.On("FindFailed", ctx, filter)}
+}
+
+func (_c *MockRepository_FindFailed_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter)) *MockRepository_FindFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindFailed_Call) Return(failedDelegations []models.FailedDelegation, err error) *MockRepository_FindFailed_Call {
+	_c.Call.Return(failedDelegations, err)
 	return _c
 }
 
-func (_c *MockRepository_FindOneByID_Call) RunAndReturn(run func(ctx context.Context, id domain.ID) error) *MockRepository_FindOneByID_Call {
+func (_c *MockRepository_FindFailed_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter) ([]models.FailedDelegation, error)) *MockRepository_FindFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFailedPage provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindFailedPage(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.FailedDelegation, error) {
+	ret := _mock.Called(ctx, filter, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFailedPage")
+	}
+
+	var r0 []models.FailedDelegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) ([]models.FailedDelegation, error)); ok {
+		return returnFunc(ctx, filter, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) []models.FailedDelegation); ok {
+		r0 = returnFunc(ctx, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.FailedDelegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindFailedPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFailedPage'
+type MockRepository_FindFailedPage_Call struct {
+	*mock.Call
+}
+
+// FindFailedPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - after *domain.DelegationCursor
+//   - limit int
+func (_e *MockRepository_Expecter) FindFailedPage(ctx interface{}, filter interface{}, after interface{}, limit interface{}) *MockRepository_FindFailedPage_Call {
+	return &MockRepository_FindFailedPage_Call{Call: _e.mock.On("FindFailedPage", ctx, filter, after, limit)}
+}
+
+func (_c *MockRepository_FindFailedPage_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int)) *MockRepository_FindFailedPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 *domain.DelegationCursor
+		if args[2] != nil {
+			arg2 = args[2].(*domain.DelegationCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindFailedPage_Call) Return(failedDelegations []models.FailedDelegation, err error) *MockRepository_FindFailedPage_Call {
+	_c.Call.Return(failedDelegations, err)
+	return _c
+}
+
+func (_c *MockRepository_FindFailedPage_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.FailedDelegation, error)) *MockRepository_FindFailedPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLatest provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindLatest(ctx context.Context, delegators []domain.Address) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, delegators)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLatest")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, delegators)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address) []models.Delegation); ok {
+		r0 = returnFunc(ctx, delegators)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Address) error); ok {
+		r1 = returnFunc(ctx, delegators)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindLatest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLatest'
+type MockRepository_FindLatest_Call struct {
+	*mock.Call
+}
+
+// FindLatest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegators []domain.Address
+func (_e *MockRepository_Expecter) FindLatest(ctx interface{}, delegators interface{}) *MockRepository_FindLatest_Call {
+	return &MockRepository_FindLatest_Call{Call: _e.mock.On("FindLatest", ctx, delegators)}
+}
+
+func (_c *MockRepository_FindLatest_Call) Run(run func(ctx context.Context, delegators []domain.Address)) *MockRepository_FindLatest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Address
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Address)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindLatest_Call) Return(delegations []models.Delegation, err error) *MockRepository_FindLatest_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockRepository_FindLatest_Call) RunAndReturn(run func(ctx context.Context, delegators []domain.Address) ([]models.Delegation, error)) *MockRepository_FindLatest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPage provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindPage(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, filter, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPage")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, filter, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) []models.Delegation); ok {
+		r0 = returnFunc(ctx, filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter, *domain.DelegationCursor, int) error); ok {
+		r1 = returnFunc(ctx, filter, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPage'
+type MockRepository_FindPage_Call struct {
+	*mock.Call
+}
+
+// FindPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - after *domain.DelegationCursor
+//   - limit int
+func (_e *MockRepository_Expecter) FindPage(ctx interface{}, filter interface{}, after interface{}, limit interface{}) *MockRepository_FindPage_Call {
+	return &MockRepository_FindPage_Call{Call: _e.mock.On("FindPage", ctx, filter, after, limit)}
+}
+
+func (_c *MockRepository_FindPage_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int)) *MockRepository_FindPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 *domain.DelegationCursor
+		if args[2] != nil {
+			arg2 = args[2].(*domain.DelegationCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPage_Call) Return(delegations []models.Delegation, err error) *MockRepository_FindPage_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockRepository_FindPage_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, after *domain.DelegationCursor, limit int) ([]models.Delegation, error)) *MockRepository_FindPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPages provides a mock function for the type MockRepository
+func (_mock *MockRepository) FindPages(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, after *domain.DelegationCursor, limit int) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, group, addresses, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPages")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationGroup, []domain.Address, *domain.DelegationCursor, int) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, group, addresses, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationGroup, []domain.Address, *domain.DelegationCursor, int) []models.Delegation); ok {
+		r0 = returnFunc(ctx, group, addresses, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationGroup, []domain.Address, *domain.DelegationCursor, int) error); ok {
+		r1 = returnFunc(ctx, group, addresses, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_FindPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPages'
+type MockRepository_FindPages_Call struct {
+	*mock.Call
+}
+
+// FindPages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group domain.DelegationGroup
+//   - addresses []domain.Address
+//   - after *domain.DelegationCursor
+//   - limit int
+func (_e *MockRepository_Expecter) FindPages(ctx interface{}, group interface{}, addresses interface{}, after interface{}, limit interface{}) *MockRepository_FindPages_Call {
+	return &MockRepository_FindPages_Call{Call: _e.mock.On("FindPages", ctx, group, addresses, after, limit)}
+}
+
+func (_c *MockRepository_FindPages_Call) Run(run func(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, after *domain.DelegationCursor, limit int)) *MockRepository_FindPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationGroup
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationGroup)
+		}
+		var arg2 []domain.Address
+		if args[2] != nil {
+			arg2 = args[2].([]domain.Address)
+		}
+		var arg3 *domain.DelegationCursor
+		if args[3] != nil {
+			arg3 = args[3].(*domain.DelegationCursor)
+		}
+		var arg4 int
+		if args[4] != nil {
+			arg4 = args[4].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_FindPages_Call) Return(delegations []models.Delegation, err error) *MockRepository_FindPages_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockRepository_FindPages_Call) RunAndReturn(run func(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, after *domain.DelegationCursor, limit int) ([]models.Delegation, error)) *MockRepository_FindPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexHead provides a mock function for the type MockRepository
+func (_mock *MockRepository) GetIndexHead(ctx context.Context) (domain.IndexHead, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIndexHead")
+	}
+
+	var r0 domain.IndexHead
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.IndexHead, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.IndexHead); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.IndexHead)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockRepository_GetIndexHead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexHead'
+type MockRepository_GetIndexHead_Call struct {
+	*mock.Call
+}
+
+// GetIndexHead is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockRepository_Expecter) GetIndexHead(ctx interface{}) *MockRepository_GetIndexHead_Call {
+	return &MockRepository_GetIndexHead_Call{Call: _e.mock.On("GetIndexHead", ctx)}
+}
+
+func (_c *MockRepository_GetIndexHead_Call) Run(run func(ctx context.Context)) *MockRepository_GetIndexHead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_GetIndexHead_Call) Return(indexHead domain.IndexHead, err error) *MockRepository_GetIndexHead_Call {
+	_c.Call.Return(indexHead, err)
+	return _c
+}
+
+func (_c *MockRepository_GetIndexHead_Call) RunAndReturn(run func(ctx context.Context) (domain.IndexHead, error)) *MockRepository_GetIndexHead_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -334,3 +941,129 @@ func (_c *MockRepository_GetLastProcessedLevel_Call) RunAndReturn(run func(ctx c
 	_c.Call.Return(run)
 	return _c
 }
+
+// StreamAll provides a mock function for the type MockRepository
+func (_mock *MockRepository) StreamAll(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAll")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, func(models.Delegation) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_StreamAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAll'
+type MockRepository_StreamAll_Call struct {
+	*mock.Call
+}
+
+// StreamAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - fn func(models.Delegation) error
+func (_e *MockRepository_Expecter) StreamAll(ctx interface{}, filter interface{}, fn interface{}) *MockRepository_StreamAll_Call {
+	return &MockRepository_StreamAll_Call{Call: _e.mock.On("StreamAll", ctx, filter, fn)}
+}
+
+func (_c *MockRepository_StreamAll_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error)) *MockRepository_StreamAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 func(models.Delegation) error
+		if args[2] != nil {
+			arg2 = args[2].(func(models.Delegation) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_StreamAll_Call) Return(err error) *MockRepository_StreamAll_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_StreamAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, fn func(models.Delegation) error) error) *MockRepository_StreamAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamFailed provides a mock function for the type MockRepository
+func (_mock *MockRepository) StreamFailed(ctx context.Context, filter domain.DelegationFilter, fn func(models.FailedDelegation) error) error {
+	ret := _mock.Called(ctx, filter, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamFailed")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, func(models.FailedDelegation) error) error); ok {
+		r0 = returnFunc(ctx, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_StreamFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamFailed'
+type MockRepository_StreamFailed_Call struct {
+	*mock.Call
+}
+
+// StreamFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - fn func(models.FailedDelegation) error
+func (_e *MockRepository_Expecter) StreamFailed(ctx interface{}, filter interface{}, fn interface{}) *MockRepository_StreamFailed_Call {
+	return &MockRepository_StreamFailed_Call{Call: _e.mock.On("StreamFailed", ctx, filter, fn)}
+}
+
+func (_c *MockRepository_StreamFailed_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, fn func(models.FailedDelegation) error)) *MockRepository_StreamFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 func(models.FailedDelegation) error
+		if args[2] != nil {
+			arg2 = args[2].(func(models.FailedDelegation) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_StreamFailed_Call) Return(err error) *MockRepository_StreamFailed_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_StreamFailed_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, fn func(models.FailedDelegation) error) error) *MockRepository_StreamFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,231 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockResponseStore creates a new instance of MockResponseStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockResponseStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockResponseStore {
+	mock := &MockResponseStore{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockResponseStore is an autogenerated mock type for the ResponseStore type
+type MockResponseStore struct {
+	mock.Mock
+}
+
+type MockResponseStore_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockResponseStore) EXPECT() *MockResponseStore_Expecter {
+	return &MockResponseStore_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockResponseStore
+func (_mock *MockResponseStore) Get(ctx context.Context, key string) (domain.CachedResponse, bool, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 domain.CachedResponse
+	var r1 bool
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.CachedResponse, bool, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.CachedResponse); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(domain.CachedResponse)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, key)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockResponseStore_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockResponseStore_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockResponseStore_Expecter) Get(ctx interface{}, key interface{}) *MockResponseStore_Get_Call {
+	return &MockResponseStore_Get_Call{Call: _e.mock.On("Get", ctx, key)}
+}
+
+func (_c *MockResponseStore_Get_Call) Run(run func(ctx context.Context, key string)) *MockResponseStore_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockResponseStore_Get_Call) Return(cachedResponse domain.CachedResponse, b bool, err error) *MockResponseStore_Get_Call {
+	_c.Call.Return(cachedResponse, b, err)
+	return _c
+}
+
+func (_c *MockResponseStore_Get_Call) RunAndReturn(run func(ctx context.Context, key string) (domain.CachedResponse, bool, error)) *MockResponseStore_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Invalidate provides a mock function for the type MockResponseStore
+func (_mock *MockResponseStore) Invalidate(ctx context.Context, level int64) error {
+	ret := _mock.Called(ctx, level)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Invalidate")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = returnFunc(ctx, level)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockResponseStore_Invalidate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Invalidate'
+type MockResponseStore_Invalidate_Call struct {
+	*mock.Call
+}
+
+// Invalidate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - level int64
+func (_e *MockResponseStore_Expecter) Invalidate(ctx interface{}, level interface{}) *MockResponseStore_Invalidate_Call {
+	return &MockResponseStore_Invalidate_Call{Call: _e.mock.On("Invalidate", ctx, level)}
+}
+
+func (_c *MockResponseStore_Invalidate_Call) Run(run func(ctx context.Context, level int64)) *MockResponseStore_Invalidate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int64
+		if args[1] != nil {
+			arg1 = args[1].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockResponseStore_Invalidate_Call) Return(err error) *MockResponseStore_Invalidate_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockResponseStore_Invalidate_Call) RunAndReturn(run func(ctx context.Context, level int64) error) *MockResponseStore_Invalidate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Set provides a mock function for the type MockResponseStore
+func (_mock *MockResponseStore) Set(ctx context.Context, key string, res domain.CachedResponse) error {
+	ret := _mock.Called(ctx, key, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Set")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.CachedResponse) error); ok {
+		r0 = returnFunc(ctx, key, res)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockResponseStore_Set_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Set'
+type MockResponseStore_Set_Call struct {
+	*mock.Call
+}
+
+// Set is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - res domain.CachedResponse
+func (_e *MockResponseStore_Expecter) Set(ctx interface{}, key interface{}, res interface{}) *MockResponseStore_Set_Call {
+	return &MockResponseStore_Set_Call{Call: _e.mock.On("Set", ctx, key, res)}
+}
+
+func (_c *MockResponseStore_Set_Call) Run(run func(ctx context.Context, key string, res domain.CachedResponse)) *MockResponseStore_Set_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.CachedResponse
+		if args[2] != nil {
+			arg2 = args[2].(domain.CachedResponse)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockResponseStore_Set_Call) Return(err error) *MockResponseStore_Set_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockResponseStore_Set_Call) RunAndReturn(run func(ctx context.Context, key string, res domain.CachedResponse) error) *MockResponseStore_Set_Call {
+	_c.Call.Return(run)
+	return _c
+}
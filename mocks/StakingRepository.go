@@ -0,0 +1,293 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStakingRepository creates a new instance of MockStakingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStakingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStakingRepository {
+	mock := &MockStakingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStakingRepository is an autogenerated mock type for the StakingRepository type
+type MockStakingRepository struct {
+	mock.Mock
+}
+
+type MockStakingRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStakingRepository) EXPECT() *MockStakingRepository_Expecter {
+	return &MockStakingRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockStakingRepository
+func (_mock *MockStakingRepository) Create(ctx context.Context, operations []models.StakingOperation) error {
+	ret := _mock.Called(ctx, operations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.StakingOperation) error); ok {
+		r0 = returnFunc(ctx, operations)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStakingRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStakingRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - operations []models.StakingOperation
+func (_e *MockStakingRepository_Expecter) Create(ctx interface{}, operations interface{}) *MockStakingRepository_Create_Call {
+	return &MockStakingRepository_Create_Call{Call: _e.mock.On("Create", ctx, operations)}
+}
+
+func (_c *MockStakingRepository_Create_Call) Run(run func(ctx context.Context, operations []models.StakingOperation)) *MockStakingRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []models.StakingOperation
+		if args[1] != nil {
+			arg1 = args[1].([]models.StakingOperation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingRepository_Create_Call) Return(err error) *MockStakingRepository_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStakingRepository_Create_Call) RunAndReturn(run func(ctx context.Context, operations []models.StakingOperation) error) *MockStakingRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAll provides a mock function for the type MockStakingRepository
+func (_mock *MockStakingRepository) FindAll(ctx context.Context, filter domain.StakingFilter) ([]models.StakingOperation, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAll")
+	}
+
+	var r0 []models.StakingOperation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter) ([]models.StakingOperation, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter) []models.StakingOperation); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StakingOperation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StakingFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStakingRepository_FindAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAll'
+type MockStakingRepository_FindAll_Call struct {
+	*mock.Call
+}
+
+// FindAll is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.StakingFilter
+func (_e *MockStakingRepository_Expecter) FindAll(ctx interface{}, filter interface{}) *MockStakingRepository_FindAll_Call {
+	return &MockStakingRepository_FindAll_Call{Call: _e.mock.On("FindAll", ctx, filter)}
+}
+
+func (_c *MockStakingRepository_FindAll_Call) Run(run func(ctx context.Context, filter domain.StakingFilter)) *MockStakingRepository_FindAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StakingFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.StakingFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingRepository_FindAll_Call) Return(stakingOperations []models.StakingOperation, err error) *MockStakingRepository_FindAll_Call {
+	_c.Call.Return(stakingOperations, err)
+	return _c
+}
+
+func (_c *MockStakingRepository_FindAll_Call) RunAndReturn(run func(ctx context.Context, filter domain.StakingFilter) ([]models.StakingOperation, error)) *MockStakingRepository_FindAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindStakers provides a mock function for the type MockStakingRepository
+func (_mock *MockStakingRepository) FindStakers(ctx context.Context, filter domain.StakingFilter) ([]models.StakerStats, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindStakers")
+	}
+
+	var r0 []models.StakerStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter) ([]models.StakerStats, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter) []models.StakerStats); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.StakerStats)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StakingFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStakingRepository_FindStakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindStakers'
+type MockStakingRepository_FindStakers_Call struct {
+	*mock.Call
+}
+
+// FindStakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.StakingFilter
+func (_e *MockStakingRepository_Expecter) FindStakers(ctx interface{}, filter interface{}) *MockStakingRepository_FindStakers_Call {
+	return &MockStakingRepository_FindStakers_Call{Call: _e.mock.On("FindStakers", ctx, filter)}
+}
+
+func (_c *MockStakingRepository_FindStakers_Call) Run(run func(ctx context.Context, filter domain.StakingFilter)) *MockStakingRepository_FindStakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StakingFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.StakingFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingRepository_FindStakers_Call) Return(stakerStatss []models.StakerStats, err error) *MockStakingRepository_FindStakers_Call {
+	_c.Call.Return(stakerStatss, err)
+	return _c
+}
+
+func (_c *MockStakingRepository_FindStakers_Call) RunAndReturn(run func(ctx context.Context, filter domain.StakingFilter) ([]models.StakerStats, error)) *MockStakingRepository_FindStakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastProcessedLevel provides a mock function for the type MockStakingRepository
+func (_mock *MockStakingRepository) GetLastProcessedLevel(ctx context.Context) (int64, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastProcessedLevel")
+	}
+
+	var r0 int64
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStakingRepository_GetLastProcessedLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastProcessedLevel'
+type MockStakingRepository_GetLastProcessedLevel_Call struct {
+	*mock.Call
+}
+
+// GetLastProcessedLevel is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockStakingRepository_Expecter) GetLastProcessedLevel(ctx interface{}) *MockStakingRepository_GetLastProcessedLevel_Call {
+	return &MockStakingRepository_GetLastProcessedLevel_Call{Call: _e.mock.On("GetLastProcessedLevel", ctx)}
+}
+
+func (_c *MockStakingRepository_GetLastProcessedLevel_Call) Run(run func(ctx context.Context)) *MockStakingRepository_GetLastProcessedLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingRepository_GetLastProcessedLevel_Call) Return(n int64, err error) *MockStakingRepository_GetLastProcessedLevel_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockStakingRepository_GetLastProcessedLevel_Call) RunAndReturn(run func(ctx context.Context) (int64, error)) *MockStakingRepository_GetLastProcessedLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,240 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStakingUseCase creates a new instance of MockStakingUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStakingUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStakingUseCase {
+	mock := &MockStakingUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStakingUseCase is an autogenerated mock type for the StakingUseCase type
+type MockStakingUseCase struct {
+	mock.Mock
+}
+
+type MockStakingUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStakingUseCase) EXPECT() *MockStakingUseCase_Expecter {
+	return &MockStakingUseCase_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type MockStakingUseCase
+func (_mock *MockStakingUseCase) Create(ctx context.Context, data []domain.TzktApiStakingResponse) error {
+	ret := _mock.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.TzktApiStakingResponse) error); ok {
+		r0 = returnFunc(ctx, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStakingUseCase_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type MockStakingUseCase_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data []domain.TzktApiStakingResponse
+func (_e *MockStakingUseCase_Expecter) Create(ctx interface{}, data interface{}) *MockStakingUseCase_Create_Call {
+	return &MockStakingUseCase_Create_Call{Call: _e.mock.On("Create", ctx, data)}
+}
+
+func (_c *MockStakingUseCase_Create_Call) Run(run func(ctx context.Context, data []domain.TzktApiStakingResponse)) *MockStakingUseCase_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.TzktApiStakingResponse
+		if args[1] != nil {
+			arg1 = args[1].([]domain.TzktApiStakingResponse)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingUseCase_Create_Call) Return(err error) *MockStakingUseCase_Create_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStakingUseCase_Create_Call) RunAndReturn(run func(ctx context.Context, data []domain.TzktApiStakingResponse) error) *MockStakingUseCase_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStakers provides a mock function for the type MockStakingUseCase
+func (_mock *MockStakingUseCase) GetStakers(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakerResponseType], error) {
+	ret := _mock.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStakers")
+	}
+
+	var r0 domain.ApiResponse[domain.StakerResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) (domain.ApiResponse[domain.StakerResponseType], error)); ok {
+		return returnFunc(ctx, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) domain.ApiResponse[domain.StakerResponseType]); ok {
+		r0 = returnFunc(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.StakerResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStakingUseCase_GetStakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStakers'
+type MockStakingUseCase_GetStakers_Call struct {
+	*mock.Call
+}
+
+// GetStakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.StakingFilter
+//   - opts domain.ResponseOptions
+func (_e *MockStakingUseCase_Expecter) GetStakers(ctx interface{}, filter interface{}, opts interface{}) *MockStakingUseCase_GetStakers_Call {
+	return &MockStakingUseCase_GetStakers_Call{Call: _e.mock.On("GetStakers", ctx, filter, opts)}
+}
+
+func (_c *MockStakingUseCase_GetStakers_Call) Run(run func(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions)) *MockStakingUseCase_GetStakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StakingFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.StakingFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingUseCase_GetStakers_Call) Return(apiResponse domain.ApiResponse[domain.StakerResponseType], err error) *MockStakingUseCase_GetStakers_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockStakingUseCase_GetStakers_Call) RunAndReturn(run func(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakerResponseType], error)) *MockStakingUseCase_GetStakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaking provides a mock function for the type MockStakingUseCase
+func (_mock *MockStakingUseCase) GetStaking(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakingResponseType], error) {
+	ret := _mock.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaking")
+	}
+
+	var r0 domain.ApiResponse[domain.StakingResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) (domain.ApiResponse[domain.StakingResponseType], error)); ok {
+		return returnFunc(ctx, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) domain.ApiResponse[domain.StakingResponseType]); ok {
+		r0 = returnFunc(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.StakingResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.StakingFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStakingUseCase_GetStaking_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaking'
+type MockStakingUseCase_GetStaking_Call struct {
+	*mock.Call
+}
+
+// GetStaking is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.StakingFilter
+//   - opts domain.ResponseOptions
+func (_e *MockStakingUseCase_Expecter) GetStaking(ctx interface{}, filter interface{}, opts interface{}) *MockStakingUseCase_GetStaking_Call {
+	return &MockStakingUseCase_GetStaking_Call{Call: _e.mock.On("GetStaking", ctx, filter, opts)}
+}
+
+func (_c *MockStakingUseCase_GetStaking_Call) Run(run func(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions)) *MockStakingUseCase_GetStaking_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.StakingFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.StakingFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStakingUseCase_GetStaking_Call) Return(apiResponse domain.ApiResponse[domain.StakingResponseType], err error) *MockStakingUseCase_GetStaking_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockStakingUseCase_GetStaking_Call) RunAndReturn(run func(ctx context.Context, filter domain.StakingFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.StakingResponseType], error)) *MockStakingUseCase_GetStaking_Call {
+	_c.Call.Return(run)
+	return _c
+}
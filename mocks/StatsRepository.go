@@ -0,0 +1,505 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStatsRepository creates a new instance of MockStatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatsRepository {
+	mock := &MockStatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStatsRepository is an autogenerated mock type for the StatsRepository type
+type MockStatsRepository struct {
+	mock.Mock
+}
+
+type MockStatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatsRepository) EXPECT() *MockStatsRepository_Expecter {
+	return &MockStatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// FindConcentration provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindConcentration(ctx context.Context, limit int) ([]models.BakerConcentration, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindConcentration")
+	}
+
+	var r0 []models.BakerConcentration
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]models.BakerConcentration, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []models.BakerConcentration); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BakerConcentration)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindConcentration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindConcentration'
+type MockStatsRepository_FindConcentration_Call struct {
+	*mock.Call
+}
+
+// FindConcentration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockStatsRepository_Expecter) FindConcentration(ctx interface{}, limit interface{}) *MockStatsRepository_FindConcentration_Call {
+	return &MockStatsRepository_FindConcentration_Call{Call: _e.mock.On("FindConcentration", ctx, limit)}
+}
+
+func (_c *MockStatsRepository_FindConcentration_Call) Run(run func(ctx context.Context, limit int)) *MockStatsRepository_FindConcentration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindConcentration_Call) Return(bakerConcentrations []models.BakerConcentration, err error) *MockStatsRepository_FindConcentration_Call {
+	_c.Call.Return(bakerConcentrations, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindConcentration_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]models.BakerConcentration, error)) *MockStatsRepository_FindConcentration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindFlows provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindFlows(ctx context.Context, filter domain.PeriodFilter) ([]models.BakerFlow, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindFlows")
+	}
+
+	var r0 []models.BakerFlow
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PeriodFilter) ([]models.BakerFlow, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PeriodFilter) []models.BakerFlow); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BakerFlow)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PeriodFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindFlows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindFlows'
+type MockStatsRepository_FindFlows_Call struct {
+	*mock.Call
+}
+
+// FindFlows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.PeriodFilter
+func (_e *MockStatsRepository_Expecter) FindFlows(ctx interface{}, filter interface{}) *MockStatsRepository_FindFlows_Call {
+	return &MockStatsRepository_FindFlows_Call{Call: _e.mock.On("FindFlows", ctx, filter)}
+}
+
+func (_c *MockStatsRepository_FindFlows_Call) Run(run func(ctx context.Context, filter domain.PeriodFilter)) *MockStatsRepository_FindFlows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PeriodFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.PeriodFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindFlows_Call) Return(bakerFlows []models.BakerFlow, err error) *MockStatsRepository_FindFlows_Call {
+	_c.Call.Return(bakerFlows, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindFlows_Call) RunAndReturn(run func(ctx context.Context, filter domain.PeriodFilter) ([]models.BakerFlow, error)) *MockStatsRepository_FindFlows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindLargestDelegations provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindLargestDelegations(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindLargestDelegations")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter) []models.Delegation); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WhaleFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindLargestDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindLargestDelegations'
+type MockStatsRepository_FindLargestDelegations_Call struct {
+	*mock.Call
+}
+
+// FindLargestDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.WhaleFilter
+func (_e *MockStatsRepository_Expecter) FindLargestDelegations(ctx interface{}, filter interface{}) *MockStatsRepository_FindLargestDelegations_Call {
+	return &MockStatsRepository_FindLargestDelegations_Call{Call: _e.mock.On("FindLargestDelegations", ctx, filter)}
+}
+
+func (_c *MockStatsRepository_FindLargestDelegations_Call) Run(run func(ctx context.Context, filter domain.WhaleFilter)) *MockStatsRepository_FindLargestDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WhaleFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.WhaleFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindLargestDelegations_Call) Return(delegations []models.Delegation, err error) *MockStatsRepository_FindLargestDelegations_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindLargestDelegations_Call) RunAndReturn(run func(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error)) *MockStatsRepository_FindLargestDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindTimeseries provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindTimeseries(ctx context.Context, filter domain.TimeseriesFilter) ([]models.TimeseriesPoint, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindTimeseries")
+	}
+
+	var r0 []models.TimeseriesPoint
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TimeseriesFilter) ([]models.TimeseriesPoint, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TimeseriesFilter) []models.TimeseriesPoint); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TimeseriesPoint)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.TimeseriesFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindTimeseries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindTimeseries'
+type MockStatsRepository_FindTimeseries_Call struct {
+	*mock.Call
+}
+
+// FindTimeseries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.TimeseriesFilter
+func (_e *MockStatsRepository_Expecter) FindTimeseries(ctx interface{}, filter interface{}) *MockStatsRepository_FindTimeseries_Call {
+	return &MockStatsRepository_FindTimeseries_Call{Call: _e.mock.On("FindTimeseries", ctx, filter)}
+}
+
+func (_c *MockStatsRepository_FindTimeseries_Call) Run(run func(ctx context.Context, filter domain.TimeseriesFilter)) *MockStatsRepository_FindTimeseries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.TimeseriesFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.TimeseriesFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindTimeseries_Call) Return(timeseriesPoints []models.TimeseriesPoint, err error) *MockStatsRepository_FindTimeseries_Call {
+	_c.Call.Return(timeseriesPoints, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindTimeseries_Call) RunAndReturn(run func(ctx context.Context, filter domain.TimeseriesFilter) ([]models.TimeseriesPoint, error)) *MockStatsRepository_FindTimeseries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWhaleMovements provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindWhaleMovements(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error) {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWhaleMovements")
+	}
+
+	var r0 []models.Delegation
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter) ([]models.Delegation, error)); ok {
+		return returnFunc(ctx, filter)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter) []models.Delegation); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Delegation)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WhaleFilter) error); ok {
+		r1 = returnFunc(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindWhaleMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWhaleMovements'
+type MockStatsRepository_FindWhaleMovements_Call struct {
+	*mock.Call
+}
+
+// FindWhaleMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.WhaleFilter
+func (_e *MockStatsRepository_Expecter) FindWhaleMovements(ctx interface{}, filter interface{}) *MockStatsRepository_FindWhaleMovements_Call {
+	return &MockStatsRepository_FindWhaleMovements_Call{Call: _e.mock.On("FindWhaleMovements", ctx, filter)}
+}
+
+func (_c *MockStatsRepository_FindWhaleMovements_Call) Run(run func(ctx context.Context, filter domain.WhaleFilter)) *MockStatsRepository_FindWhaleMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WhaleFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.WhaleFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindWhaleMovements_Call) Return(delegations []models.Delegation, err error) *MockStatsRepository_FindWhaleMovements_Call {
+	_c.Call.Return(delegations, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindWhaleMovements_Call) RunAndReturn(run func(ctx context.Context, filter domain.WhaleFilter) ([]models.Delegation, error)) *MockStatsRepository_FindWhaleMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWhaleReports provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) FindWhaleReports(ctx context.Context, limit int) ([]models.WhaleReport, error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWhaleReports")
+	}
+
+	var r0 []models.WhaleReport
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) ([]models.WhaleReport, error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) []models.WhaleReport); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WhaleReport)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsRepository_FindWhaleReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWhaleReports'
+type MockStatsRepository_FindWhaleReports_Call struct {
+	*mock.Call
+}
+
+// FindWhaleReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockStatsRepository_Expecter) FindWhaleReports(ctx interface{}, limit interface{}) *MockStatsRepository_FindWhaleReports_Call {
+	return &MockStatsRepository_FindWhaleReports_Call{Call: _e.mock.On("FindWhaleReports", ctx, limit)}
+}
+
+func (_c *MockStatsRepository_FindWhaleReports_Call) Run(run func(ctx context.Context, limit int)) *MockStatsRepository_FindWhaleReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_FindWhaleReports_Call) Return(whaleReports []models.WhaleReport, err error) *MockStatsRepository_FindWhaleReports_Call {
+	_c.Call.Return(whaleReports, err)
+	return _c
+}
+
+func (_c *MockStatsRepository_FindWhaleReports_Call) RunAndReturn(run func(ctx context.Context, limit int) ([]models.WhaleReport, error)) *MockStatsRepository_FindWhaleReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveWhaleReport provides a mock function for the type MockStatsRepository
+func (_mock *MockStatsRepository) SaveWhaleReport(ctx context.Context, report models.WhaleReport) error {
+	ret := _mock.Called(ctx, report)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveWhaleReport")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.WhaleReport) error); ok {
+		r0 = returnFunc(ctx, report)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatsRepository_SaveWhaleReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveWhaleReport'
+type MockStatsRepository_SaveWhaleReport_Call struct {
+	*mock.Call
+}
+
+// SaveWhaleReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - report models.WhaleReport
+func (_e *MockStatsRepository_Expecter) SaveWhaleReport(ctx interface{}, report interface{}) *MockStatsRepository_SaveWhaleReport_Call {
+	return &MockStatsRepository_SaveWhaleReport_Call{Call: _e.mock.On("SaveWhaleReport", ctx, report)}
+}
+
+func (_c *MockStatsRepository_SaveWhaleReport_Call) Run(run func(ctx context.Context, report models.WhaleReport)) *MockStatsRepository_SaveWhaleReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.WhaleReport
+		if args[1] != nil {
+			arg1 = args[1].(models.WhaleReport)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsRepository_SaveWhaleReport_Call) Return(err error) *MockStatsRepository_SaveWhaleReport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatsRepository_SaveWhaleReport_Call) RunAndReturn(run func(ctx context.Context, report models.WhaleReport) error) *MockStatsRepository_SaveWhaleReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
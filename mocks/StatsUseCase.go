@@ -0,0 +1,378 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/pkg/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockStatsUseCase creates a new instance of MockStatsUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStatsUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStatsUseCase {
+	mock := &MockStatsUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockStatsUseCase is an autogenerated mock type for the StatsUseCase type
+type MockStatsUseCase struct {
+	mock.Mock
+}
+
+type MockStatsUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStatsUseCase) EXPECT() *MockStatsUseCase_Expecter {
+	return &MockStatsUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateWhaleReport provides a mock function for the type MockStatsUseCase
+func (_mock *MockStatsUseCase) CreateWhaleReport(ctx context.Context, filter domain.WhaleFilter) error {
+	ret := _mock.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWhaleReport")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter) error); ok {
+		r0 = returnFunc(ctx, filter)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockStatsUseCase_CreateWhaleReport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWhaleReport'
+type MockStatsUseCase_CreateWhaleReport_Call struct {
+	*mock.Call
+}
+
+// CreateWhaleReport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.WhaleFilter
+func (_e *MockStatsUseCase_Expecter) CreateWhaleReport(ctx interface{}, filter interface{}) *MockStatsUseCase_CreateWhaleReport_Call {
+	return &MockStatsUseCase_CreateWhaleReport_Call{Call: _e.mock.On("CreateWhaleReport", ctx, filter)}
+}
+
+func (_c *MockStatsUseCase_CreateWhaleReport_Call) Run(run func(ctx context.Context, filter domain.WhaleFilter)) *MockStatsUseCase_CreateWhaleReport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WhaleFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.WhaleFilter)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsUseCase_CreateWhaleReport_Call) Return(err error) *MockStatsUseCase_CreateWhaleReport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockStatsUseCase_CreateWhaleReport_Call) RunAndReturn(run func(ctx context.Context, filter domain.WhaleFilter) error) *MockStatsUseCase_CreateWhaleReport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlows provides a mock function for the type MockStatsUseCase
+func (_mock *MockStatsUseCase) GetFlows(ctx context.Context, filter domain.PeriodFilter, opts domain.ResponseOptions) (domain.FlowsResponseType, error) {
+	ret := _mock.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlows")
+	}
+
+	var r0 domain.FlowsResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PeriodFilter, domain.ResponseOptions) (domain.FlowsResponseType, error)); ok {
+		return returnFunc(ctx, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PeriodFilter, domain.ResponseOptions) domain.FlowsResponseType); ok {
+		r0 = returnFunc(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.FlowsResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.PeriodFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsUseCase_GetFlows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlows'
+type MockStatsUseCase_GetFlows_Call struct {
+	*mock.Call
+}
+
+// GetFlows is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.PeriodFilter
+//   - opts domain.ResponseOptions
+func (_e *MockStatsUseCase_Expecter) GetFlows(ctx interface{}, filter interface{}, opts interface{}) *MockStatsUseCase_GetFlows_Call {
+	return &MockStatsUseCase_GetFlows_Call{Call: _e.mock.On("GetFlows", ctx, filter, opts)}
+}
+
+func (_c *MockStatsUseCase_GetFlows_Call) Run(run func(ctx context.Context, filter domain.PeriodFilter, opts domain.ResponseOptions)) *MockStatsUseCase_GetFlows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PeriodFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.PeriodFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetFlows_Call) Return(flowsResponseType domain.FlowsResponseType, err error) *MockStatsUseCase_GetFlows_Call {
+	_c.Call.Return(flowsResponseType, err)
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetFlows_Call) RunAndReturn(run func(ctx context.Context, filter domain.PeriodFilter, opts domain.ResponseOptions) (domain.FlowsResponseType, error)) *MockStatsUseCase_GetFlows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTimeseries provides a mock function for the type MockStatsUseCase
+func (_mock *MockStatsUseCase) GetTimeseries(ctx context.Context, filter domain.TimeseriesFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.TimeseriesPointResponseType], error) {
+	ret := _mock.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTimeseries")
+	}
+
+	var r0 domain.ApiResponse[domain.TimeseriesPointResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TimeseriesFilter, domain.ResponseOptions) (domain.ApiResponse[domain.TimeseriesPointResponseType], error)); ok {
+		return returnFunc(ctx, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.TimeseriesFilter, domain.ResponseOptions) domain.ApiResponse[domain.TimeseriesPointResponseType]); ok {
+		r0 = returnFunc(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.TimeseriesPointResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.TimeseriesFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsUseCase_GetTimeseries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeseries'
+type MockStatsUseCase_GetTimeseries_Call struct {
+	*mock.Call
+}
+
+// GetTimeseries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.TimeseriesFilter
+//   - opts domain.ResponseOptions
+func (_e *MockStatsUseCase_Expecter) GetTimeseries(ctx interface{}, filter interface{}, opts interface{}) *MockStatsUseCase_GetTimeseries_Call {
+	return &MockStatsUseCase_GetTimeseries_Call{Call: _e.mock.On("GetTimeseries", ctx, filter, opts)}
+}
+
+func (_c *MockStatsUseCase_GetTimeseries_Call) Run(run func(ctx context.Context, filter domain.TimeseriesFilter, opts domain.ResponseOptions)) *MockStatsUseCase_GetTimeseries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.TimeseriesFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.TimeseriesFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetTimeseries_Call) Return(apiResponse domain.ApiResponse[domain.TimeseriesPointResponseType], err error) *MockStatsUseCase_GetTimeseries_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetTimeseries_Call) RunAndReturn(run func(ctx context.Context, filter domain.TimeseriesFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.TimeseriesPointResponseType], error)) *MockStatsUseCase_GetTimeseries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWhaleReports provides a mock function for the type MockStatsUseCase
+func (_mock *MockStatsUseCase) GetWhaleReports(ctx context.Context, limit int) (domain.ApiResponse[domain.WhaleReportResponseType], error) {
+	ret := _mock.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWhaleReports")
+	}
+
+	var r0 domain.ApiResponse[domain.WhaleReportResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (domain.ApiResponse[domain.WhaleReportResponseType], error)); ok {
+		return returnFunc(ctx, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) domain.ApiResponse[domain.WhaleReportResponseType]); ok {
+		r0 = returnFunc(ctx, limit)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.WhaleReportResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsUseCase_GetWhaleReports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWhaleReports'
+type MockStatsUseCase_GetWhaleReports_Call struct {
+	*mock.Call
+}
+
+// GetWhaleReports is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *MockStatsUseCase_Expecter) GetWhaleReports(ctx interface{}, limit interface{}) *MockStatsUseCase_GetWhaleReports_Call {
+	return &MockStatsUseCase_GetWhaleReports_Call{Call: _e.mock.On("GetWhaleReports", ctx, limit)}
+}
+
+func (_c *MockStatsUseCase_GetWhaleReports_Call) Run(run func(ctx context.Context, limit int)) *MockStatsUseCase_GetWhaleReports_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetWhaleReports_Call) Return(apiResponse domain.ApiResponse[domain.WhaleReportResponseType], err error) *MockStatsUseCase_GetWhaleReports_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetWhaleReports_Call) RunAndReturn(run func(ctx context.Context, limit int) (domain.ApiResponse[domain.WhaleReportResponseType], error)) *MockStatsUseCase_GetWhaleReports_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWhales provides a mock function for the type MockStatsUseCase
+func (_mock *MockStatsUseCase) GetWhales(ctx context.Context, filter domain.WhaleFilter, opts domain.ResponseOptions) (domain.WhalesResponseType, error) {
+	ret := _mock.Called(ctx, filter, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWhales")
+	}
+
+	var r0 domain.WhalesResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter, domain.ResponseOptions) (domain.WhalesResponseType, error)); ok {
+		return returnFunc(ctx, filter, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.WhaleFilter, domain.ResponseOptions) domain.WhalesResponseType); ok {
+		r0 = returnFunc(ctx, filter, opts)
+	} else {
+		r0 = ret.Get(0).(domain.WhalesResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.WhaleFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockStatsUseCase_GetWhales_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWhales'
+type MockStatsUseCase_GetWhales_Call struct {
+	*mock.Call
+}
+
+// GetWhales is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.WhaleFilter
+//   - opts domain.ResponseOptions
+func (_e *MockStatsUseCase_Expecter) GetWhales(ctx interface{}, filter interface{}, opts interface{}) *MockStatsUseCase_GetWhales_Call {
+	return &MockStatsUseCase_GetWhales_Call{Call: _e.mock.On("GetWhales", ctx, filter, opts)}
+}
+
+func (_c *MockStatsUseCase_GetWhales_Call) Run(run func(ctx context.Context, filter domain.WhaleFilter, opts domain.ResponseOptions)) *MockStatsUseCase_GetWhales_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.WhaleFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.WhaleFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetWhales_Call) Return(whalesResponseType domain.WhalesResponseType, err error) *MockStatsUseCase_GetWhales_Call {
+	_c.Call.Return(whalesResponseType, err)
+	return _c
+}
+
+func (_c *MockStatsUseCase_GetWhales_Call) RunAndReturn(run func(ctx context.Context, filter domain.WhaleFilter, opts domain.ResponseOptions) (domain.WhalesResponseType, error)) *MockStatsUseCase_GetWhales_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -95,9 +95,440 @@ func (_c *MockUseCase_Create_Call) RunAndReturn(run func(ctx context.Context, da
 	return _c
 }
 
+// ExportDelegations provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) ExportDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+	ret := _mock.Called(ctx, filter, opts, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportDelegations")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.ResponseOptions, func(domain.DelegationsResponseType) error) error); ok {
+		r0 = returnFunc(ctx, filter, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUseCase_ExportDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportDelegations'
+type MockUseCase_ExportDelegations_Call struct {
+	*mock.Call
+}
+
+// ExportDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - opts domain.ResponseOptions
+//   - fn func(domain.DelegationsResponseType) error
+func (_e *MockUseCase_Expecter) ExportDelegations(ctx interface{}, filter interface{}, opts interface{}, fn interface{}) *MockUseCase_ExportDelegations_Call {
+	return &MockUseCase_ExportDelegations_Call{Call: _e.mock.On("ExportDelegations", ctx, filter, opts, fn)}
+}
+
+func (_c *MockUseCase_ExportDelegations_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error)) *MockUseCase_ExportDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		var arg3 func(domain.DelegationsResponseType) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.DelegationsResponseType) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_ExportDelegations_Call) Return(err error) *MockUseCase_ExportDelegations_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUseCase_ExportDelegations_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error) *MockUseCase_ExportDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBaker provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetBaker(ctx context.Context, address domain.Address, opts domain.ResponseOptions) (domain.BakerResponseType, error) {
+	ret := _mock.Called(ctx, address, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBaker")
+	}
+
+	var r0 domain.BakerResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.ResponseOptions) (domain.BakerResponseType, error)); ok {
+		return returnFunc(ctx, address, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.ResponseOptions) domain.BakerResponseType); ok {
+		r0 = returnFunc(ctx, address, opts)
+	} else {
+		r0 = ret.Get(0).(domain.BakerResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, address, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetBaker_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBaker'
+type MockUseCase_GetBaker_Call struct {
+	*mock.Call
+}
+
+// GetBaker is a helper method to define mock.On call
+//   - ctx context.Context
+//   - address domain.Address
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetBaker(ctx interface{}, address interface{}, opts interface{}) *MockUseCase_GetBaker_Call {
+	return &MockUseCase_GetBaker_Call{Call: _e.mock.On("GetBaker", ctx, address, opts)}
+}
+
+func (_c *MockUseCase_GetBaker_Call) Run(run func(ctx context.Context, address domain.Address, opts domain.ResponseOptions)) *MockUseCase_GetBaker_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetBaker_Call) Return(bakerResponseType domain.BakerResponseType, err error) *MockUseCase_GetBaker_Call {
+	_c.Call.Return(bakerResponseType, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetBaker_Call) RunAndReturn(run func(ctx context.Context, address domain.Address, opts domain.ResponseOptions) (domain.BakerResponseType, error)) *MockUseCase_GetBaker_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBakers provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetBakers(ctx context.Context, opts domain.ResponseOptions) (domain.ApiResponse[domain.BakerResponseType], error) {
+	ret := _mock.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBakers")
+	}
+
+	var r0 domain.ApiResponse[domain.BakerResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ResponseOptions) (domain.ApiResponse[domain.BakerResponseType], error)); ok {
+		return returnFunc(ctx, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ResponseOptions) domain.ApiResponse[domain.BakerResponseType]); ok {
+		r0 = returnFunc(ctx, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.BakerResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetBakers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBakers'
+type MockUseCase_GetBakers_Call struct {
+	*mock.Call
+}
+
+// GetBakers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetBakers(ctx interface{}, opts interface{}) *MockUseCase_GetBakers_Call {
+	return &MockUseCase_GetBakers_Call{Call: _e.mock.On("GetBakers", ctx, opts)}
+}
+
+func (_c *MockUseCase_GetBakers_Call) Run(run func(ctx context.Context, opts domain.ResponseOptions)) *MockUseCase_GetBakers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.ResponseOptions
+		if args[1] != nil {
+			arg1 = args[1].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetBakers_Call) Return(apiResponse domain.ApiResponse[domain.BakerResponseType], err error) *MockUseCase_GetBakers_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetBakers_Call) RunAndReturn(run func(ctx context.Context, opts domain.ResponseOptions) (domain.ApiResponse[domain.BakerResponseType], error)) *MockUseCase_GetBakers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBakersByAddress provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetBakersByAddress(ctx context.Context, addresses []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.BakerResponseType, error) {
+	ret := _mock.Called(ctx, addresses, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBakersByAddress")
+	}
+
+	var r0 map[domain.Address]domain.BakerResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address, domain.ResponseOptions) (map[domain.Address]domain.BakerResponseType, error)); ok {
+		return returnFunc(ctx, addresses, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address, domain.ResponseOptions) map[domain.Address]domain.BakerResponseType); ok {
+		r0 = returnFunc(ctx, addresses, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.Address]domain.BakerResponseType)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Address, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, addresses, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetBakersByAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBakersByAddress'
+type MockUseCase_GetBakersByAddress_Call struct {
+	*mock.Call
+}
+
+// GetBakersByAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - addresses []domain.Address
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetBakersByAddress(ctx interface{}, addresses interface{}, opts interface{}) *MockUseCase_GetBakersByAddress_Call {
+	return &MockUseCase_GetBakersByAddress_Call{Call: _e.mock.On("GetBakersByAddress", ctx, addresses, opts)}
+}
+
+func (_c *MockUseCase_GetBakersByAddress_Call) Run(run func(ctx context.Context, addresses []domain.Address, opts domain.ResponseOptions)) *MockUseCase_GetBakersByAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Address
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Address)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetBakersByAddress_Call) Return(addressToBakerResponseType map[domain.Address]domain.BakerResponseType, err error) *MockUseCase_GetBakersByAddress_Call {
+	_c.Call.Return(addressToBakerResponseType, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetBakersByAddress_Call) RunAndReturn(run func(ctx context.Context, addresses []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.BakerResponseType, error)) *MockUseCase_GetBakersByAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBakersPage provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetBakersPage(ctx context.Context, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.BakerResponseType], error) {
+	ret := _mock.Called(ctx, page, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBakersPage")
+	}
+
+	var r0 domain.PageResponse[domain.BakerResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Page, domain.ResponseOptions) (domain.PageResponse[domain.BakerResponseType], error)); ok {
+		return returnFunc(ctx, page, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Page, domain.ResponseOptions) domain.PageResponse[domain.BakerResponseType]); ok {
+		r0 = returnFunc(ctx, page, opts)
+	} else {
+		r0 = ret.Get(0).(domain.PageResponse[domain.BakerResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Page, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, page, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetBakersPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBakersPage'
+type MockUseCase_GetBakersPage_Call struct {
+	*mock.Call
+}
+
+// GetBakersPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - page domain.Page
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetBakersPage(ctx interface{}, page interface{}, opts interface{}) *MockUseCase_GetBakersPage_Call {
+	return &MockUseCase_GetBakersPage_Call{Call: _e.mock.On("GetBakersPage", ctx, page, opts)}
+}
+
+func (_c *MockUseCase_GetBakersPage_Call) Run(run func(ctx context.Context, page domain.Page, opts domain.ResponseOptions)) *MockUseCase_GetBakersPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Page
+		if args[1] != nil {
+			arg1 = args[1].(domain.Page)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetBakersPage_Call) Return(pageResponse domain.PageResponse[domain.BakerResponseType], err error) *MockUseCase_GetBakersPage_Call {
+	_c.Call.Return(pageResponse, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetBakersPage_Call) RunAndReturn(run func(ctx context.Context, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.BakerResponseType], error)) *MockUseCase_GetBakersPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCurrentDelegations provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetCurrentDelegations(ctx context.Context, baker domain.Address, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error) {
+	ret := _mock.Called(ctx, baker, page, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentDelegations")
+	}
+
+	var r0 domain.PageResponse[domain.DelegationsResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.Page, domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error)); ok {
+		return returnFunc(ctx, baker, page, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.Address, domain.Page, domain.ResponseOptions) domain.PageResponse[domain.DelegationsResponseType]); ok {
+		r0 = returnFunc(ctx, baker, page, opts)
+	} else {
+		r0 = ret.Get(0).(domain.PageResponse[domain.DelegationsResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.Address, domain.Page, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, baker, page, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetCurrentDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentDelegations'
+type MockUseCase_GetCurrentDelegations_Call struct {
+	*mock.Call
+}
+
+// GetCurrentDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - baker domain.Address
+//   - page domain.Page
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetCurrentDelegations(ctx interface{}, baker interface{}, page interface{}, opts interface{}) *MockUseCase_GetCurrentDelegations_Call {
+	return &MockUseCase_GetCurrentDelegations_Call{Call: _e.mock.On("GetCurrentDelegations", ctx, baker, page, opts)}
+}
+
+func (_c *MockUseCase_GetCurrentDelegations_Call) Run(run func(ctx context.Context, baker domain.Address, page domain.Page, opts domain.ResponseOptions)) *MockUseCase_GetCurrentDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.Address
+		if args[1] != nil {
+			arg1 = args[1].(domain.Address)
+		}
+		var arg2 domain.Page
+		if args[2] != nil {
+			arg2 = args[2].(domain.Page)
+		}
+		var arg3 domain.ResponseOptions
+		if args[3] != nil {
+			arg3 = args[3].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetCurrentDelegations_Call) Return(pageResponse domain.PageResponse[domain.DelegationsResponseType], err error) *MockUseCase_GetCurrentDelegations_Call {
+	_c.Call.Return(pageResponse, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetCurrentDelegations_Call) RunAndReturn(run func(ctx context.Context, baker domain.Address, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error)) *MockUseCase_GetCurrentDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetDelegations provides a mock function for the type MockUseCase
-func (_mock *MockUseCase) GetDelegations(ctx context.Context) (domain.ApiResponse[domain.DelegationsResponseType], error) {
-	ret := _mock.Called(ctx)
+func (_mock *MockUseCase) GetDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.DelegationsResponseType], error) {
+	ret := _mock.Called(ctx, filter, opts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetDelegations")
@@ -105,16 +536,16 @@ func (_mock *MockUseCase) GetDelegations(ctx context.Context) (domain.ApiRespons
 
 	var r0 domain.ApiResponse[domain.DelegationsResponseType]
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.ApiResponse[domain.DelegationsResponseType], error)); ok {
-		return returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.ResponseOptions) (domain.ApiResponse[domain.DelegationsResponseType], error)); ok {
+		return returnFunc(ctx, filter, opts)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.ApiResponse[domain.DelegationsResponseType]); ok {
-		r0 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.ResponseOptions) domain.ApiResponse[domain.DelegationsResponseType]); ok {
+		r0 = returnFunc(ctx, filter, opts)
 	} else {
 		r0 = ret.Get(0).(domain.ApiResponse[domain.DelegationsResponseType])
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, opts)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -128,18 +559,30 @@ type MockUseCase_GetDelegations_Call struct {
 
 // GetDelegations is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockUseCase_Expecter) GetDelegations(ctx interface{}) *MockUseCase_GetDelegations_Call {
-	return &MockUseCase_GetDelegations_Call{Call: _e.mock.On("GetDelegations", ctx)}
+//   - filter domain.DelegationFilter
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetDelegations(ctx interface{}, filter interface{}, opts interface{}) *MockUseCase_GetDelegations_Call {
+	return &MockUseCase_GetDelegations_Call{Call: _e.mock.On("GetDelegations", ctx, filter, opts)}
 }
 
-func (_c *MockUseCase_GetDelegations_Call) Run(run func(ctx context.Context)) *MockUseCase_GetDelegations_Call {
+func (_c *MockUseCase_GetDelegations_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions)) *MockUseCase_GetDelegations_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -150,7 +593,314 @@ func (_c *MockUseCase_GetDelegations_Call) Return(apiResponse domain.ApiResponse
 	return _c
 }
 
-func (_c *MockUseCase_GetDelegations_Call) RunAndReturn(run func(ctx context.Context) (domain.ApiResponse[domain.DelegationsResponseType], error)) *MockUseCase_GetDelegations_Call {
+func (_c *MockUseCase_GetDelegations_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions) (domain.ApiResponse[domain.DelegationsResponseType], error)) *MockUseCase_GetDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelegationsPage provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetDelegationsPage(ctx context.Context, filter domain.DelegationFilter, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error) {
+	ret := _mock.Called(ctx, filter, page, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegationsPage")
+	}
+
+	var r0 domain.PageResponse[domain.DelegationsResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.Page, domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error)); ok {
+		return returnFunc(ctx, filter, page, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.Page, domain.ResponseOptions) domain.PageResponse[domain.DelegationsResponseType]); ok {
+		r0 = returnFunc(ctx, filter, page, opts)
+	} else {
+		r0 = ret.Get(0).(domain.PageResponse[domain.DelegationsResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationFilter, domain.Page, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, filter, page, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetDelegationsPage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelegationsPage'
+type MockUseCase_GetDelegationsPage_Call struct {
+	*mock.Call
+}
+
+// GetDelegationsPage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - page domain.Page
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetDelegationsPage(ctx interface{}, filter interface{}, page interface{}, opts interface{}) *MockUseCase_GetDelegationsPage_Call {
+	return &MockUseCase_GetDelegationsPage_Call{Call: _e.mock.On("GetDelegationsPage", ctx, filter, page, opts)}
+}
+
+func (_c *MockUseCase_GetDelegationsPage_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, page domain.Page, opts domain.ResponseOptions)) *MockUseCase_GetDelegationsPage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 domain.Page
+		if args[2] != nil {
+			arg2 = args[2].(domain.Page)
+		}
+		var arg3 domain.ResponseOptions
+		if args[3] != nil {
+			arg3 = args[3].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetDelegationsPage_Call) Return(pageResponse domain.PageResponse[domain.DelegationsResponseType], err error) *MockUseCase_GetDelegationsPage_Call {
+	_c.Call.Return(pageResponse, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetDelegationsPage_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, page domain.Page, opts domain.ResponseOptions) (domain.PageResponse[domain.DelegationsResponseType], error)) *MockUseCase_GetDelegationsPage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDelegationsPages provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetDelegationsPages(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, page domain.Page, opts domain.ResponseOptions) (map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], error) {
+	ret := _mock.Called(ctx, group, addresses, page, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDelegationsPages")
+	}
+
+	var r0 map[domain.Address]domain.PageResponse[domain.DelegationsResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationGroup, []domain.Address, domain.Page, domain.ResponseOptions) (map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], error)); ok {
+		return returnFunc(ctx, group, addresses, page, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationGroup, []domain.Address, domain.Page, domain.ResponseOptions) map[domain.Address]domain.PageResponse[domain.DelegationsResponseType]); ok {
+		r0 = returnFunc(ctx, group, addresses, page, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.Address]domain.PageResponse[domain.DelegationsResponseType])
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.DelegationGroup, []domain.Address, domain.Page, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, group, addresses, page, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetDelegationsPages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDelegationsPages'
+type MockUseCase_GetDelegationsPages_Call struct {
+	*mock.Call
+}
+
+// GetDelegationsPages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - group domain.DelegationGroup
+//   - addresses []domain.Address
+//   - page domain.Page
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetDelegationsPages(ctx interface{}, group interface{}, addresses interface{}, page interface{}, opts interface{}) *MockUseCase_GetDelegationsPages_Call {
+	return &MockUseCase_GetDelegationsPages_Call{Call: _e.mock.On("GetDelegationsPages", ctx, group, addresses, page, opts)}
+}
+
+func (_c *MockUseCase_GetDelegationsPages_Call) Run(run func(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, page domain.Page, opts domain.ResponseOptions)) *MockUseCase_GetDelegationsPages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationGroup
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationGroup)
+		}
+		var arg2 []domain.Address
+		if args[2] != nil {
+			arg2 = args[2].([]domain.Address)
+		}
+		var arg3 domain.Page
+		if args[3] != nil {
+			arg3 = args[3].(domain.Page)
+		}
+		var arg4 domain.ResponseOptions
+		if args[4] != nil {
+			arg4 = args[4].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetDelegationsPages_Call) Return(addressToPageResponse map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], err error) *MockUseCase_GetDelegationsPages_Call {
+	_c.Call.Return(addressToPageResponse, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetDelegationsPages_Call) RunAndReturn(run func(ctx context.Context, group domain.DelegationGroup, addresses []domain.Address, page domain.Page, opts domain.ResponseOptions) (map[domain.Address]domain.PageResponse[domain.DelegationsResponseType], error)) *MockUseCase_GetDelegationsPages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLatestDelegations provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) GetLatestDelegations(ctx context.Context, delegators []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.DelegationsResponseType, error) {
+	ret := _mock.Called(ctx, delegators, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLatestDelegations")
+	}
+
+	var r0 map[domain.Address]domain.DelegationsResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address, domain.ResponseOptions) (map[domain.Address]domain.DelegationsResponseType, error)); ok {
+		return returnFunc(ctx, delegators, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.Address, domain.ResponseOptions) map[domain.Address]domain.DelegationsResponseType); ok {
+		r0 = returnFunc(ctx, delegators, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[domain.Address]domain.DelegationsResponseType)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []domain.Address, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, delegators, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUseCase_GetLatestDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLatestDelegations'
+type MockUseCase_GetLatestDelegations_Call struct {
+	*mock.Call
+}
+
+// GetLatestDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegators []domain.Address
+//   - opts domain.ResponseOptions
+func (_e *MockUseCase_Expecter) GetLatestDelegations(ctx interface{}, delegators interface{}, opts interface{}) *MockUseCase_GetLatestDelegations_Call {
+	return &MockUseCase_GetLatestDelegations_Call{Call: _e.mock.On("GetLatestDelegations", ctx, delegators, opts)}
+}
+
+func (_c *MockUseCase_GetLatestDelegations_Call) Run(run func(ctx context.Context, delegators []domain.Address, opts domain.ResponseOptions)) *MockUseCase_GetLatestDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.Address
+		if args[1] != nil {
+			arg1 = args[1].([]domain.Address)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_GetLatestDelegations_Call) Return(addressToDelegationsResponseType map[domain.Address]domain.DelegationsResponseType, err error) *MockUseCase_GetLatestDelegations_Call {
+	_c.Call.Return(addressToDelegationsResponseType, err)
+	return _c
+}
+
+func (_c *MockUseCase_GetLatestDelegations_Call) RunAndReturn(run func(ctx context.Context, delegators []domain.Address, opts domain.ResponseOptions) (map[domain.Address]domain.DelegationsResponseType, error)) *MockUseCase_GetLatestDelegations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchDelegations provides a mock function for the type MockUseCase
+func (_mock *MockUseCase) WatchDelegations(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error {
+	ret := _mock.Called(ctx, filter, opts, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchDelegations")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.DelegationFilter, domain.ResponseOptions, func(domain.DelegationsResponseType) error) error); ok {
+		r0 = returnFunc(ctx, filter, opts, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockUseCase_WatchDelegations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchDelegations'
+type MockUseCase_WatchDelegations_Call struct {
+	*mock.Call
+}
+
+// WatchDelegations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter domain.DelegationFilter
+//   - opts domain.ResponseOptions
+//   - fn func(domain.DelegationsResponseType) error
+func (_e *MockUseCase_Expecter) WatchDelegations(ctx interface{}, filter interface{}, opts interface{}, fn interface{}) *MockUseCase_WatchDelegations_Call {
+	return &MockUseCase_WatchDelegations_Call{Call: _e.mock.On("WatchDelegations", ctx, filter, opts, fn)}
+}
+
+func (_c *MockUseCase_WatchDelegations_Call) Run(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error)) *MockUseCase_WatchDelegations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.DelegationFilter
+		if args[1] != nil {
+			arg1 = args[1].(domain.DelegationFilter)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		var arg3 func(domain.DelegationsResponseType) error
+		if args[3] != nil {
+			arg3 = args[3].(func(domain.DelegationsResponseType) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUseCase_WatchDelegations_Call) Return(err error) *MockUseCase_WatchDelegations_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockUseCase_WatchDelegations_Call) RunAndReturn(run func(ctx context.Context, filter domain.DelegationFilter, opts domain.ResponseOptions, fn func(domain.DelegationsResponseType) error) error) *MockUseCase_WatchDelegations_Call {
 	_c.Call.Return(run)
 	return _c
 }
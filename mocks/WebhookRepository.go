@@ -0,0 +1,583 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"time"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookRepository creates a new instance of MockWebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookRepository {
+	mock := &MockWebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookRepository is an autogenerated mock type for the WebhookRepository type
+type MockWebhookRepository struct {
+	mock.Mock
+}
+
+type MockWebhookRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookRepository) EXPECT() *MockWebhookRepository_Expecter {
+	return &MockWebhookRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDueDeliveries provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]models.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, now, leaseUntil, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []models.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = returnFunc(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_ClaimDueDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDueDeliveries'
+type MockWebhookRepository_ClaimDueDeliveries_Call struct {
+	*mock.Call
+}
+
+// ClaimDueDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) ClaimDueDeliveries(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	return &MockWebhookRepository_ClaimDueDeliveries_Call{Call: _e.mock.On("ClaimDueDeliveries", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) Return(webhookDeliverys []models.WebhookDelivery, err error) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_ClaimDueDeliveries_Call) RunAndReturn(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error)) *MockWebhookRepository_ClaimDueDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWebhook provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error) {
+	ret := _mock.Called(ctx, webhook)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Webhook) (models.Webhook, error)); ok {
+		return returnFunc(ctx, webhook)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.Webhook) models.Webhook); ok {
+		r0 = returnFunc(ctx, webhook)
+	} else {
+		r0 = ret.Get(0).(models.Webhook)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, models.Webhook) error); ok {
+		r1 = returnFunc(ctx, webhook)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookRepository_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhook models.Webhook
+func (_e *MockWebhookRepository_Expecter) CreateWebhook(ctx interface{}, webhook interface{}) *MockWebhookRepository_CreateWebhook_Call {
+	return &MockWebhookRepository_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, webhook)}
+}
+
+func (_c *MockWebhookRepository_CreateWebhook_Call) Run(run func(ctx context.Context, webhook models.Webhook)) *MockWebhookRepository_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.Webhook
+		if args[1] != nil {
+			arg1 = args[1].(models.Webhook)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_CreateWebhook_Call) Return(webhook1 models.Webhook, err error) *MockWebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(webhook1, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, webhook models.Webhook) (models.Webhook, error)) *MockWebhookRepository_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, apiKeyID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, apiKeyID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookRepository_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockWebhookRepository_Expecter) DeleteWebhook(ctx interface{}, apiKeyID interface{}, id interface{}) *MockWebhookRepository_DeleteWebhook_Call {
+	return &MockWebhookRepository_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, apiKeyID, id)}
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID)) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) Return(err error) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error) *MockWebhookRepository_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyWebhooks provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindAPIKeyWebhooks(ctx context.Context, apiKeyID uuid.UUID) ([]models.Webhook, error) {
+	ret := _mock.Called(ctx, apiKeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyWebhooks")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]models.Webhook, error)); ok {
+		return returnFunc(ctx, apiKeyID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []models.Webhook); ok {
+		r0 = returnFunc(ctx, apiKeyID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, apiKeyID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindAPIKeyWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyWebhooks'
+type MockWebhookRepository_FindAPIKeyWebhooks_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+func (_e *MockWebhookRepository_Expecter) FindAPIKeyWebhooks(ctx interface{}, apiKeyID interface{}) *MockWebhookRepository_FindAPIKeyWebhooks_Call {
+	return &MockWebhookRepository_FindAPIKeyWebhooks_Call{Call: _e.mock.On("FindAPIKeyWebhooks", ctx, apiKeyID)}
+}
+
+func (_c *MockWebhookRepository_FindAPIKeyWebhooks_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID)) *MockWebhookRepository_FindAPIKeyWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindAPIKeyWebhooks_Call) Return(webhooks []models.Webhook, err error) *MockWebhookRepository_FindAPIKeyWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindAPIKeyWebhooks_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID) ([]models.Webhook, error)) *MockWebhookRepository_FindAPIKeyWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindDeliveries provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, webhookID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindDeliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) ([]models.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, webhookID, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int) []models.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, webhookID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, webhookID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindDeliveries'
+type MockWebhookRepository_FindDeliveries_Call struct {
+	*mock.Call
+}
+
+// FindDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - webhookID uuid.UUID
+//   - limit int
+func (_e *MockWebhookRepository_Expecter) FindDeliveries(ctx interface{}, webhookID interface{}, limit interface{}) *MockWebhookRepository_FindDeliveries_Call {
+	return &MockWebhookRepository_FindDeliveries_Call{Call: _e.mock.On("FindDeliveries", ctx, webhookID, limit)}
+}
+
+func (_c *MockWebhookRepository_FindDeliveries_Call) Run(run func(ctx context.Context, webhookID uuid.UUID, limit int)) *MockWebhookRepository_FindDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindDeliveries_Call) Return(webhookDeliverys []models.WebhookDelivery, err error) *MockWebhookRepository_FindDeliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindDeliveries_Call) RunAndReturn(run func(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error)) *MockWebhookRepository_FindDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhook provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) (models.Webhook, error) {
+	ret := _mock.Called(ctx, apiKeyID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWebhook")
+	}
+
+	var r0 models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (models.Webhook, error)); ok {
+		return returnFunc(ctx, apiKeyID, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) models.Webhook); ok {
+		r0 = returnFunc(ctx, apiKeyID, id)
+	} else {
+		r0 = ret.Get(0).(models.Webhook)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, apiKeyID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhook'
+type MockWebhookRepository_FindWebhook_Call struct {
+	*mock.Call
+}
+
+// FindWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockWebhookRepository_Expecter) FindWebhook(ctx interface{}, apiKeyID interface{}, id interface{}) *MockWebhookRepository_FindWebhook_Call {
+	return &MockWebhookRepository_FindWebhook_Call{Call: _e.mock.On("FindWebhook", ctx, apiKeyID, id)}
+}
+
+func (_c *MockWebhookRepository_FindWebhook_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID)) *MockWebhookRepository_FindWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindWebhook_Call) Return(webhook models.Webhook, err error) *MockWebhookRepository_FindWebhook_Call {
+	_c.Call.Return(webhook, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindWebhook_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) (models.Webhook, error)) *MockWebhookRepository_FindWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindWebhooks provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) FindWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindWebhooks")
+	}
+
+	var r0 []models.Webhook
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]models.Webhook, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []models.Webhook); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Webhook)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookRepository_FindWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindWebhooks'
+type MockWebhookRepository_FindWebhooks_Call struct {
+	*mock.Call
+}
+
+// FindWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookRepository_Expecter) FindWebhooks(ctx interface{}) *MockWebhookRepository_FindWebhooks_Call {
+	return &MockWebhookRepository_FindWebhooks_Call{Call: _e.mock.On("FindWebhooks", ctx)}
+}
+
+func (_c *MockWebhookRepository_FindWebhooks_Call) Run(run func(ctx context.Context)) *MockWebhookRepository_FindWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindWebhooks_Call) Return(webhooks []models.Webhook, err error) *MockWebhookRepository_FindWebhooks_Call {
+	_c.Call.Return(webhooks, err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_FindWebhooks_Call) RunAndReturn(run func(ctx context.Context) ([]models.Webhook, error)) *MockWebhookRepository_FindWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDelivery provides a mock function for the type MockWebhookRepository
+func (_mock *MockWebhookRepository) UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	ret := _mock.Called(ctx, delivery)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDelivery")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, models.WebhookDelivery) error); ok {
+		r0 = returnFunc(ctx, delivery)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookRepository_UpdateDelivery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDelivery'
+type MockWebhookRepository_UpdateDelivery_Call struct {
+	*mock.Call
+}
+
+// UpdateDelivery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delivery models.WebhookDelivery
+func (_e *MockWebhookRepository_Expecter) UpdateDelivery(ctx interface{}, delivery interface{}) *MockWebhookRepository_UpdateDelivery_Call {
+	return &MockWebhookRepository_UpdateDelivery_Call{Call: _e.mock.On("UpdateDelivery", ctx, delivery)}
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) Run(run func(ctx context.Context, delivery models.WebhookDelivery)) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 models.WebhookDelivery
+		if args[1] != nil {
+			arg1 = args[1].(models.WebhookDelivery)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) Return(err error) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookRepository_UpdateDelivery_Call) RunAndReturn(run func(ctx context.Context, delivery models.WebhookDelivery) error) *MockWebhookRepository_UpdateDelivery_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,116 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookSender creates a new instance of MockWebhookSender. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookSender(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookSender {
+	mock := &MockWebhookSender{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookSender is an autogenerated mock type for the WebhookSender type
+type MockWebhookSender struct {
+	mock.Mock
+}
+
+type MockWebhookSender_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookSender) EXPECT() *MockWebhookSender_Expecter {
+	return &MockWebhookSender_Expecter{mock: &_m.Mock}
+}
+
+// Send provides a mock function for the type MockWebhookSender
+func (_mock *MockWebhookSender) Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error) {
+	ret := _mock.Called(ctx, url, headers, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Send")
+	}
+
+	var r0 int
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string, []byte) (int, error)); ok {
+		return returnFunc(ctx, url, headers, body)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, map[string]string, []byte) int); ok {
+		r0 = returnFunc(ctx, url, headers, body)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, map[string]string, []byte) error); ok {
+		r1 = returnFunc(ctx, url, headers, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookSender_Send_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Send'
+type MockWebhookSender_Send_Call struct {
+	*mock.Call
+}
+
+// Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - url string
+//   - headers map[string]string
+//   - body []byte
+func (_e *MockWebhookSender_Expecter) Send(ctx interface{}, url interface{}, headers interface{}, body interface{}) *MockWebhookSender_Send_Call {
+	return &MockWebhookSender_Send_Call{Call: _e.mock.On("Send", ctx, url, headers, body)}
+}
+
+func (_c *MockWebhookSender_Send_Call) Run(run func(ctx context.Context, url string, headers map[string]string, body []byte)) *MockWebhookSender_Send_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 map[string]string
+		if args[2] != nil {
+			arg2 = args[2].(map[string]string)
+		}
+		var arg3 []byte
+		if args[3] != nil {
+			arg3 = args[3].([]byte)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) Return(n int, err error) *MockWebhookSender_Send_Call {
+	_c.Call.Return(n, err)
+	return _c
+}
+
+func (_c *MockWebhookSender_Send_Call) RunAndReturn(run func(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)) *MockWebhookSender_Send_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,523 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+	"delegator/internal/models"
+	"delegator/pkg/domain"
+
+	"github.com/google/uuid"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NewMockWebhookUseCase creates a new instance of MockWebhookUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWebhookUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWebhookUseCase {
+	mock := &MockWebhookUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockWebhookUseCase is an autogenerated mock type for the WebhookUseCase type
+type MockWebhookUseCase struct {
+	mock.Mock
+}
+
+type MockWebhookUseCase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWebhookUseCase) EXPECT() *MockWebhookUseCase_Expecter {
+	return &MockWebhookUseCase_Expecter{mock: &_m.Mock}
+}
+
+// CreateWebhook provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) CreateWebhook(ctx context.Context, dto domain.CreateWebhookDTO, opts domain.ResponseOptions) (domain.WebhookResponseType, error) {
+	ret := _mock.Called(ctx, dto, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWebhook")
+	}
+
+	var r0 domain.WebhookResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookDTO, domain.ResponseOptions) (domain.WebhookResponseType, error)); ok {
+		return returnFunc(ctx, dto, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.CreateWebhookDTO, domain.ResponseOptions) domain.WebhookResponseType); ok {
+		r0 = returnFunc(ctx, dto, opts)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.CreateWebhookDTO, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, dto, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookUseCase_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type MockWebhookUseCase_CreateWebhook_Call struct {
+	*mock.Call
+}
+
+// CreateWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dto domain.CreateWebhookDTO
+//   - opts domain.ResponseOptions
+func (_e *MockWebhookUseCase_Expecter) CreateWebhook(ctx interface{}, dto interface{}, opts interface{}) *MockWebhookUseCase_CreateWebhook_Call {
+	return &MockWebhookUseCase_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, dto, opts)}
+}
+
+func (_c *MockWebhookUseCase_CreateWebhook_Call) Run(run func(ctx context.Context, dto domain.CreateWebhookDTO, opts domain.ResponseOptions)) *MockWebhookUseCase_CreateWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.CreateWebhookDTO
+		if args[1] != nil {
+			arg1 = args[1].(domain.CreateWebhookDTO)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_CreateWebhook_Call) Return(webhookResponseType domain.WebhookResponseType, err error) *MockWebhookUseCase_CreateWebhook_Call {
+	_c.Call.Return(webhookResponseType, err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_CreateWebhook_Call) RunAndReturn(run func(ctx context.Context, dto domain.CreateWebhookDTO, opts domain.ResponseOptions) (domain.WebhookResponseType, error)) *MockWebhookUseCase_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error {
+	ret := _mock.Called(ctx, apiKeyID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, apiKeyID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookUseCase_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type MockWebhookUseCase_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - id uuid.UUID
+func (_e *MockWebhookUseCase_Expecter) DeleteWebhook(ctx interface{}, apiKeyID interface{}, id interface{}) *MockWebhookUseCase_DeleteWebhook_Call {
+	return &MockWebhookUseCase_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, apiKeyID, id)}
+}
+
+func (_c *MockWebhookUseCase_DeleteWebhook_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID)) *MockWebhookUseCase_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_DeleteWebhook_Call) Return(err error) *MockWebhookUseCase_DeleteWebhook_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_DeleteWebhook_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error) *MockWebhookUseCase_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deliver provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) Deliver(ctx context.Context) error {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliver")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWebhookUseCase_Deliver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliver'
+type MockWebhookUseCase_Deliver_Call struct {
+	*mock.Call
+}
+
+// Deliver is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockWebhookUseCase_Expecter) Deliver(ctx interface{}) *MockWebhookUseCase_Deliver_Call {
+	return &MockWebhookUseCase_Deliver_Call{Call: _e.mock.On("Deliver", ctx)}
+}
+
+func (_c *MockWebhookUseCase_Deliver_Call) Run(run func(ctx context.Context)) *MockWebhookUseCase_Deliver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_Deliver_Call) Return(err error) *MockWebhookUseCase_Deliver_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_Deliver_Call) RunAndReturn(run func(ctx context.Context) error) *MockWebhookUseCase_Deliver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Deliveries provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) Deliveries(ctx context.Context, delegations []models.Delegation) ([]models.WebhookDelivery, error) {
+	ret := _mock.Called(ctx, delegations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Deliveries")
+	}
+
+	var r0 []models.WebhookDelivery
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.Delegation) ([]models.WebhookDelivery, error)); ok {
+		return returnFunc(ctx, delegations)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []models.Delegation) []models.WebhookDelivery); ok {
+		r0 = returnFunc(ctx, delegations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WebhookDelivery)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []models.Delegation) error); ok {
+		r1 = returnFunc(ctx, delegations)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookUseCase_Deliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Deliveries'
+type MockWebhookUseCase_Deliveries_Call struct {
+	*mock.Call
+}
+
+// Deliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delegations []models.Delegation
+func (_e *MockWebhookUseCase_Expecter) Deliveries(ctx interface{}, delegations interface{}) *MockWebhookUseCase_Deliveries_Call {
+	return &MockWebhookUseCase_Deliveries_Call{Call: _e.mock.On("Deliveries", ctx, delegations)}
+}
+
+func (_c *MockWebhookUseCase_Deliveries_Call) Run(run func(ctx context.Context, delegations []models.Delegation)) *MockWebhookUseCase_Deliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []models.Delegation
+		if args[1] != nil {
+			arg1 = args[1].([]models.Delegation)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_Deliveries_Call) Return(webhookDeliverys []models.WebhookDelivery, err error) *MockWebhookUseCase_Deliveries_Call {
+	_c.Call.Return(webhookDeliverys, err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_Deliveries_Call) RunAndReturn(run func(ctx context.Context, delegations []models.Delegation) ([]models.WebhookDelivery, error)) *MockWebhookUseCase_Deliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDeliveries provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) GetDeliveries(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, limit int) (domain.ApiResponse[domain.WebhookDeliveryResponseType], error) {
+	ret := _mock.Called(ctx, apiKeyID, id, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDeliveries")
+	}
+
+	var r0 domain.ApiResponse[domain.WebhookDeliveryResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) (domain.ApiResponse[domain.WebhookDeliveryResponseType], error)); ok {
+		return returnFunc(ctx, apiKeyID, id, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, int) domain.ApiResponse[domain.WebhookDeliveryResponseType]); ok {
+		r0 = returnFunc(ctx, apiKeyID, id, limit)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.WebhookDeliveryResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, int) error); ok {
+		r1 = returnFunc(ctx, apiKeyID, id, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookUseCase_GetDeliveries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDeliveries'
+type MockWebhookUseCase_GetDeliveries_Call struct {
+	*mock.Call
+}
+
+// GetDeliveries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - id uuid.UUID
+//   - limit int
+func (_e *MockWebhookUseCase_Expecter) GetDeliveries(ctx interface{}, apiKeyID interface{}, id interface{}, limit interface{}) *MockWebhookUseCase_GetDeliveries_Call {
+	return &MockWebhookUseCase_GetDeliveries_Call{Call: _e.mock.On("GetDeliveries", ctx, apiKeyID, id, limit)}
+}
+
+func (_c *MockWebhookUseCase_GetDeliveries_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, limit int)) *MockWebhookUseCase_GetDeliveries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetDeliveries_Call) Return(apiResponse domain.ApiResponse[domain.WebhookDeliveryResponseType], err error) *MockWebhookUseCase_GetDeliveries_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetDeliveries_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, limit int) (domain.ApiResponse[domain.WebhookDeliveryResponseType], error)) *MockWebhookUseCase_GetDeliveries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhook provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) GetWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, opts domain.ResponseOptions) (domain.WebhookResponseType, error) {
+	ret := _mock.Called(ctx, apiKeyID, id, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhook")
+	}
+
+	var r0 domain.WebhookResponseType
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.ResponseOptions) (domain.WebhookResponseType, error)); ok {
+		return returnFunc(ctx, apiKeyID, id, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, domain.ResponseOptions) domain.WebhookResponseType); ok {
+		r0 = returnFunc(ctx, apiKeyID, id, opts)
+	} else {
+		r0 = ret.Get(0).(domain.WebhookResponseType)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, apiKeyID, id, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookUseCase_GetWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhook'
+type MockWebhookUseCase_GetWebhook_Call struct {
+	*mock.Call
+}
+
+// GetWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - id uuid.UUID
+//   - opts domain.ResponseOptions
+func (_e *MockWebhookUseCase_Expecter) GetWebhook(ctx interface{}, apiKeyID interface{}, id interface{}, opts interface{}) *MockWebhookUseCase_GetWebhook_Call {
+	return &MockWebhookUseCase_GetWebhook_Call{Call: _e.mock.On("GetWebhook", ctx, apiKeyID, id, opts)}
+}
+
+func (_c *MockWebhookUseCase_GetWebhook_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, opts domain.ResponseOptions)) *MockWebhookUseCase_GetWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		var arg3 domain.ResponseOptions
+		if args[3] != nil {
+			arg3 = args[3].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetWebhook_Call) Return(webhookResponseType domain.WebhookResponseType, err error) *MockWebhookUseCase_GetWebhook_Call {
+	_c.Call.Return(webhookResponseType, err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetWebhook_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, opts domain.ResponseOptions) (domain.WebhookResponseType, error)) *MockWebhookUseCase_GetWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWebhooks provides a mock function for the type MockWebhookUseCase
+func (_mock *MockWebhookUseCase) GetWebhooks(ctx context.Context, apiKeyID uuid.UUID, opts domain.ResponseOptions) (domain.ApiResponse[domain.WebhookResponseType], error) {
+	ret := _mock.Called(ctx, apiKeyID, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWebhooks")
+	}
+
+	var r0 domain.ApiResponse[domain.WebhookResponseType]
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.ResponseOptions) (domain.ApiResponse[domain.WebhookResponseType], error)); ok {
+		return returnFunc(ctx, apiKeyID, opts)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.ResponseOptions) domain.ApiResponse[domain.WebhookResponseType]); ok {
+		r0 = returnFunc(ctx, apiKeyID, opts)
+	} else {
+		r0 = ret.Get(0).(domain.ApiResponse[domain.WebhookResponseType])
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, domain.ResponseOptions) error); ok {
+		r1 = returnFunc(ctx, apiKeyID, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockWebhookUseCase_GetWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWebhooks'
+type MockWebhookUseCase_GetWebhooks_Call struct {
+	*mock.Call
+}
+
+// GetWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKeyID uuid.UUID
+//   - opts domain.ResponseOptions
+func (_e *MockWebhookUseCase_Expecter) GetWebhooks(ctx interface{}, apiKeyID interface{}, opts interface{}) *MockWebhookUseCase_GetWebhooks_Call {
+	return &MockWebhookUseCase_GetWebhooks_Call{Call: _e.mock.On("GetWebhooks", ctx, apiKeyID, opts)}
+}
+
+func (_c *MockWebhookUseCase_GetWebhooks_Call) Run(run func(ctx context.Context, apiKeyID uuid.UUID, opts domain.ResponseOptions)) *MockWebhookUseCase_GetWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 domain.ResponseOptions
+		if args[2] != nil {
+			arg2 = args[2].(domain.ResponseOptions)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetWebhooks_Call) Return(apiResponse domain.ApiResponse[domain.WebhookResponseType], err error) *MockWebhookUseCase_GetWebhooks_Call {
+	_c.Call.Return(apiResponse, err)
+	return _c
+}
+
+func (_c *MockWebhookUseCase_GetWebhooks_Call) RunAndReturn(run func(ctx context.Context, apiKeyID uuid.UUID, opts domain.ResponseOptions) (domain.ApiResponse[domain.WebhookResponseType], error)) *MockWebhookUseCase_GetWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
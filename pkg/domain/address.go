@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrInvalidAddress is returned when a string is not a valid Tezos address.
+var ErrInvalidAddress = errors.New("invalid tezos address")
+
+// Address is a base58check encoded Tezos address.
+type Address string
+
+// AddressKind is the kind of account an address points to, named after its prefix.
+type AddressKind string
+
+const (
+	AddressKindEd25519     AddressKind = "tz1"
+	AddressKindSecp256k1   AddressKind = "tz2"
+	AddressKindP256        AddressKind = "tz3"
+	AddressKindBLS         AddressKind = "tz4"
+	AddressKindContract    AddressKind = "KT1"
+	AddressKindSmartRollup AddressKind = "sr1"
+)
+
+const (
+	addressLength        = 36
+	addressPayloadLength = 20
+	addressChecksumSize  = 4
+)
+
+// addressPrefixes holds the binary prefix of every supported kind, the base58
+// encoding of prefix+payload always starts with the kind's name.
+var addressPrefixes = map[AddressKind][]byte{
+	AddressKindEd25519:     {6, 161, 159},
+	AddressKindSecp256k1:   {6, 161, 161},
+	AddressKindP256:        {6, 161, 164},
+	AddressKindBLS:         {6, 161, 166},
+	AddressKindContract:    {2, 90, 121},
+	AddressKindSmartRollup: {6, 124, 117},
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// ParseAddress validates s as a base58check Tezos address with a known prefix.
+func ParseAddress(s string) (Address, error) {
+	if len(s) != addressLength {
+		return "", fmt.Errorf("%w: %q must be %d characters long", ErrInvalidAddress, s, addressLength)
+	}
+
+	kind := AddressKind(s[:3])
+	prefix, ok := addressPrefixes[kind]
+	if !ok {
+		return "", fmt.Errorf("%w: %q has an unknown prefix", ErrInvalidAddress, s)
+	}
+
+	decoded, err := base58Decode(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q: %v", ErrInvalidAddress, s, err)
+	}
+
+	if len(decoded) != len(prefix)+addressPayloadLength+addressChecksumSize {
+		return "", fmt.Errorf("%w: %q has an invalid payload length", ErrInvalidAddress, s)
+	}
+
+	body := decoded[:len(decoded)-addressChecksumSize]
+	if !bytes.Equal(checksum(body), decoded[len(body):]) {
+		return "", fmt.Errorf("%w: %q has an invalid checksum", ErrInvalidAddress, s)
+	}
+
+	if !bytes.Equal(body[:len(prefix)], prefix) {
+		return "", fmt.Errorf("%w: %q has an invalid prefix", ErrInvalidAddress, s)
+	}
+
+	return Address(s), nil
+}
+
+// Kind returns the kind of the address. The result is only meaningful for an
+// address obtained from ParseAddress.
+func (a Address) Kind() AddressKind {
+	if len(a) < 3 {
+		return ""
+	}
+	return AddressKind(a[:3])
+}
+
+// IsImplicit reports whether the address is an implicit (tz) account, the only
+// kind that can be a baker.
+func (a Address) IsImplicit() bool {
+	switch a.Kind() {
+	case AddressKindEd25519, AddressKindSecp256k1, AddressKindP256, AddressKindBLS:
+		return true
+	default:
+		return false
+	}
+}
+
+func (a Address) String() string {
+	return string(a)
+}
+
+func checksum(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:addressChecksumSize]
+}
+
+func base58Decode(s string) ([]byte, error) {
+	value := new(big.Int)
+	radix := big.NewInt(58)
+
+	leadingZeros := 0
+	for i := 0; i < len(s) && s[i] == base58Alphabet[0]; i++ {
+		leadingZeros++
+	}
+
+	for _, r := range []byte(s) {
+		index := bytes.IndexByte([]byte(base58Alphabet), r)
+		if index < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", r)
+		}
+		value.Mul(value, radix)
+		value.Add(value, big.NewInt(int64(index)))
+	}
+
+	return append(make([]byte, leadingZeros), value.Bytes()...), nil
+}
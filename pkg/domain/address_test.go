@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		address      string
+		wantErr      bool
+		expectedKind AddressKind
+		implicit     bool
+	}{
+		{
+			name:         "Valid_Tz1",
+			address:      "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb",
+			expectedKind: AddressKindEd25519,
+			implicit:     true,
+		},
+		{
+			name:         "Valid_Tz2",
+			address:      "tz2BFTyPeYRzxd5aiBchbXN3WCZhx7BqbMBq",
+			expectedKind: AddressKindSecp256k1,
+			implicit:     true,
+		},
+		{
+			name:         "Valid_Tz3",
+			address:      "tz3WXYtyDUNL91qfiCJtVUX746QpNv5i5ve5",
+			expectedKind: AddressKindP256,
+			implicit:     true,
+		},
+		{
+			name:         "Valid_Tz4",
+			address:      "tz4HVR6aty9KwsQFHh81C1G7gBdhxT8kuytm",
+			expectedKind: AddressKindBLS,
+			implicit:     true,
+		},
+		{
+			name:         "Valid_KT1",
+			address:      "KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn",
+			expectedKind: AddressKindContract,
+			implicit:     false,
+		},
+		{
+			name:         "Valid_Sr1",
+			address:      "sr1Ghq66tYK9y3r8CC1Tf8i8m5nxh8nTvZEf",
+			expectedKind: AddressKindSmartRollup,
+			implicit:     false,
+		},
+		{
+			name:    "Truncated_Address",
+			address: "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjc",
+			wantErr: true,
+		},
+		{
+			name:    "Empty_Address",
+			address: "",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown_Prefix",
+			address: "tz5VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid_Checksum",
+			address: "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjc",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid_Base58_Character",
+			address: "tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcj0",
+			wantErr: true,
+		},
+		{
+			name:    "Mismatched_Prefix",
+			address: "KT1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			address, err := ParseAddress(tt.address)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAddress)
+				assert.Empty(t, address)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.address, address.String())
+			assert.Equal(t, tt.expectedKind, address.Kind())
+			assert.Equal(t, tt.implicit, address.IsImplicit())
+		})
+	}
+}
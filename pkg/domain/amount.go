@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidUnit is returned when an amount unit is not supported.
+	ErrInvalidUnit = errors.New("invalid unit")
+	// ErrInvalidAmount is returned when an amount can not be parsed.
+	ErrInvalidAmount = errors.New("invalid amount")
+)
+
+// MutezPerTez is the number of mutez in one tez.
+const MutezPerTez = 1_000_000
+
+// Mutez is an amount of tez expressed in its smallest unit.
+type Mutez int64
+
+// Unit is the unit amounts are rendered in by the API.
+type Unit string
+
+const (
+	UnitMutez Unit = "mutez"
+	UnitTez   Unit = "tez"
+)
+
+// ParseUnit validates a unit name, an empty name defaults to UnitMutez.
+func ParseUnit(s string) (Unit, error) {
+	switch Unit(s) {
+	case "", UnitMutez:
+		return UnitMutez, nil
+	case UnitTez:
+		return UnitTez, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected %q or %q", ErrInvalidUnit, s, UnitTez, UnitMutez)
+	}
+}
+
+// ParseAmount parses a non-negative amount expressed in unit, tez accept up to 6 decimals.
+func ParseAmount(s string, unit Unit) (Mutez, error) {
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if unit != UnitTez && hasFraction {
+		return 0, fmt.Errorf("%w: %q, mutez have no decimals", ErrInvalidAmount, s)
+	}
+	if len(fraction) > 6 || (hasFraction && fraction == "") {
+		return 0, fmt.Errorf("%w: %q, expected at most 6 decimals", ErrInvalidAmount, s)
+	}
+
+	value, err := strconv.ParseUint(whole, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if unit != UnitTez {
+		return Mutez(value), nil
+	}
+
+	decimals := uint64(0)
+	if fraction != "" {
+		decimals, err = strconv.ParseUint(fraction+strings.Repeat("0", 6-len(fraction)), 10, 63)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+		}
+	}
+	if value > (1<<63-1-decimals)/MutezPerTez {
+		return 0, fmt.Errorf("%w: %q, out of range", ErrInvalidAmount, s)
+	}
+	return Mutez(value*MutezPerTez + decimals), nil
+}
+
+// Amount is an amount of mutez rendered in a given unit. It serializes as a
+// JSON string so that large values survive JavaScript number precision.
+type Amount struct {
+	Mutez Mutez
+	Unit  Unit
+}
+
+// NewAmount returns the amount of mutez rendered in unit.
+func NewAmount(mutez int64, unit Unit) Amount {
+	return Amount{Mutez: Mutez(mutez), Unit: unit}
+}
+
+// String formats the amount in its unit, tez are formatted with 6 decimals.
+func (a Amount) String() string {
+	if a.Unit != UnitTez {
+		return strconv.FormatInt(int64(a.Mutez), 10)
+	}
+
+	sign := ""
+	value := uint64(a.Mutez)
+	if a.Mutez < 0 {
+		sign = "-"
+		value = uint64(-a.Mutez)
+	}
+	return fmt.Sprintf("%s%d.%06d", sign, value/MutezPerTez, value%MutezPerTez)
+}
+
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(a.String())), nil
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUnit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		unit     string
+		expected Unit
+		wantErr  bool
+	}{
+		{
+			name:     "Default_Mutez",
+			unit:     "",
+			expected: UnitMutez,
+		},
+		{
+			name:     "Mutez",
+			unit:     "mutez",
+			expected: UnitMutez,
+		},
+		{
+			name:     "Tez",
+			unit:     "tez",
+			expected: UnitTez,
+		},
+		{
+			name:    "Unknown_Unit",
+			unit:    "xtz",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			unit, err := ParseUnit(tt.unit)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidUnit)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, unit)
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		unit     Unit
+		expected Mutez
+		wantErr  bool
+	}{
+		{
+			name:     "Mutez",
+			input:    "1500000",
+			unit:     UnitMutez,
+			expected: 1500000,
+		},
+		{
+			name:     "Tez_Whole",
+			input:    "100000",
+			unit:     UnitTez,
+			expected: 100000000000,
+		},
+		{
+			name:     "Tez_Decimals",
+			input:    "1.5",
+			unit:     UnitTez,
+			expected: 1500000,
+		},
+		{
+			name:     "Tez_Six_Decimals",
+			input:    "0.000001",
+			unit:     UnitTez,
+			expected: 1,
+		},
+		{
+			name:    "Tez_Too_Many_Decimals",
+			input:   "0.0000001",
+			unit:    UnitTez,
+			wantErr: true,
+		},
+		{
+			name:    "Mutez_Decimals",
+			input:   "1.5",
+			unit:    UnitMutez,
+			wantErr: true,
+		},
+		{
+			name:    "Negative",
+			input:   "-1",
+			unit:    UnitMutez,
+			wantErr: true,
+		},
+		{
+			name:    "Trailing_Dot",
+			input:   "1.",
+			unit:    UnitTez,
+			wantErr: true,
+		},
+		{
+			name:    "Tez_Out_Of_Range",
+			input:   "9223372036855",
+			unit:    UnitTez,
+			wantErr: true,
+		},
+		{
+			name:    "Not_A_Number",
+			input:   "lots",
+			unit:    UnitMutez,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			amount, err := ParseAmount(tt.input, tt.unit)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidAmount)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, amount)
+		})
+	}
+}
+
+func TestAmount_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		amount   Amount
+		expected string
+	}{
+		{
+			name:     "Mutez",
+			amount:   NewAmount(1234567, UnitMutez),
+			expected: `"1234567"`,
+		},
+		{
+			name:     "Mutez_Beyond_JS_Precision",
+			amount:   NewAmount(9007199254740993, UnitMutez),
+			expected: `"9007199254740993"`,
+		},
+		{
+			name:     "Tez",
+			amount:   NewAmount(1234567, UnitTez),
+			expected: `"1.234567"`,
+		},
+		{
+			name:     "Tez_Below_One",
+			amount:   NewAmount(42, UnitTez),
+			expected: `"0.000042"`,
+		},
+		{
+			name:     "Tez_Negative",
+			amount:   NewAmount(-1500000, UnitTez),
+			expected: `"-1.500000"`,
+		},
+		{
+			name:     "Zero_Value_Defaults_To_Mutez",
+			amount:   Amount{Mutez: 10},
+			expected: `"10"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(tt.amount)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix starts every issued API key, so that leaked keys are easy to scan for.
+const APIKeyPrefix = "dlg_"
+
+// ErrInvalidAPIKey is returned when an API key is unknown or was revoked.
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// ErrQuotaExceeded is returned when an API key spent its daily quota.
+var ErrQuotaExceeded = errors.New("daily quota exceeded")
+
+// IssueAPIKeyDTO is an API key to issue, nil limits take the configured defaults.
+// Zero limits are unlimited.
+type IssueAPIKeyDTO struct {
+	Name       string
+	RateLimit  *float64
+	RateBurst  *int
+	DailyQuota *int64
+}
+
+// APIKey is an authenticated API key with its limits. RateLimit is in requests
+// per second, zero limits are unlimited.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	RateLimit  float64
+	RateBurst  int
+	DailyQuota int64
+}
+
+// APIKeyQuota is the daily quota of a key after a request, Limit is zero when
+// the key has no quota. Reset is the start of the next UTC day.
+type APIKeyQuota struct {
+	Limit int64
+	Used  int64
+	Reset time.Time
+}
+
+// Remaining returns the number of requests left for the day.
+func (q APIKeyQuota) Remaining() int64 {
+	return max(q.Limit-q.Used, 0)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, key models.APIKey) (models.APIKey, error)
+	FindAPIKeys(ctx context.Context) ([]models.APIKey, error)
+	FindAPIKey(ctx context.Context, id uuid.UUID) (models.APIKey, error)
+	FindAPIKeyByHash(ctx context.Context, hash string) (models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	// IncrementUsage adds requests to the usage of a key on day and returns the
+	// requests of that day.
+	IncrementUsage(ctx context.Context, id uuid.UUID, day time.Time, requests int64) (int64, error)
+	// FindUsage returns the daily usage of a key since from, newest first.
+	FindUsage(ctx context.Context, id uuid.UUID, from time.Time) ([]models.APIKeyUsage, error)
+}
+
+type APIKeyUseCase interface {
+	IssueAPIKey(ctx context.Context, dto IssueAPIKeyDTO) (APIKeyResponseType, error)
+	GetAPIKeys(ctx context.Context) (ApiResponse[APIKeyResponseType], error)
+	GetAPIKey(ctx context.Context, id uuid.UUID) (APIKeyResponseType, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) error
+	GetUsage(ctx context.Context, id uuid.UUID, days int) (ApiResponse[APIKeyUsageResponseType], error)
+	// Authenticate returns the key matching a raw API key, or ErrInvalidAPIKey.
+	Authenticate(ctx context.Context, key string) (APIKey, error)
+	// RecordUsage counts a request of a key, it returns ErrQuotaExceeded with the
+	// quota once the daily quota is spent. The requests are stored by FlushUsage.
+	RecordUsage(ctx context.Context, key APIKey) (APIKeyQuota, error)
+	// FlushUsage stores the requests counted since the last flush.
+	FlushUsage(ctx context.Context) error
+}
+
+// APIKeyResponseType is an issued API key, Key is only returned when the key
+// is issued.
+type APIKeyResponseType struct {
+	ID         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Prefix     string    `json:"prefix"`
+	Key        string    `json:"key,omitempty"`
+	RateLimit  float64   `json:"rate_limit"`
+	RateBurst  int       `json:"rate_burst"`
+	DailyQuota int64     `json:"daily_quota"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// APIKeyUsageResponseType is the number of requests of a key during a UTC day,
+// formatted as 2006-01-02.
+type APIKeyUsageResponseType struct {
+	Day      string `json:"day"`
+	Requests int64  `json:"requests"`
+}
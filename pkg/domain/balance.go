@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"time"
+)
+
+// AccountService fetches the current state of accounts.
+type AccountService interface {
+	GetAccounts(ctx context.Context, addresses []string) ([]TzktApiAccountResponse, error)
+}
+
+// BalanceHistoryFilter bounds the history of a baker delegated balance. Nil
+// fields are not filtered on.
+type BalanceHistoryFilter struct {
+	From *time.Time
+	To   *time.Time
+}
+
+type BalanceRepository interface {
+	FindActiveDelegators(ctx context.Context) ([]string, error)
+	SaveBalances(ctx context.Context, balances []models.DelegatorBalance) error
+	SnapshotBakerBalances(ctx context.Context, takenAt time.Time) error
+	FindLatestBakerBalance(ctx context.Context, address Address) (models.BakerBalanceSnapshot, error)
+	FindBakerBalances(ctx context.Context, address Address, filter BalanceHistoryFilter) ([]models.BakerBalanceSnapshot, error)
+}
+
+type BalanceUseCase interface {
+	// Refresh fetches the balance of every active delegator and snapshots the
+	// delegated balance of every baker.
+	Refresh(ctx context.Context) error
+	GetBakerBalance(ctx context.Context, address Address, filter BalanceHistoryFilter, opts ResponseOptions) (BakerBalanceResponseType, error)
+}
+
+type BakerBalanceResponseType struct {
+	Baker            Address                `json:"baker"`
+	DelegatedBalance Amount                 `json:"delegated_balance"`
+	Delegators       int64                  `json:"delegators"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+	History          []BakerBalanceSnapshot `json:"history"`
+}
+
+type BakerBalanceSnapshot struct {
+	Timestamp        time.Time `json:"timestamp"`
+	DelegatedBalance Amount    `json:"delegated_balance"`
+	Delegators       int64     `json:"delegators"`
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// IndexHead is the latest operation indexed, the responses built from the
+// indexed delegations are versioned by it.
+type IndexHead struct {
+	Level     int64
+	Timestamp time.Time
+}
+
+// IndexHeadTracker follows the head of the indexed delegations.
+type IndexHeadTracker interface {
+	// Current returns the indexed head.
+	Current(ctx context.Context) (IndexHead, error)
+	// Advance records a commit of the indexer up to head, the responses cached
+	// at an older head are invalidated.
+	Advance(ctx context.Context, head IndexHead)
+}
+
+// CachedResponse is a rendered response. Level is the indexed level it was
+// rendered at, zero when the response is final. A final response is kept until
+// Expires rather than invalidated, as it may have been rendered before its cycle
+// was fully indexed.
+type CachedResponse struct {
+	Level       int64     `json:"level"`
+	Expires     time.Time `json:"expires,omitzero"`
+	ContentType string    `json:"content_type"`
+	Body        []byte    `json:"body"`
+}
+
+// Expired reports whether a final response must be rendered again at now.
+func (r CachedResponse) Expired(now time.Time) bool {
+	return !r.Expires.IsZero() && !now.Before(r.Expires)
+}
+
+// ResponseStore keeps the rendered responses by key.
+type ResponseStore interface {
+	Get(ctx context.Context, key string) (CachedResponse, bool, error)
+	Set(ctx context.Context, key string, res CachedResponse) error
+	// Invalidate drops the responses rendered before level, the final ones are kept.
+	Invalidate(ctx context.Context, level int64) error
+}
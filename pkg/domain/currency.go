@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCurrency is returned when a currency is not quoted by TzKT.
+var ErrInvalidCurrency = errors.New("invalid currency")
+
+// Currency is a currency TzKT quotes tez in.
+type Currency string
+
+const (
+	CurrencyBTC Currency = "btc"
+	CurrencyEUR Currency = "eur"
+	CurrencyUSD Currency = "usd"
+	CurrencyCNY Currency = "cny"
+	CurrencyJPY Currency = "jpy"
+	CurrencyKRW Currency = "krw"
+	CurrencyETH Currency = "eth"
+	CurrencyGBP Currency = "gbp"
+)
+
+// Currencies lists every supported currency.
+var Currencies = []Currency{
+	CurrencyBTC, CurrencyEUR, CurrencyUSD, CurrencyCNY,
+	CurrencyJPY, CurrencyKRW, CurrencyETH, CurrencyGBP,
+}
+
+// ParseCurrency validates a currency code, case insensitive.
+func ParseCurrency(s string) (Currency, error) {
+	for _, currency := range Currencies {
+		if Currency(strings.ToLower(s)) == currency {
+			return currency, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidCurrency, s)
+}
+
+// FiatValue is the value of an amount in a currency, using the quote at the time of the operation.
+type FiatValue struct {
+	Currency Currency `json:"currency"`
+	Quote    float64  `json:"quote"`
+	Value    float64  `json:"value"`
+}
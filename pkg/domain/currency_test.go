@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCurrency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		currency string
+		expected Currency
+		wantErr  bool
+	}{
+		{
+			name:     "USD",
+			currency: "usd",
+			expected: CurrencyUSD,
+		},
+		{
+			name:     "EUR",
+			currency: "eur",
+			expected: CurrencyEUR,
+		},
+		{
+			name:     "BTC",
+			currency: "btc",
+			expected: CurrencyBTC,
+		},
+		{
+			name:     "Unknown_Currency",
+			currency: "chf",
+			wantErr:  true,
+		},
+		{
+			name:     "Upper_Case",
+			currency: "USD",
+			expected: CurrencyUSD,
+		},
+		{
+			name:     "Mixed_Case",
+			currency: "Eur",
+			expected: CurrencyEUR,
+		},
+		{
+			name:     "Empty",
+			currency: "",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			currency, err := ParseCurrency(tt.currency)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidCurrency)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, currency)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+)
+
+// ProtocolService fetches the Tezos protocols with their constants.
+type ProtocolService interface {
+	GetProtocols(ctx context.Context) ([]TzktApiProtocolResponse, error)
+}
+
+// CycleMapper maps a block level to the cycle it belongs to.
+type CycleMapper interface {
+	// CycleOf reports false when no known protocol covers level.
+	CycleOf(level int64) (int64, bool)
+}
+
+type CycleRepository interface {
+	SaveProtocols(ctx context.Context, protocols []models.Protocol) error
+	FindProtocols(ctx context.Context) ([]models.Protocol, error)
+	// AssignCycles sets the cycle of the delegations whose cycle is missing or
+	// outdated according to the stored protocols, and returns how many changed.
+	AssignCycles(ctx context.Context) (int64, error)
+	FindBakers(ctx context.Context, cycle int64) ([]models.CycleBakerStats, error)
+}
+
+type CycleUseCase interface {
+	// Sync refreshes the protocols from TzKT, falling back to the stored ones when
+	// TzKT is unavailable, and assigns their cycle to the delegations.
+	Sync(ctx context.Context) error
+	GetBakers(ctx context.Context, cycle int64, opts ResponseOptions) (ApiResponse[CycleBakerResponseType], error)
+}
+
+type CycleBakerResponseType struct {
+	Cycle               int64   `json:"cycle"`
+	Address             Address `json:"address"`
+	Alias               *string `json:"alias,omitempty"`
+	DelegationsReceived int64   `json:"delegations_received"`
+	NewDelegators       int64   `json:"new_delegators"`
+	Departures          int64   `json:"departures"`
+	Delegators          int64   `json:"delegators"`
+	DelegatedAmount     Amount  `json:"delegated_amount"`
+}
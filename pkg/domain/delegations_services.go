@@ -1,6 +1,9 @@
 package domain
 
+import "context"
+
 type DelegationService interface {
-	GetDelegations() ([]TzktApiDelegationsResponse, error)
-	GetDelegationsFromLevel(lastLevel int64, limit int) ([]TzktApiDelegationsResponse, error)
+	GetDelegations(ctx context.Context) ([]TzktApiDelegationsResponse, error)
+	GetDelegationsFromLevel(ctx context.Context, lastLevel int64, limit int) ([]TzktApiDelegationsResponse, error)
+	GetStakingFromLevel(ctx context.Context, lastLevel int64, limit int) ([]TzktApiStakingResponse, error)
 }
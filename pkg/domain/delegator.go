@@ -8,16 +8,112 @@ import (
 type CreateDelegationDTO struct {
 	Baker      models.Baker
 	Delegation models.Delegation
+	Quote      *models.DelegationQuote
+	// DeactivatedBaker is set when a registered baker delegates away from itself.
+	DeactivatedBaker *string
+}
+
+// DelegationBatch is a batch of indexed operations, stored in a single transaction so
+// that the checkpoint never moves past operations that were not stored.
+type DelegationBatch struct {
+	Delegations []CreateDelegationDTO
+	// Failed are the operations that did not take effect.
+	Failed []models.FailedDelegation
+	// WebhookDeliveries are the pending deliveries of the delegations.
+	WebhookDeliveries []models.WebhookDelivery
+}
+
+// DelegationFilter narrows the delegations returned by the use case and the repository.
+// Nil fields are not filtered on.
+type DelegationFilter struct {
+	Delegator *Address
+	Baker     *Address
+	Cycle     *int64
+	// Status selects applied delegations when empty, or the stored delegations
+	// that did not take effect with that status.
+	Status OperationStatus
+	// AfterOperationID only selects the delegations with a greater TzKT operation id.
+	AfterOperationID *int64
+}
+
+// DelegationGroup is the address the delegations are paged by when the pages of
+// several delegators or bakers are fetched at once.
+type DelegationGroup string
+
+const (
+	GroupByDelegator DelegationGroup = "delegator"
+	GroupByBaker     DelegationGroup = "baker"
+)
+
+// Matches reports whether an applied delegation is selected by the filter.
+func (f DelegationFilter) Matches(delegation models.Delegation) bool {
+	if f.Delegator != nil && f.Delegator.String() != delegation.Delegator {
+		return false
+	}
+	if f.Baker != nil && f.Baker.String() != delegation.BakerID {
+		return false
+	}
+	if f.Cycle != nil && (delegation.Cycle == nil || *delegation.Cycle != *f.Cycle) {
+		return false
+	}
+	if f.AfterOperationID != nil && (delegation.OperationID == nil || *delegation.OperationID <= *f.AfterOperationID) {
+		return false
+	}
+	return true
 }
 
 type Repository interface {
-	Create(ctx context.Context, delegationToCreate []CreateDelegationDTO) error
-	FindAll(ctx context.Context) ([]models.Delegation, error)
+	Create(ctx context.Context, batch DelegationBatch) error
+	FindAll(ctx context.Context, filter DelegationFilter) ([]models.Delegation, error)
+	FindFailed(ctx context.Context, filter DelegationFilter) ([]models.FailedDelegation, error)
+	StreamAll(ctx context.Context, filter DelegationFilter, fn func(models.Delegation) error) error
+	StreamFailed(ctx context.Context, filter DelegationFilter, fn func(models.FailedDelegation) error) error
+	// FindPage returns up to limit applied delegations matching the filter, newest
+	// first, starting after the cursor when it is set.
+	FindPage(ctx context.Context, filter DelegationFilter, after *DelegationCursor, limit int) ([]models.Delegation, error)
+	// FindFailedPage returns up to limit stored delegations that did not take effect
+	// matching the filter, newest first, starting after the cursor when it is set.
+	FindFailedPage(ctx context.Context, filter DelegationFilter, after *DelegationCursor, limit int) ([]models.FailedDelegation, error)
+	// FindPages returns up to limit applied delegations of each of the addresses,
+	// grouped by address and newest first, starting after the cursor when it is set.
+	FindPages(ctx context.Context, group DelegationGroup, addresses []Address, after *DelegationCursor, limit int) ([]models.Delegation, error)
+	// FindLatest returns the latest delegation of each delegator that delegated.
+	FindLatest(ctx context.Context, delegators []Address) ([]models.Delegation, error)
+	// FindCurrentDelegations returns the latest delegation of the delegators currently
+	// delegating to baker, ordered by delegator and starting after the given one.
+	FindCurrentDelegations(ctx context.Context, baker Address, after *Address, limit int) ([]models.Delegation, error)
+	FindBakers(ctx context.Context, address *Address) ([]models.BakerStats, error)
+	FindBakersByAddress(ctx context.Context, addresses []Address) ([]models.BakerStats, error)
 	GetLastProcessedLevel(ctx context.Context) (int64, error)
+	// GetIndexHead returns the level and timestamp of the latest stored delegation,
+	// failed ones included, or a zero head when none is stored.
+	GetIndexHead(ctx context.Context) (IndexHead, error)
 	CountDelegations(ctx context.Context) (int64, error)
 }
 
 type UseCase interface {
 	Create(ctx context.Context, data []TzktApiDelegationsResponse) error // should be a dto here instead of the api resp
-	GetDelegations(ctx context.Context) (ApiResponse[DelegationsResponseType], error)
+	GetDelegations(ctx context.Context, filter DelegationFilter, opts ResponseOptions) (ApiResponse[DelegationsResponseType], error)
+	// ExportDelegations calls fn with every delegation matching the filter, oldest first,
+	// without loading them all in memory.
+	ExportDelegations(ctx context.Context, filter DelegationFilter, opts ResponseOptions, fn func(DelegationsResponseType) error) error
+	// WatchDelegations calls fn with the delegations after filter.AfterOperationID, then
+	// with every matching delegation as soon as it is indexed, until ctx is done.
+	WatchDelegations(ctx context.Context, filter DelegationFilter, opts ResponseOptions, fn func(DelegationsResponseType) error) error
+	GetBakers(ctx context.Context, opts ResponseOptions) (ApiResponse[BakerResponseType], error)
+	GetBaker(ctx context.Context, address Address, opts ResponseOptions) (BakerResponseType, error)
+	// GetDelegationsPage returns a page of the delegations matching the filter, newest first.
+	GetDelegationsPage(ctx context.Context, filter DelegationFilter, page Page, opts ResponseOptions) (PageResponse[DelegationsResponseType], error)
+	// GetDelegationsPages returns the same page of the applied delegations of each of
+	// the addresses, in a single query.
+	GetDelegationsPages(ctx context.Context, group DelegationGroup, addresses []Address, page Page, opts ResponseOptions) (map[Address]PageResponse[DelegationsResponseType], error)
+	// GetBakersPage returns a page of the bakers, by delegated amount.
+	GetBakersPage(ctx context.Context, page Page, opts ResponseOptions) (PageResponse[BakerResponseType], error)
+	// GetBakersByAddress returns the bakers that exist among addresses.
+	GetBakersByAddress(ctx context.Context, addresses []Address, opts ResponseOptions) (map[Address]BakerResponseType, error)
+	// GetLatestDelegations returns the latest delegation of each delegator that delegated.
+	GetLatestDelegations(ctx context.Context, delegators []Address, opts ResponseOptions) (map[Address]DelegationsResponseType, error)
+	// GetCurrentDelegations returns a page of the latest delegation of the delegators
+	// currently delegating to baker, by delegator address.
+	GetCurrentDelegations(ctx context.Context, baker Address, page Page, opts ResponseOptions) (PageResponse[DelegationsResponseType], error)
 }
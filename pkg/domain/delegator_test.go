@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"delegator/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelegationFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	delegator := Address("tz1LPyr1AnE8q6Fs9SkQvsyBRXafqQYgWnpT")
+	baker := Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	otherBaker := Address("tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6")
+	cycle := int64(7)
+	otherCycle := int64(8)
+	operationID := int64(42)
+	delegation := models.Delegation{
+		Delegator:   delegator.String(),
+		BakerID:     baker.String(),
+		Cycle:       &cycle,
+		OperationID: &operationID,
+	}
+	before := operationID - 1
+
+	tests := []struct {
+		name     string
+		filter   DelegationFilter
+		expected bool
+	}{
+		{name: "Empty", filter: DelegationFilter{}, expected: true},
+		{name: "Delegator_And_Baker", filter: DelegationFilter{Delegator: &delegator, Baker: &baker}, expected: true},
+		{name: "Other_Baker", filter: DelegationFilter{Baker: &otherBaker}, expected: false},
+		{name: "Cycle", filter: DelegationFilter{Cycle: &cycle}, expected: true},
+		{name: "Other_Cycle", filter: DelegationFilter{Cycle: &otherCycle}, expected: false},
+		{name: "After_Previous_Operation", filter: DelegationFilter{AfterOperationID: &before}, expected: true},
+		{name: "After_Same_Operation", filter: DelegationFilter{AfterOperationID: &operationID}, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.filter.Matches(delegation))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+)
+
+// ErrorCode classifies the errors returned to the clients.
+type ErrorCode string
+
+const (
+	CodeInvalidArgument   ErrorCode = "invalid_argument"
+	CodeUnauthenticated   ErrorCode = "unauthenticated"
+	CodePermissionDenied  ErrorCode = "permission_denied"
+	CodeNotFound          ErrorCode = "not_found"
+	CodeMethodNotAllowed  ErrorCode = "method_not_allowed"
+	CodeNotAcceptable     ErrorCode = "not_acceptable"
+	CodePayloadTooLarge   ErrorCode = "payload_too_large"
+	CodeResourceExhausted ErrorCode = "resource_exhausted"
+	CodeInternal          ErrorCode = "internal"
+	CodeUnavailable       ErrorCode = "unavailable"
+)
+
+// APIError is an error returned to the clients. Code, Message and Details are
+// returned as is, Err is the cause, which is only logged.
+type APIError struct {
+	Code    ErrorCode
+	Message string
+	Details map[string]any
+	Err     error
+}
+
+func (e *APIError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// WithDetail returns a copy of the error with a detail added.
+func (e *APIError) WithDetail(key string, value any) *APIError {
+	res := *e
+	res.Details = maps.Clone(e.Details)
+	if res.Details == nil {
+		res.Details = make(map[string]any, 1)
+	}
+	res.Details[key] = value
+	return &res
+}
+
+// NewError returns an error of code with a formatted message.
+func NewError(code ErrorCode, format string, args ...any) *APIError {
+	return &APIError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// WrapError returns an error of code with the message of err, which must be fit
+// for the clients.
+func WrapError(code ErrorCode, err error) *APIError {
+	return &APIError{Code: code, Message: err.Error(), Err: err}
+}
+
+// InvalidArgument returns an invalid argument error with the message of err,
+// which tells the client what to fix.
+func InvalidArgument(err error) *APIError {
+	return WrapError(CodeInvalidArgument, err)
+}
+
+// NotFound returns a not found error.
+func NotFound(message string) *APIError {
+	return &APIError{Code: CodeNotFound, Message: message, Err: ErrNotFound}
+}
+
+// Internal returns an internal error whose cause is hidden from the clients. The
+// timeouts and connection failures are unavailable errors, worth a retry.
+func Internal(message string, err error) *APIError {
+	code := CodeInternal
+	if isUnavailable(err) {
+		code = CodeUnavailable
+	}
+	return &APIError{Code: code, Message: message, Err: err}
+}
+
+// CodeOf returns the code of err, CodeInternal when it is not an *APIError.
+func CodeOf(err error) ErrorCode {
+	var e *APIError
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeInternal
+}
+
+func isUnavailable(err error) bool {
+	var netErr net.Error
+	return errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.As(err, &netErr)
+}
+
+// ErrNotFound is returned when a requested resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrSubscriptionClosed is returned when a subscriber fell too far behind the
+// published events and was dropped.
+var ErrSubscriptionClosed = errors.New("subscription closed")
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIError_WithDetail(t *testing.T) {
+	t.Parallel()
+
+	base := NotFound("baker not found")
+	err := base.WithDetail("address", "tz1abc")
+
+	assert.Equal(t, map[string]any{"address": "tz1abc"}, err.Details)
+	assert.Nil(t, base.Details)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, "baker not found: not found", err.Error())
+}
+
+func TestInternal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected ErrorCode
+	}{
+		{name: "Internal", err: errors.New("boom"), expected: CodeInternal},
+		{name: "Deadline_Exceeded", err: fmt.Errorf("query: %w", context.DeadlineExceeded), expected: CodeUnavailable},
+		{name: "Bad_Connection", err: driver.ErrBadConn, expected: CodeUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Internal("failed to get delegations", tt.err)
+			assert.Equal(t, tt.expected, err.Code)
+			assert.Equal(t, "failed to get delegations", err.Message)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, CodeInvalidArgument, CodeOf(fmt.Errorf("wrapped: %w", InvalidArgument(errors.New("invalid limit")))))
+	assert.Equal(t, CodeInternal, CodeOf(errors.New("boom")))
+}
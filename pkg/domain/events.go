@@ -0,0 +1,13 @@
+package domain
+
+import "delegator/internal/models"
+
+// DelegationBroker fans the delegations committed by the indexer out to the
+// in-process subscribers.
+type DelegationBroker interface {
+	Publish(delegations []models.Delegation)
+	// Subscribe returns the channel the delegations matching filter are sent on and the
+	// function releasing it. The channel is closed when the subscriber is released or
+	// dropped for falling behind.
+	Subscribe(filter DelegationFilter) (<-chan models.Delegation, func())
+}
@@ -2,12 +2,76 @@ package domain
 
 import "time"
 
+// ResponseOptions controls how the use cases render their responses.
+type ResponseOptions struct {
+	Units Unit
+	// Currency, when set, adds the fiat value of amounts in that currency.
+	Currency Currency
+	// Expand adds the full operation metadata to delegations.
+	Expand bool
+}
+
+// Unit returns the unit amounts are rendered in, mutez by default.
+func (o ResponseOptions) Unit() Unit {
+	if o.Units == "" {
+		return UnitMutez
+	}
+	return o.Units
+}
+
 type DelegationsResponseType struct {
-	Timestamp time.Time `json:"timestamp"`
-	Amount    int64     `json:"amount"`
-	Delegator string    `json:"delegator"`
-	Level     int64     `json:"level"`
+	Timestamp time.Time  `json:"timestamp"`
+	Amount    Amount     `json:"amount"`
+	Delegator Address    `json:"delegator"`
+	Level     int64      `json:"level"`
+	Cycle     *int64     `json:"cycle,omitempty"`
+	FiatValue *FiatValue `json:"fiat_value,omitempty"`
+	// Status and Errors are only set for delegations that did not take effect.
+	Status OperationStatus `json:"status,omitempty"`
+	Errors []string        `json:"errors,omitempty"`
+
+	*DelegationMetadata
 }
+
+// DelegationMetadata is the TzKT operation metadata of a delegation, only
+// rendered when ResponseOptions.Expand is set.
+type DelegationMetadata struct {
+	OperationID         *int64   `json:"operation_id"`
+	OperationHash       *string  `json:"operation_hash"`
+	Block               *string  `json:"block"`
+	Counter             *int64   `json:"counter"`
+	GasUsed             *int     `json:"gas_used"`
+	BakerFee            *Amount  `json:"baker_fee"`
+	Initiator           *Address `json:"initiator"`
+	InitiatorAlias      *string  `json:"initiator_alias"`
+	Nonce               *int     `json:"nonce"`
+	StakingUpdatesCount *int     `json:"staking_updates_count"`
+	SelfDelegation      bool     `json:"self_delegation"`
+	DelegatorAlias      *string  `json:"delegator_alias"`
+	Baker               *Address `json:"baker"`
+	BakerAlias          *string  `json:"baker_alias"`
+	PreviousBaker       *Address `json:"previous_baker"`
+	PreviousBakerAlias  *string  `json:"previous_baker_alias"`
+}
+
+type BakerResponseType struct {
+	Address                  Address    `json:"address"`
+	Alias                    *string    `json:"alias,omitempty"`
+	FirstSeen                time.Time  `json:"first_seen"`
+	LastSeen                 time.Time  `json:"last_seen"`
+	Active                   bool       `json:"active"`
+	RegistrationLevel        *int64     `json:"registration_level,omitempty"`
+	RegistrationTimestamp    *time.Time `json:"registration_timestamp,omitempty"`
+	DeactivationLevel        *int64     `json:"deactivation_level,omitempty"`
+	DeactivationTimestamp    *time.Time `json:"deactivation_timestamp,omitempty"`
+	TotalDelegationsReceived int64      `json:"total_delegations_received"`
+	Delegators               int64      `json:"delegators"`
+	DelegatedAmount          Amount     `json:"delegated_amount"`
+	Stakers                  int64      `json:"stakers"`
+	StakedAmount             Amount     `json:"staked_amount"`
+}
+
 type ApiResponse[T any] struct {
-	Data []T `json:"data"`
+	Data  []T  `json:"data"`
+	Units Unit `json:"units,omitempty"`
 }
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseOptions_Unit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		opts     ResponseOptions
+		expected Unit
+	}{
+		{
+			name:     "Empty_Defaults_To_Mutez",
+			expected: UnitMutez,
+		},
+		{
+			name:     "Tez",
+			opts:     ResponseOptions{Units: UnitTez},
+			expected: UnitTez,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.opts.Unit())
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"delegator/internal/models"
+	"errors"
+	"fmt"
+)
+
+// UndelegatedBaker is the placeholder baker undelegations are stored against.
+const UndelegatedBaker = "UNDELEGATED"
+
+// DelegationKind tells whether a delegation is the first of its delegator, a move
+// to another baker or a departure without a new baker.
+type DelegationKind string
+
+const (
+	KindNew          DelegationKind = "new"
+	KindRedelegation DelegationKind = "redelegation"
+	KindUndelegation DelegationKind = "undelegation"
+)
+
+// ErrInvalidDelegationKind is returned when a delegation kind is not supported.
+var ErrInvalidDelegationKind = errors.New("invalid delegation kind")
+
+// ParseDelegationKind validates a delegation kind.
+func ParseDelegationKind(s string) (DelegationKind, error) {
+	switch kind := DelegationKind(s); kind {
+	case KindNew, KindRedelegation, KindUndelegation:
+		return kind, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected %q, %q or %q", ErrInvalidDelegationKind, s, KindNew, KindRedelegation, KindUndelegation)
+	}
+}
+
+// KindOf returns the kind of a stored delegation.
+func KindOf(delegation models.Delegation) DelegationKind {
+	switch {
+	case delegation.BakerID == UndelegatedBaker:
+		return KindUndelegation
+	case delegation.PreviousBaker != nil:
+		return KindRedelegation
+	default:
+		return KindNew
+	}
+}
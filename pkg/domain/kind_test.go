@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"delegator/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKindOf(t *testing.T) {
+	t.Parallel()
+
+	previousBaker := "tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj"
+
+	tests := []struct {
+		name       string
+		delegation models.Delegation
+		expected   DelegationKind
+	}{
+		{
+			name:       "New",
+			delegation: models.Delegation{BakerID: "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6"},
+			expected:   KindNew,
+		},
+		{
+			name:       "Redelegation",
+			delegation: models.Delegation{BakerID: "tz1aSkwEot3L2kmUvcoxzjMomb9mvBNuzFK6", PreviousBaker: &previousBaker},
+			expected:   KindRedelegation,
+		},
+		{
+			name:       "Undelegation",
+			delegation: models.Delegation{BakerID: UndelegatedBaker, PreviousBaker: &previousBaker},
+			expected:   KindUndelegation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, KindOf(tt.delegation))
+		})
+	}
+}
+
+func TestParseDelegationKind(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected DelegationKind
+		wantErr  error
+	}{
+		{name: "New", input: "new", expected: KindNew},
+		{name: "Redelegation", input: "redelegation", expected: KindRedelegation},
+		{name: "Undelegation", input: "undelegation", expected: KindUndelegation},
+		{name: "Invalid", input: "staking", wantErr: ErrInvalidDelegationKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, err := ParseDelegationKind(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, kind)
+		})
+	}
+}
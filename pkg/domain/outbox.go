@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"time"
+)
+
+// OutboxMessage is a delegation event relayed from the outbox to a message bus.
+// Messages are published in ID order and at least once, consumers deduplicate
+// them on ID.
+type OutboxMessage struct {
+	ID int64
+	// Event is the name of the event, e.g. delegation.new.
+	Event string
+	// Key is the delegator address, the messages of a delegator keep their order
+	// on partitioned buses.
+	Key       string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+// DelegationMessage is the payload of the delegation events published on the message bus.
+type DelegationMessage struct {
+	Event      string          `json:"event"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Delegation DelegationEvent `json:"delegation"`
+}
+
+type OutboxRepository interface {
+	// FindPending returns the oldest events that were not published yet, in ID order.
+	FindPending(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	MarkPublished(ctx context.Context, ids []int64, publishedAt time.Time) error
+}
+
+// EventSink publishes the outbox messages to a message bus.
+type EventSink interface {
+	// Publish returns once every message was acknowledged by the bus, in order.
+	Publish(ctx context.Context, messages []OutboxMessage) error
+	Close() error
+}
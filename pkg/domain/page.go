@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageSize is the number of items of a page when none is requested.
+	DefaultPageSize = 20
+	// MaxPageSize bounds the number of items of a page.
+	MaxPageSize = 100
+)
+
+// ErrInvalidCursor is returned when a pagination cursor can not be decoded.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Page selects the First items following the item of the After cursor, the
+// first page when After is empty. Cursors are opaque to the clients.
+type Page struct {
+	First int
+	After string
+}
+
+// Size returns the number of items of the page, DefaultPageSize when none is
+// requested and at most MaxPageSize.
+func (p Page) Size() int {
+	switch {
+	case p.First <= 0:
+		return DefaultPageSize
+	case p.First > MaxPageSize:
+		return MaxPageSize
+	default:
+		return p.First
+	}
+}
+
+// PageResponse is a page of items along with the cursor of each of them.
+type PageResponse[T any] struct {
+	Data        []T
+	Cursors     []string
+	HasNextPage bool
+}
+
+// DelegationCursor is the position of a delegation in the newest first order,
+// the delegation id breaks the ties between the delegations of a level.
+type DelegationCursor struct {
+	Level int64
+	ID    uuid.UUID
+}
+
+// String encodes the cursor.
+func (c DelegationCursor) String() string {
+	return encodeCursor("delegation", strconv.FormatInt(c.Level, 10)+":"+c.ID.String())
+}
+
+// ParseDelegationCursor decodes a cursor returned by DelegationCursor.String.
+func ParseDelegationCursor(s string) (DelegationCursor, error) {
+	value, err := decodeCursor("delegation", s)
+	if err != nil {
+		return DelegationCursor{}, err
+	}
+
+	level, id, _ := strings.Cut(value, ":")
+	var cursor DelegationCursor
+	if cursor.Level, err = strconv.ParseInt(level, 10, 64); err != nil {
+		return DelegationCursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	if cursor.ID, err = uuid.Parse(id); err != nil {
+		return DelegationCursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	return cursor, nil
+}
+
+// AddressCursor encodes the position of an item in a list ordered by address.
+func AddressCursor(address Address) string {
+	return encodeCursor("address", address.String())
+}
+
+// ParseAddressCursor decodes a cursor returned by AddressCursor.
+func ParseAddressCursor(s string) (Address, error) {
+	value, err := decodeCursor("address", s)
+	if err != nil {
+		return "", err
+	}
+	return Address(value), nil
+}
+
+// OffsetCursor encodes the position of an item in a list that is not keyed.
+func OffsetCursor(offset int) string {
+	return encodeCursor("offset", strconv.Itoa(offset))
+}
+
+// ParseOffsetCursor decodes a cursor returned by OffsetCursor.
+func ParseOffsetCursor(s string) (int, error) {
+	value, err := decodeCursor("offset", s)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.Atoi(value)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	return offset, nil
+}
+
+func encodeCursor(kind, value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(kind + ":" + value))
+}
+
+func decodeCursor(kind, s string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+
+	prefix, value, ok := strings.Cut(string(decoded), ":")
+	if !ok || prefix != kind || value == "" {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	return value, nil
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPage_Size(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		first    int
+		expected int
+	}{
+		{name: "Default", first: 0, expected: DefaultPageSize},
+		{name: "Negative", first: -5, expected: DefaultPageSize},
+		{name: "Requested", first: 42, expected: 42},
+		{name: "Clamped", first: 1000, expected: MaxPageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, Page{First: tt.first}.Size())
+		})
+	}
+}
+
+func TestDelegationCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := DelegationCursor{Level: 5_000_000, ID: uuid.MustParse("11111111-1111-1111-1111-111111111111")}
+
+	parsed, err := ParseDelegationCursor(cursor.String())
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		parse func() error
+	}{
+		{
+			name:  "Not_Base64",
+			parse: func() error { _, err := ParseDelegationCursor("not a cursor"); return err },
+		},
+		{
+			name:  "Other_Kind",
+			parse: func() error { _, err := ParseDelegationCursor(OffsetCursor(3)); return err },
+		},
+		{
+			name:  "Invalid_Level",
+			parse: func() error { _, err := ParseDelegationCursor(encodeCursor("delegation", "x:y")); return err },
+		},
+		{
+			name:  "Negative_Offset",
+			parse: func() error { _, err := ParseOffsetCursor(encodeCursor("offset", "-1")); return err },
+		},
+		{
+			name:  "Empty_Address",
+			parse: func() error { _, err := ParseAddressCursor(encodeCursor("address", "")); return err },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.ErrorIs(t, tt.parse(), ErrInvalidCursor)
+		})
+	}
+}
+
+func TestAddressAndOffsetCursor(t *testing.T) {
+	t.Parallel()
+
+	address := Address("tz1VgjNPutu1jjAk724Y4KK1bBpWoUyvyakj")
+	parsedAddress, err := ParseAddressCursor(AddressCursor(address))
+	assert.NoError(t, err)
+	assert.Equal(t, address, parsedAddress)
+
+	offset, err := ParseOffsetCursor(OffsetCursor(7))
+	assert.NoError(t, err)
+	assert.Equal(t, 7, offset)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrInvalidStakingAction is returned when a staking action is not supported.
+var ErrInvalidStakingAction = errors.New("invalid staking action")
+
+// StakingAction is the kind of a staking operation.
+type StakingAction string
+
+const (
+	StakingActionStake    StakingAction = "stake"
+	StakingActionUnstake  StakingAction = "unstake"
+	StakingActionFinalize StakingAction = "finalize"
+)
+
+// ParseStakingAction validates a staking action name.
+func ParseStakingAction(s string) (StakingAction, error) {
+	switch action := StakingAction(s); action {
+	case StakingActionStake, StakingActionUnstake, StakingActionFinalize:
+		return action, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected %q, %q or %q", ErrInvalidStakingAction, s, StakingActionStake, StakingActionUnstake, StakingActionFinalize)
+	}
+}
+
+// StakingFilter narrows the staking operations returned by the use case and the
+// repository. Nil fields are not filtered on.
+type StakingFilter struct {
+	Staker *Address
+	Baker  *Address
+	Action *StakingAction
+}
+
+type StakingRepository interface {
+	Create(ctx context.Context, operations []models.StakingOperation) error
+	FindAll(ctx context.Context, filter StakingFilter) ([]models.StakingOperation, error)
+	FindStakers(ctx context.Context, filter StakingFilter) ([]models.StakerStats, error)
+	GetLastProcessedLevel(ctx context.Context) (int64, error)
+}
+
+type StakingUseCase interface {
+	Create(ctx context.Context, data []TzktApiStakingResponse) error
+	GetStaking(ctx context.Context, filter StakingFilter, opts ResponseOptions) (ApiResponse[StakingResponseType], error)
+	GetStakers(ctx context.Context, filter StakingFilter, opts ResponseOptions) (ApiResponse[StakerResponseType], error)
+}
+
+type StakingResponseType struct {
+	OperationID     int64         `json:"operation_id"`
+	OperationHash   string        `json:"operation_hash"`
+	Block           string        `json:"block"`
+	Level           int64         `json:"level"`
+	Timestamp       time.Time     `json:"timestamp"`
+	Action          StakingAction `json:"action"`
+	Staker          Address       `json:"staker"`
+	StakerAlias     *string       `json:"staker_alias,omitempty"`
+	Baker           Address       `json:"baker"`
+	BakerAlias      *string       `json:"baker_alias,omitempty"`
+	RequestedAmount Amount        `json:"requested_amount"`
+	Amount          Amount        `json:"amount"`
+	BakerFee        Amount        `json:"baker_fee"`
+}
+
+// StakerResponseType is the stake a staker currently has with a baker, as the
+// sum of its stake operations minus the sum of its unstake operations.
+type StakerResponseType struct {
+	Staker       Address   `json:"staker"`
+	Baker        Address   `json:"baker"`
+	StakedAmount Amount    `json:"staked_amount"`
+	FirstStaked  time.Time `json:"first_staked"`
+	LastUpdated  time.Time `json:"last_updated"`
+}
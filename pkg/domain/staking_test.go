@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStakingAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected StakingAction
+		wantErr  bool
+	}{
+		{
+			name:     "Stake",
+			input:    "stake",
+			expected: StakingActionStake,
+		},
+		{
+			name:     "Unstake",
+			input:    "unstake",
+			expected: StakingActionUnstake,
+		},
+		{
+			name:     "Finalize",
+			input:    "finalize",
+			expected: StakingActionFinalize,
+		},
+		{
+			name:    "Empty",
+			input:   "",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown",
+			input:   "restake",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, err := ParseStakingAction(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidStakingAction)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, action)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidInterval is returned when a statistics interval is not supported.
+	ErrInvalidInterval = errors.New("invalid interval")
+	// ErrInvalidMetric is returned when a statistics metric is not supported.
+	ErrInvalidMetric = errors.New("invalid metric")
+	// ErrRangeTooLarge is returned when a statistics range spans too many buckets.
+	ErrRangeTooLarge = errors.New("range too large")
+)
+
+// StatsInterval is the width of the buckets statistics are aggregated in.
+type StatsInterval string
+
+const (
+	IntervalDay   StatsInterval = "day"
+	IntervalWeek  StatsInterval = "week"
+	IntervalMonth StatsInterval = "month"
+)
+
+// ParseStatsInterval validates an interval name, an empty name defaults to IntervalDay.
+func ParseStatsInterval(s string) (StatsInterval, error) {
+	switch interval := StatsInterval(s); interval {
+	case "":
+		return IntervalDay, nil
+	case IntervalDay, IntervalWeek, IntervalMonth:
+		return interval, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected %q, %q or %q", ErrInvalidInterval, s, IntervalDay, IntervalWeek, IntervalMonth)
+	}
+}
+
+// Truncate returns the start of the bucket t belongs to, weeks start on Monday.
+func (i StatsInterval) Truncate(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch i {
+	case IntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case IntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// AddTo returns t moved by n buckets.
+func (i StatsInterval) AddTo(t time.Time, n int) time.Time {
+	switch i {
+	case IntervalWeek:
+		return t.AddDate(0, 0, 7*n)
+	case IntervalMonth:
+		return t.AddDate(0, n, 0)
+	default:
+		return t.AddDate(0, 0, n)
+	}
+}
+
+// Buckets returns the number of buckets from the one of from to the one of to,
+// both included.
+func (i StatsInterval) Buckets(from, to time.Time) int {
+	from, to = i.Truncate(from), i.Truncate(to)
+	days := int(to.Sub(from).Hours() / 24)
+	switch i {
+	case IntervalWeek:
+		return days/7 + 1
+	case IntervalMonth:
+		return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month()) + 1
+	default:
+		return days + 1
+	}
+}
+
+// StatsMetric is the value aggregated in every bucket of a time series.
+type StatsMetric string
+
+const (
+	// MetricCount is the number of delegations to a baker.
+	MetricCount StatsMetric = "count"
+	// MetricVolume is the amount delegated to a baker.
+	MetricVolume StatsMetric = "volume"
+	// MetricNewDelegators is the number of accounts delegating for the first time.
+	MetricNewDelegators StatsMetric = "new_delegators"
+	// MetricUndelegations is the number of accounts leaving their baker without a new one.
+	MetricUndelegations StatsMetric = "undelegations"
+)
+
+// ParseStatsMetric validates a metric name, an empty name defaults to MetricCount.
+func ParseStatsMetric(s string) (StatsMetric, error) {
+	switch metric := StatsMetric(s); metric {
+	case "":
+		return MetricCount, nil
+	case MetricCount, MetricVolume, MetricNewDelegators, MetricUndelegations:
+		return metric, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected one of %q, %q, %q or %q", ErrInvalidMetric, s, MetricCount, MetricVolume, MetricNewDelegators, MetricUndelegations)
+	}
+}
+
+// TimeseriesFilter selects the time series returned by the use case and the
+// repository. Nil fields are not filtered on, the use case defaults From and To
+// before calling the repository.
+type TimeseriesFilter struct {
+	Interval StatsInterval
+	Metric   StatsMetric
+	// Baker selects the delegations to the baker, or the undelegations from it.
+	Baker *Address
+	From  *time.Time
+	To    *time.Time
+}
+
+// PeriodFilter bounds the delegations statistics are computed from. Nil fields
+// are defaulted by the use case before calling the repository.
+type PeriodFilter struct {
+	From *time.Time
+	To   *time.Time
+}
+
+// WhaleFilter selects the delegations of a whale report. Zero fields are defaulted
+// by the use case before calling the repository.
+type WhaleFilter struct {
+	PeriodFilter
+	// Limit caps the number of delegations and movements listed.
+	Limit int
+	// MinAmount is the smallest amount of a whale movement, zero reports every
+	// movement.
+	MinAmount *Mutez
+}
+
+type StatsRepository interface {
+	FindTimeseries(ctx context.Context, filter TimeseriesFilter) ([]models.TimeseriesPoint, error)
+	FindFlows(ctx context.Context, filter PeriodFilter) ([]models.BakerFlow, error)
+	FindLargestDelegations(ctx context.Context, filter WhaleFilter) ([]models.Delegation, error)
+	FindWhaleMovements(ctx context.Context, filter WhaleFilter) ([]models.Delegation, error)
+	// FindConcentration returns the concentration of the limit bakers with the
+	// largest delegated amount.
+	FindConcentration(ctx context.Context, limit int) ([]models.BakerConcentration, error)
+	SaveWhaleReport(ctx context.Context, report models.WhaleReport) error
+	FindWhaleReports(ctx context.Context, limit int) ([]models.WhaleReport, error)
+}
+
+type StatsUseCase interface {
+	GetTimeseries(ctx context.Context, filter TimeseriesFilter, opts ResponseOptions) (ApiResponse[TimeseriesPointResponseType], error)
+	GetFlows(ctx context.Context, filter PeriodFilter, opts ResponseOptions) (FlowsResponseType, error)
+	GetWhales(ctx context.Context, filter WhaleFilter, opts ResponseOptions) (WhalesResponseType, error)
+	// CreateWhaleReport computes the whale report of the period and stores it.
+	CreateWhaleReport(ctx context.Context, filter WhaleFilter) error
+	GetWhaleReports(ctx context.Context, limit int) (ApiResponse[WhaleReportResponseType], error)
+}
+
+// TimeseriesPointResponseType is the value of a metric in the bucket starting at
+// Timestamp. Value is set for counting metrics and Amount for the volume.
+type TimeseriesPointResponseType struct {
+	Timestamp time.Time `json:"timestamp"`
+	Value     *int64    `json:"value,omitempty"`
+	Amount    *Amount   `json:"amount,omitempty"`
+}
+
+// FlowsResponseType is the movement of delegators between bakers during a period.
+type FlowsResponseType struct {
+	From       time.Time      `json:"from"`
+	To         time.Time      `json:"to"`
+	Flows      []BakerFlow    `json:"flows"`
+	Bakers     []BakerNetFlow `json:"bakers"`
+	TopGainers []BakerNetFlow `json:"top_gainers"`
+	TopLosers  []BakerNetFlow `json:"top_losers"`
+}
+
+// BakerFlow is a cell of the baker to baker matrix. From is nil for accounts
+// delegating for the first time and To is nil for undelegations.
+type BakerFlow struct {
+	From   *Address `json:"from"`
+	To     *Address `json:"to"`
+	Count  int64    `json:"count"`
+	Amount Amount   `json:"amount"`
+}
+
+// BakerNetFlow is what a baker gained and lost during a period.
+type BakerNetFlow struct {
+	Baker         Address `json:"baker"`
+	InflowCount   int64   `json:"inflow_count"`
+	InflowAmount  Amount  `json:"inflow_amount"`
+	OutflowCount  int64   `json:"outflow_count"`
+	OutflowAmount Amount  `json:"outflow_amount"`
+	NetCount      int64   `json:"net_count"`
+	NetAmount     Amount  `json:"net_amount"`
+}
+
+// WhalesResponseType lists the largest delegations and whale movements of a period,
+// and how concentrated the current delegators of every baker are.
+type WhalesResponseType struct {
+	From               time.Time            `json:"from"`
+	To                 time.Time            `json:"to"`
+	MinAmount          Amount               `json:"min_amount"`
+	LargestDelegations []WhaleDelegation    `json:"largest_delegations"`
+	Movements          []WhaleDelegation    `json:"movements"`
+	Concentration      []BakerConcentration `json:"concentration"`
+}
+
+type WhaleDelegation struct {
+	OperationHash *string        `json:"operation_hash,omitempty"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Level         int64          `json:"level"`
+	Kind          DelegationKind `json:"kind"`
+	Delegator     Address        `json:"delegator"`
+	Baker         *Address       `json:"baker"`
+	PreviousBaker *Address       `json:"previous_baker"`
+	Amount        Amount         `json:"amount"`
+}
+
+// BakerConcentration measures how much a baker depends on its largest delegators.
+// HerfindahlIndex is the sum of the squared shares of its delegators, from close to
+// 0 for many equal delegators to 1 for a single one.
+type BakerConcentration struct {
+	Baker           Address `json:"baker"`
+	Delegators      int64   `json:"delegators"`
+	DelegatedAmount Amount  `json:"delegated_amount"`
+	HerfindahlIndex float64 `json:"herfindahl_index"`
+	Top10Share      float64 `json:"top10_share"`
+}
+
+// WhaleReportResponseType is a stored scheduled whale report, Report is the
+// WhalesResponseType of its period rendered in mutez.
+type WhaleReportResponseType struct {
+	ID          string          `json:"id"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	From        time.Time       `json:"from"`
+	To          time.Time       `json:"to"`
+	Report      json.RawMessage `json:"report"`
+}
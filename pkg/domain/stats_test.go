@@ -0,0 +1,171 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStatsInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected StatsInterval
+		wantErr  bool
+	}{
+		{
+			name:     "Empty_Defaults_To_Day",
+			input:    "",
+			expected: IntervalDay,
+		},
+		{
+			name:     "Week",
+			input:    "week",
+			expected: IntervalWeek,
+		},
+		{
+			name:     "Month",
+			input:    "month",
+			expected: IntervalMonth,
+		},
+		{
+			name:    "Unknown",
+			input:   "year",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			interval, err := ParseStatsInterval(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInterval)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, interval)
+		})
+	}
+}
+
+func TestParseStatsMetric(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected StatsMetric
+		wantErr  bool
+	}{
+		{
+			name:     "Empty_Defaults_To_Count",
+			input:    "",
+			expected: MetricCount,
+		},
+		{
+			name:     "Volume",
+			input:    "volume",
+			expected: MetricVolume,
+		},
+		{
+			name:     "New_Delegators",
+			input:    "new_delegators",
+			expected: MetricNewDelegators,
+		},
+		{
+			name:     "Undelegations",
+			input:    "undelegations",
+			expected: MetricUndelegations,
+		},
+		{
+			name:    "Unknown",
+			input:   "sum",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			metric, err := ParseStatsMetric(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidMetric)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, metric)
+		})
+	}
+}
+
+func TestStatsInterval_Truncate(t *testing.T) {
+	t.Parallel()
+
+	// A Thursday.
+	instant := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval StatsInterval
+		expected time.Time
+	}{
+		{
+			name:     "Day",
+			interval: IntervalDay,
+			expected: time.Date(2024, 6, 13, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Week_Starts_On_Monday",
+			interval: IntervalWeek,
+			expected: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Month",
+			interval: IntervalMonth,
+			expected: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.interval.Truncate(instant))
+		})
+	}
+}
+
+func TestStatsInterval_Buckets(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 6, 13, 15, 4, 5, 0, time.UTC)
+	to := time.Date(2025, 6, 13, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		interval StatsInterval
+		to       time.Time
+		expected int
+	}{
+		{name: "Same_Bucket", interval: IntervalDay, to: from.Add(time.Hour), expected: 1},
+		{name: "Day", interval: IntervalDay, to: to, expected: 366},
+		{name: "Week", interval: IntervalWeek, to: to, expected: 53},
+		{name: "Month", interval: IntervalMonth, to: to, expected: 13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.interval.Buckets(from, tt.to))
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidStatus is returned when an operation status is not supported.
+var ErrInvalidStatus = errors.New("invalid status")
+
+// OperationStatus is the status TzKT reports for an operation.
+type OperationStatus string
+
+const (
+	StatusApplied     OperationStatus = "applied"
+	StatusFailed      OperationStatus = "failed"
+	StatusBacktracked OperationStatus = "backtracked"
+	StatusSkipped     OperationStatus = "skipped"
+)
+
+// ParseOperationStatus validates a status name, an empty name defaults to StatusApplied.
+func ParseOperationStatus(s string) (OperationStatus, error) {
+	switch status := OperationStatus(s); status {
+	case "":
+		return StatusApplied, nil
+	case StatusApplied, StatusFailed, StatusBacktracked, StatusSkipped:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: %q, expected one of %q, %q, %q or %q", ErrInvalidStatus, s, StatusApplied, StatusFailed, StatusBacktracked, StatusSkipped)
+	}
+}
+
+// IsApplied reports whether the operation took effect, an empty status is
+// considered applied.
+func (s OperationStatus) IsApplied() bool {
+	return s == "" || s == StatusApplied
+}
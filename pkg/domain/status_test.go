@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOperationStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected OperationStatus
+		wantErr  bool
+	}{
+		{
+			name:     "Empty_Defaults_To_Applied",
+			input:    "",
+			expected: StatusApplied,
+		},
+		{
+			name:     "Applied",
+			input:    "applied",
+			expected: StatusApplied,
+		},
+		{
+			name:     "Failed",
+			input:    "failed",
+			expected: StatusFailed,
+		},
+		{
+			name:     "Backtracked",
+			input:    "backtracked",
+			expected: StatusBacktracked,
+		},
+		{
+			name:     "Skipped",
+			input:    "skipped",
+			expected: StatusSkipped,
+		},
+		{
+			name:    "Unknown",
+			input:   "pending",
+			wantErr: true,
+		},
+		{
+			name:    "Wrong_Case",
+			input:   "Failed",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, err := ParseOperationStatus(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidStatus)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, status)
+		})
+	}
+}
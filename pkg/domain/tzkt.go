@@ -44,3 +44,45 @@ type Quote struct {
 	ETH float64 `json:"eth"`
 	GBP float64 `json:"gbp"`
 }
+
+type TzktApiStakingResponse struct {
+	Type                string   `json:"type"`
+	ID                  int64    `json:"id"`
+	Level               int64    `json:"level"`
+	Timestamp           string   `json:"timestamp"`
+	Block               string   `json:"block"`
+	Hash                string   `json:"hash"`
+	Counter             int64    `json:"counter"`
+	Sender              *Account `json:"sender,omitempty"`
+	GasLimit            int      `json:"gasLimit"`
+	GasUsed             int      `json:"gasUsed"`
+	StorageLimit        int      `json:"storageLimit"`
+	BakerFee            int64    `json:"bakerFee"`
+	Action              string   `json:"action"`
+	RequestedAmount     int64    `json:"requestedAmount"`
+	Amount              int64    `json:"amount"`
+	Baker               *Account `json:"baker,omitempty"`
+	StakingUpdatesCount *int     `json:"stakingUpdatesCount,omitempty"`
+	Status              string   `json:"status"`
+	Errors              []Error  `json:"errors,omitempty"`
+	Quote               *Quote   `json:"quote,omitempty"`
+}
+
+type TzktApiAccountResponse struct {
+	Address string `json:"address"`
+	Balance int64  `json:"balance"`
+}
+
+type TzktApiProtocolResponse struct {
+	Code            int               `json:"code"`
+	Hash            string            `json:"hash"`
+	FirstLevel      int64             `json:"firstLevel"`
+	FirstCycle      int64             `json:"firstCycle"`
+	FirstCycleLevel int64             `json:"firstCycleLevel"`
+	LastLevel       *int64            `json:"lastLevel,omitempty"`
+	Constants       ProtocolConstants `json:"constants"`
+}
+
+type ProtocolConstants struct {
+	BlocksPerCycle int64 `json:"blocksPerCycle"`
+}
@@ -0,0 +1,172 @@
+package domain
+
+import (
+	"context"
+	"delegator/internal/models"
+	"errors"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeliveryStatus is the state of a webhook delivery.
+type DeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are sent on their next attempt.
+	DeliveryPending DeliveryStatus = "pending"
+	// DeliveryDelivered deliveries were acknowledged with a 2xx response.
+	DeliveryDelivered DeliveryStatus = "delivered"
+	// DeliveryFailed deliveries exhausted their attempts.
+	DeliveryFailed DeliveryStatus = "failed"
+)
+
+// ErrInvalidEndpoint is returned when a webhook endpoint does not resolve or
+// resolves to an address that is not public, so that webhooks cannot be used to
+// reach the internal network.
+var ErrInvalidEndpoint = errors.New("invalid url")
+
+// sharedAddressSpace is the carrier-grade NAT range (RFC 6598).
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicAddr reports whether webhooks may be delivered to addr: loopback, private
+// (RFC 1918 and unique local), link-local, carrier-grade NAT, multicast and
+// unspecified addresses are refused.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!addr.IsUnspecified() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// CreateWebhookDTO is a webhook subscription. Nil and empty fields are not filtered on.
+// APIKeyID is the key of the request, webhooks are only created with an API key.
+type CreateWebhookDTO struct {
+	APIKeyID  uuid.UUID
+	URL       string
+	Baker     *Address
+	Delegator *Address
+	MinAmount Mutez
+	Kinds     []DelegationKind
+}
+
+type WebhookRepository interface {
+	CreateWebhook(ctx context.Context, webhook models.Webhook) (models.Webhook, error)
+	// FindWebhooks returns every webhook, whatever the key that created it.
+	FindWebhooks(ctx context.Context) ([]models.Webhook, error)
+	// The following methods only see the webhooks created with apiKeyID.
+	FindAPIKeyWebhooks(ctx context.Context, apiKeyID uuid.UUID) ([]models.Webhook, error)
+	FindWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) (models.Webhook, error)
+	DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error
+	// ClaimDueDeliveries returns the due deliveries and leases them until leaseUntil,
+	// skipping the ones claimed by another dispatcher.
+	ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]models.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error
+	FindDeliveries(ctx context.Context, webhookID uuid.UUID, limit int) ([]models.WebhookDelivery, error)
+}
+
+// WebhookSender posts signed events to the webhook endpoints.
+type WebhookSender interface {
+	// Send posts body with the headers to url and returns the response status code.
+	Send(ctx context.Context, url string, headers map[string]string, body []byte) (int, error)
+}
+
+// WebhookUseCase manages the webhooks. The webhooks are scoped to the API key that
+// created them: apiKeyID only sees its own webhooks.
+type WebhookUseCase interface {
+	CreateWebhook(ctx context.Context, dto CreateWebhookDTO, opts ResponseOptions) (WebhookResponseType, error)
+	GetWebhooks(ctx context.Context, apiKeyID uuid.UUID, opts ResponseOptions) (ApiResponse[WebhookResponseType], error)
+	GetWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, opts ResponseOptions) (WebhookResponseType, error)
+	DeleteWebhook(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID) error
+	GetDeliveries(ctx context.Context, apiKeyID uuid.UUID, id uuid.UUID, limit int) (ApiResponse[WebhookDeliveryResponseType], error)
+	// Deliveries returns a pending delivery for every webhook matching each delegation,
+	// for the indexer to store them in the transaction of the delegations.
+	Deliveries(ctx context.Context, delegations []models.Delegation) ([]models.WebhookDelivery, error)
+	// Deliver sends the deliveries that are due and schedules a retry of the failed ones.
+	Deliver(ctx context.Context) error
+}
+
+// WebhookResponseType is a webhook subscription, Secret is only returned when
+// the webhook is created.
+type WebhookResponseType struct {
+	ID        uuid.UUID        `json:"id"`
+	URL       string           `json:"url"`
+	Baker     *Address         `json:"baker"`
+	Delegator *Address         `json:"delegator"`
+	MinAmount Amount           `json:"min_amount"`
+	Kinds     []DelegationKind `json:"kinds"`
+	Secret    string           `json:"secret,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+type WebhookDeliveryResponseType struct {
+	ID             uuid.UUID      `json:"id"`
+	Event          string         `json:"event"`
+	OperationID    int64          `json:"operation_id"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	NextAttemptAt  *time.Time     `json:"next_attempt_at"`
+	LastStatusCode *int           `json:"last_status_code"`
+	LastError      *string        `json:"last_error"`
+	CreatedAt      time.Time      `json:"created_at"`
+	DeliveredAt    *time.Time     `json:"delivered_at"`
+}
+
+// WebhookEvent is the body posted to a webhook, ID is the delivery id and stays
+// the same across retries.
+type WebhookEvent struct {
+	ID         uuid.UUID       `json:"id"`
+	Event      string          `json:"event"`
+	CreatedAt  time.Time       `json:"created_at"`
+	Delegation DelegationEvent `json:"delegation"`
+}
+
+// DelegationEvent is an indexed delegation as published to the outside, amounts
+// are in mutez.
+type DelegationEvent struct {
+	OperationID   *int64         `json:"operation_id"`
+	OperationHash *string        `json:"operation_hash"`
+	Timestamp     time.Time      `json:"timestamp"`
+	Level         int64          `json:"level"`
+	Cycle         *int64         `json:"cycle"`
+	Kind          DelegationKind `json:"kind"`
+	Delegator     Address        `json:"delegator"`
+	Baker         *Address       `json:"baker"`
+	PreviousBaker *Address       `json:"previous_baker"`
+	Amount        Amount         `json:"amount"`
+}
+
+// EventName returns the name of the event published for a delegation kind.
+func EventName(kind DelegationKind) string {
+	return "delegation." + string(kind)
+}
+
+// NewDelegationEvent returns the published form of a stored delegation.
+func NewDelegationEvent(delegation models.Delegation) DelegationEvent {
+	event := DelegationEvent{
+		OperationID:   delegation.OperationID,
+		OperationHash: delegation.OperationHash,
+		Timestamp:     delegation.Timestamp,
+		Level:         delegation.Level,
+		Cycle:         delegation.Cycle,
+		Kind:          KindOf(delegation),
+		Delegator:     Address(delegation.Delegator),
+		Amount:        NewAmount(delegation.Amount, UnitMutez),
+	}
+	if delegation.BakerID != UndelegatedBaker {
+		baker := Address(delegation.BakerID)
+		event.Baker = &baker
+	}
+	if delegation.PreviousBaker != nil {
+		previousBaker := Address(*delegation.PreviousBaker)
+		event.PreviousBaker = &previousBaker
+	}
+	return event
+}
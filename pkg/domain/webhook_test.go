@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPublicAddr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		addr     string
+		expected bool
+	}{
+		{addr: "93.184.216.34", expected: true},
+		{addr: "2606:2800:220:1:248:1893:25c8:1946", expected: true},
+		{addr: "127.0.0.1", expected: false},
+		{addr: "::1", expected: false},
+		{addr: "10.0.0.1", expected: false},
+		{addr: "172.16.5.4", expected: false},
+		{addr: "192.168.1.1", expected: false},
+		{addr: "169.254.169.254", expected: false},
+		{addr: "fe80::1", expected: false},
+		{addr: "fd00::1", expected: false},
+		{addr: "100.64.0.1", expected: false},
+		{addr: "0.0.0.0", expected: false},
+		{addr: "224.0.0.1", expected: false},
+		{addr: "::ffff:127.0.0.1", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, IsPublicAddr(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
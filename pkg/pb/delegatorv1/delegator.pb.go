@@ -0,0 +1,1195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: delegator/v1/delegator.proto
+
+package delegatorv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DelegationFilter struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Delegator *string                `protobuf:"bytes,1,opt,name=delegator,proto3,oneof" json:"delegator,omitempty"`
+	Baker     *string                `protobuf:"bytes,2,opt,name=baker,proto3,oneof" json:"baker,omitempty"`
+	Cycle     *int64                 `protobuf:"varint,3,opt,name=cycle,proto3,oneof" json:"cycle,omitempty"`
+	// status selects the applied delegations when empty, or the stored
+	// delegations that did not take effect: failed, backtracked or skipped.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DelegationFilter) Reset() {
+	*x = DelegationFilter{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationFilter) ProtoMessage() {}
+
+func (x *DelegationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationFilter.ProtoReflect.Descriptor instead.
+func (*DelegationFilter) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DelegationFilter) GetDelegator() string {
+	if x != nil && x.Delegator != nil {
+		return *x.Delegator
+	}
+	return ""
+}
+
+func (x *DelegationFilter) GetBaker() string {
+	if x != nil && x.Baker != nil {
+		return *x.Baker
+	}
+	return ""
+}
+
+func (x *DelegationFilter) GetCycle() int64 {
+	if x != nil && x.Cycle != nil {
+		return *x.Cycle
+	}
+	return 0
+}
+
+func (x *DelegationFilter) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListDelegationsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Filter *DelegationFilter      `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// currency, when set, adds the fiat value of the amounts: btc, eur, usd, cny, jpy, krw, eth or gbp.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// expand adds the operation metadata to the delegations.
+	Expand bool `protobuf:"varint,3,opt,name=expand,proto3" json:"expand,omitempty"`
+	// page_size is the number of delegations of a page, 20 when unset and at most 100.
+	PageSize int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page, the first page is
+	// returned when it is empty.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsRequest) Reset() {
+	*x = ListDelegationsRequest{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsRequest) ProtoMessage() {}
+
+func (x *ListDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDelegationsRequest) GetFilter() *DelegationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDelegationsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *ListDelegationsRequest) GetExpand() bool {
+	if x != nil {
+		return x.Expand
+	}
+	return false
+}
+
+func (x *ListDelegationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDelegationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDelegationsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Delegations []*Delegation          `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	// next_page_token fetches the next page, it is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDelegationsResponse) Reset() {
+	*x = ListDelegationsResponse{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDelegationsResponse) ProtoMessage() {}
+
+func (x *ListDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDelegationsResponse) GetDelegations() []*Delegation {
+	if x != nil {
+		return x.Delegations
+	}
+	return nil
+}
+
+func (x *ListDelegationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListBakersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBakersRequest) Reset() {
+	*x = ListBakersRequest{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBakersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBakersRequest) ProtoMessage() {}
+
+func (x *ListBakersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBakersRequest.ProtoReflect.Descriptor instead.
+func (*ListBakersRequest) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{3}
+}
+
+type ListBakersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bakers        []*Baker               `protobuf:"bytes,1,rep,name=bakers,proto3" json:"bakers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBakersResponse) Reset() {
+	*x = ListBakersResponse{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBakersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBakersResponse) ProtoMessage() {}
+
+func (x *ListBakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBakersResponse.ProtoReflect.Descriptor instead.
+func (*ListBakersResponse) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListBakersResponse) GetBakers() []*Baker {
+	if x != nil {
+		return x.Bakers
+	}
+	return nil
+}
+
+type GetBakerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBakerRequest) Reset() {
+	*x = GetBakerRequest{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBakerRequest) ProtoMessage() {}
+
+func (x *GetBakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBakerRequest.ProtoReflect.Descriptor instead.
+func (*GetBakerRequest) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBakerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type GetBakerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Baker         *Baker                 `protobuf:"bytes,1,opt,name=baker,proto3" json:"baker,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBakerResponse) Reset() {
+	*x = GetBakerResponse{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBakerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBakerResponse) ProtoMessage() {}
+
+func (x *GetBakerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBakerResponse.ProtoReflect.Descriptor instead.
+func (*GetBakerResponse) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBakerResponse) GetBaker() *Baker {
+	if x != nil {
+		return x.Baker
+	}
+	return nil
+}
+
+type WatchDelegationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// filter only selects applied delegations, its status must be empty or applied.
+	Filter   *DelegationFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Currency string            `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	// after_operation_id replays the stored delegations after that TzKT operation id first.
+	AfterOperationId *int64 `protobuf:"varint,3,opt,name=after_operation_id,json=afterOperationId,proto3,oneof" json:"after_operation_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WatchDelegationsRequest) Reset() {
+	*x = WatchDelegationsRequest{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDelegationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDelegationsRequest) ProtoMessage() {}
+
+func (x *WatchDelegationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDelegationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchDelegationsRequest) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WatchDelegationsRequest) GetFilter() *DelegationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *WatchDelegationsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WatchDelegationsRequest) GetAfterOperationId() int64 {
+	if x != nil && x.AfterOperationId != nil {
+		return *x.AfterOperationId
+	}
+	return 0
+}
+
+type WatchDelegationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delegation    *Delegation            `protobuf:"bytes,1,opt,name=delegation,proto3" json:"delegation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchDelegationsResponse) Reset() {
+	*x = WatchDelegationsResponse{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchDelegationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchDelegationsResponse) ProtoMessage() {}
+
+func (x *WatchDelegationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchDelegationsResponse.ProtoReflect.Descriptor instead.
+func (*WatchDelegationsResponse) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchDelegationsResponse) GetDelegation() *Delegation {
+	if x != nil {
+		return x.Delegation
+	}
+	return nil
+}
+
+type Delegation struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount    int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Delegator string                 `protobuf:"bytes,3,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Level     int64                  `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Cycle     *int64                 `protobuf:"varint,5,opt,name=cycle,proto3,oneof" json:"cycle,omitempty"`
+	FiatValue *FiatValue             `protobuf:"bytes,6,opt,name=fiat_value,json=fiatValue,proto3" json:"fiat_value,omitempty"`
+	// status and errors are only set for delegations that did not take effect.
+	Status string   `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Errors []string `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	// metadata is only set when requested.
+	Metadata      *DelegationMetadata `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delegation) Reset() {
+	*x = Delegation{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delegation) ProtoMessage() {}
+
+func (x *Delegation) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delegation.ProtoReflect.Descriptor instead.
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Delegation) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *Delegation) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Delegation) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *Delegation) GetLevel() int64 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *Delegation) GetCycle() int64 {
+	if x != nil && x.Cycle != nil {
+		return *x.Cycle
+	}
+	return 0
+}
+
+func (x *Delegation) GetFiatValue() *FiatValue {
+	if x != nil {
+		return x.FiatValue
+	}
+	return nil
+}
+
+func (x *Delegation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Delegation) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *Delegation) GetMetadata() *DelegationMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type FiatValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Quote         float64                `protobuf:"fixed64,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Value         float64                `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FiatValue) Reset() {
+	*x = FiatValue{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FiatValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiatValue) ProtoMessage() {}
+
+func (x *FiatValue) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiatValue.ProtoReflect.Descriptor instead.
+func (*FiatValue) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FiatValue) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FiatValue) GetQuote() float64 {
+	if x != nil {
+		return x.Quote
+	}
+	return 0
+}
+
+func (x *FiatValue) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type DelegationMetadata struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	OperationId         *int64                 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3,oneof" json:"operation_id,omitempty"`
+	OperationHash       *string                `protobuf:"bytes,2,opt,name=operation_hash,json=operationHash,proto3,oneof" json:"operation_hash,omitempty"`
+	Block               *string                `protobuf:"bytes,3,opt,name=block,proto3,oneof" json:"block,omitempty"`
+	Counter             *int64                 `protobuf:"varint,4,opt,name=counter,proto3,oneof" json:"counter,omitempty"`
+	GasUsed             *int64                 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3,oneof" json:"gas_used,omitempty"`
+	BakerFee            *int64                 `protobuf:"varint,6,opt,name=baker_fee,json=bakerFee,proto3,oneof" json:"baker_fee,omitempty"`
+	Initiator           *string                `protobuf:"bytes,7,opt,name=initiator,proto3,oneof" json:"initiator,omitempty"`
+	InitiatorAlias      *string                `protobuf:"bytes,8,opt,name=initiator_alias,json=initiatorAlias,proto3,oneof" json:"initiator_alias,omitempty"`
+	Nonce               *int64                 `protobuf:"varint,9,opt,name=nonce,proto3,oneof" json:"nonce,omitempty"`
+	StakingUpdatesCount *int64                 `protobuf:"varint,10,opt,name=staking_updates_count,json=stakingUpdatesCount,proto3,oneof" json:"staking_updates_count,omitempty"`
+	SelfDelegation      bool                   `protobuf:"varint,11,opt,name=self_delegation,json=selfDelegation,proto3" json:"self_delegation,omitempty"`
+	DelegatorAlias      *string                `protobuf:"bytes,12,opt,name=delegator_alias,json=delegatorAlias,proto3,oneof" json:"delegator_alias,omitempty"`
+	// baker is unset for undelegations.
+	Baker              *string `protobuf:"bytes,13,opt,name=baker,proto3,oneof" json:"baker,omitempty"`
+	BakerAlias         *string `protobuf:"bytes,14,opt,name=baker_alias,json=bakerAlias,proto3,oneof" json:"baker_alias,omitempty"`
+	PreviousBaker      *string `protobuf:"bytes,15,opt,name=previous_baker,json=previousBaker,proto3,oneof" json:"previous_baker,omitempty"`
+	PreviousBakerAlias *string `protobuf:"bytes,16,opt,name=previous_baker_alias,json=previousBakerAlias,proto3,oneof" json:"previous_baker_alias,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DelegationMetadata) Reset() {
+	*x = DelegationMetadata{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DelegationMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelegationMetadata) ProtoMessage() {}
+
+func (x *DelegationMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelegationMetadata.ProtoReflect.Descriptor instead.
+func (*DelegationMetadata) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DelegationMetadata) GetOperationId() int64 {
+	if x != nil && x.OperationId != nil {
+		return *x.OperationId
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetOperationHash() string {
+	if x != nil && x.OperationHash != nil {
+		return *x.OperationHash
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetBlock() string {
+	if x != nil && x.Block != nil {
+		return *x.Block
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetCounter() int64 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetGasUsed() int64 {
+	if x != nil && x.GasUsed != nil {
+		return *x.GasUsed
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetBakerFee() int64 {
+	if x != nil && x.BakerFee != nil {
+		return *x.BakerFee
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetInitiator() string {
+	if x != nil && x.Initiator != nil {
+		return *x.Initiator
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetInitiatorAlias() string {
+	if x != nil && x.InitiatorAlias != nil {
+		return *x.InitiatorAlias
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetNonce() int64 {
+	if x != nil && x.Nonce != nil {
+		return *x.Nonce
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetStakingUpdatesCount() int64 {
+	if x != nil && x.StakingUpdatesCount != nil {
+		return *x.StakingUpdatesCount
+	}
+	return 0
+}
+
+func (x *DelegationMetadata) GetSelfDelegation() bool {
+	if x != nil {
+		return x.SelfDelegation
+	}
+	return false
+}
+
+func (x *DelegationMetadata) GetDelegatorAlias() string {
+	if x != nil && x.DelegatorAlias != nil {
+		return *x.DelegatorAlias
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetBaker() string {
+	if x != nil && x.Baker != nil {
+		return *x.Baker
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetBakerAlias() string {
+	if x != nil && x.BakerAlias != nil {
+		return *x.BakerAlias
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetPreviousBaker() string {
+	if x != nil && x.PreviousBaker != nil {
+		return *x.PreviousBaker
+	}
+	return ""
+}
+
+func (x *DelegationMetadata) GetPreviousBakerAlias() string {
+	if x != nil && x.PreviousBakerAlias != nil {
+		return *x.PreviousBakerAlias
+	}
+	return ""
+}
+
+type Baker struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Address                  string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Alias                    *string                `protobuf:"bytes,2,opt,name=alias,proto3,oneof" json:"alias,omitempty"`
+	FirstSeen                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen                 *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Active                   bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	RegistrationLevel        *int64                 `protobuf:"varint,6,opt,name=registration_level,json=registrationLevel,proto3,oneof" json:"registration_level,omitempty"`
+	RegistrationTimestamp    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=registration_timestamp,json=registrationTimestamp,proto3" json:"registration_timestamp,omitempty"`
+	DeactivationLevel        *int64                 `protobuf:"varint,8,opt,name=deactivation_level,json=deactivationLevel,proto3,oneof" json:"deactivation_level,omitempty"`
+	DeactivationTimestamp    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=deactivation_timestamp,json=deactivationTimestamp,proto3" json:"deactivation_timestamp,omitempty"`
+	TotalDelegationsReceived int64                  `protobuf:"varint,10,opt,name=total_delegations_received,json=totalDelegationsReceived,proto3" json:"total_delegations_received,omitempty"`
+	Delegators               int64                  `protobuf:"varint,11,opt,name=delegators,proto3" json:"delegators,omitempty"`
+	DelegatedAmount          int64                  `protobuf:"varint,12,opt,name=delegated_amount,json=delegatedAmount,proto3" json:"delegated_amount,omitempty"`
+	Stakers                  int64                  `protobuf:"varint,13,opt,name=stakers,proto3" json:"stakers,omitempty"`
+	StakedAmount             int64                  `protobuf:"varint,14,opt,name=staked_amount,json=stakedAmount,proto3" json:"staked_amount,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Baker) Reset() {
+	*x = Baker{}
+	mi := &file_delegator_v1_delegator_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Baker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Baker) ProtoMessage() {}
+
+func (x *Baker) ProtoReflect() protoreflect.Message {
+	mi := &file_delegator_v1_delegator_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Baker.ProtoReflect.Descriptor instead.
+func (*Baker) Descriptor() ([]byte, []int) {
+	return file_delegator_v1_delegator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Baker) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Baker) GetAlias() string {
+	if x != nil && x.Alias != nil {
+		return *x.Alias
+	}
+	return ""
+}
+
+func (x *Baker) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *Baker) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *Baker) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Baker) GetRegistrationLevel() int64 {
+	if x != nil && x.RegistrationLevel != nil {
+		return *x.RegistrationLevel
+	}
+	return 0
+}
+
+func (x *Baker) GetRegistrationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RegistrationTimestamp
+	}
+	return nil
+}
+
+func (x *Baker) GetDeactivationLevel() int64 {
+	if x != nil && x.DeactivationLevel != nil {
+		return *x.DeactivationLevel
+	}
+	return 0
+}
+
+func (x *Baker) GetDeactivationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivationTimestamp
+	}
+	return nil
+}
+
+func (x *Baker) GetTotalDelegationsReceived() int64 {
+	if x != nil {
+		return x.TotalDelegationsReceived
+	}
+	return 0
+}
+
+func (x *Baker) GetDelegators() int64 {
+	if x != nil {
+		return x.Delegators
+	}
+	return 0
+}
+
+func (x *Baker) GetDelegatedAmount() int64 {
+	if x != nil {
+		return x.DelegatedAmount
+	}
+	return 0
+}
+
+func (x *Baker) GetStakers() int64 {
+	if x != nil {
+		return x.Stakers
+	}
+	return 0
+}
+
+func (x *Baker) GetStakedAmount() int64 {
+	if x != nil {
+		return x.StakedAmount
+	}
+	return 0
+}
+
+var File_delegator_v1_delegator_proto protoreflect.FileDescriptor
+
+const file_delegator_v1_delegator_proto_rawDesc = "" +
+	"\n" +
+	"\x1cdelegator/v1/delegator.proto\x12\fdelegator.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa5\x01\n" +
+	"\x10DelegationFilter\x12!\n" +
+	"\tdelegator\x18\x01 \x01(\tH\x00R\tdelegator\x88\x01\x01\x12\x19\n" +
+	"\x05baker\x18\x02 \x01(\tH\x01R\x05baker\x88\x01\x01\x12\x19\n" +
+	"\x05cycle\x18\x03 \x01(\x03H\x02R\x05cycle\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06statusB\f\n" +
+	"\n" +
+	"_delegatorB\b\n" +
+	"\x06_bakerB\b\n" +
+	"\x06_cycle\"\xc0\x01\n" +
+	"\x16ListDelegationsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.delegator.v1.DelegationFilterR\x06filter\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06expand\x18\x03 \x01(\bR\x06expand\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"}\n" +
+	"\x17ListDelegationsResponse\x12:\n" +
+	"\vdelegations\x18\x01 \x03(\v2\x18.delegator.v1.DelegationR\vdelegations\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x13\n" +
+	"\x11ListBakersRequest\"A\n" +
+	"\x12ListBakersResponse\x12+\n" +
+	"\x06bakers\x18\x01 \x03(\v2\x13.delegator.v1.BakerR\x06bakers\"+\n" +
+	"\x0fGetBakerRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"=\n" +
+	"\x10GetBakerResponse\x12)\n" +
+	"\x05baker\x18\x01 \x01(\v2\x13.delegator.v1.BakerR\x05baker\"\xb7\x01\n" +
+	"\x17WatchDelegationsRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.delegator.v1.DelegationFilterR\x06filter\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x121\n" +
+	"\x12after_operation_id\x18\x03 \x01(\x03H\x00R\x10afterOperationId\x88\x01\x01B\x15\n" +
+	"\x13_after_operation_id\"T\n" +
+	"\x18WatchDelegationsResponse\x128\n" +
+	"\n" +
+	"delegation\x18\x01 \x01(\v2\x18.delegator.v1.DelegationR\n" +
+	"delegation\"\xdd\x02\n" +
+	"\n" +
+	"Delegation\x128\n" +
+	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12\x1c\n" +
+	"\tdelegator\x18\x03 \x01(\tR\tdelegator\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\x03R\x05level\x12\x19\n" +
+	"\x05cycle\x18\x05 \x01(\x03H\x00R\x05cycle\x88\x01\x01\x126\n" +
+	"\n" +
+	"fiat_value\x18\x06 \x01(\v2\x17.delegator.v1.FiatValueR\tfiatValue\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x16\n" +
+	"\x06errors\x18\b \x03(\tR\x06errors\x12<\n" +
+	"\bmetadata\x18\t \x01(\v2 .delegator.v1.DelegationMetadataR\bmetadataB\b\n" +
+	"\x06_cycle\"S\n" +
+	"\tFiatValue\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05quote\x18\x02 \x01(\x01R\x05quote\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\"\xf9\x06\n" +
+	"\x12DelegationMetadata\x12&\n" +
+	"\foperation_id\x18\x01 \x01(\x03H\x00R\voperationId\x88\x01\x01\x12*\n" +
+	"\x0eoperation_hash\x18\x02 \x01(\tH\x01R\roperationHash\x88\x01\x01\x12\x19\n" +
+	"\x05block\x18\x03 \x01(\tH\x02R\x05block\x88\x01\x01\x12\x1d\n" +
+	"\acounter\x18\x04 \x01(\x03H\x03R\acounter\x88\x01\x01\x12\x1e\n" +
+	"\bgas_used\x18\x05 \x01(\x03H\x04R\agasUsed\x88\x01\x01\x12 \n" +
+	"\tbaker_fee\x18\x06 \x01(\x03H\x05R\bbakerFee\x88\x01\x01\x12!\n" +
+	"\tinitiator\x18\a \x01(\tH\x06R\tinitiator\x88\x01\x01\x12,\n" +
+	"\x0finitiator_alias\x18\b \x01(\tH\aR\x0einitiatorAlias\x88\x01\x01\x12\x19\n" +
+	"\x05nonce\x18\t \x01(\x03H\bR\x05nonce\x88\x01\x01\x127\n" +
+	"\x15staking_updates_count\x18\n" +
+	" \x01(\x03H\tR\x13stakingUpdatesCount\x88\x01\x01\x12'\n" +
+	"\x0fself_delegation\x18\v \x01(\bR\x0eselfDelegation\x12,\n" +
+	"\x0fdelegator_alias\x18\f \x01(\tH\n" +
+	"R\x0edelegatorAlias\x88\x01\x01\x12\x19\n" +
+	"\x05baker\x18\r \x01(\tH\vR\x05baker\x88\x01\x01\x12$\n" +
+	"\vbaker_alias\x18\x0e \x01(\tH\fR\n" +
+	"bakerAlias\x88\x01\x01\x12*\n" +
+	"\x0eprevious_baker\x18\x0f \x01(\tH\rR\rpreviousBaker\x88\x01\x01\x125\n" +
+	"\x14previous_baker_alias\x18\x10 \x01(\tH\x0eR\x12previousBakerAlias\x88\x01\x01B\x0f\n" +
+	"\r_operation_idB\x11\n" +
+	"\x0f_operation_hashB\b\n" +
+	"\x06_blockB\n" +
+	"\n" +
+	"\b_counterB\v\n" +
+	"\t_gas_usedB\f\n" +
+	"\n" +
+	"_baker_feeB\f\n" +
+	"\n" +
+	"_initiatorB\x12\n" +
+	"\x10_initiator_aliasB\b\n" +
+	"\x06_nonceB\x18\n" +
+	"\x16_staking_updates_countB\x12\n" +
+	"\x10_delegator_aliasB\b\n" +
+	"\x06_bakerB\x0e\n" +
+	"\f_baker_aliasB\x11\n" +
+	"\x0f_previous_bakerB\x17\n" +
+	"\x15_previous_baker_alias\"\xd6\x05\n" +
+	"\x05Baker\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x19\n" +
+	"\x05alias\x18\x02 \x01(\tH\x00R\x05alias\x88\x01\x01\x129\n" +
+	"\n" +
+	"first_seen\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
+	"\tlast_seen\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x122\n" +
+	"\x12registration_level\x18\x06 \x01(\x03H\x01R\x11registrationLevel\x88\x01\x01\x12Q\n" +
+	"\x16registration_timestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x15registrationTimestamp\x122\n" +
+	"\x12deactivation_level\x18\b \x01(\x03H\x02R\x11deactivationLevel\x88\x01\x01\x12Q\n" +
+	"\x16deactivation_timestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x15deactivationTimestamp\x12<\n" +
+	"\x1atotal_delegations_received\x18\n" +
+	" \x01(\x03R\x18totalDelegationsReceived\x12\x1e\n" +
+	"\n" +
+	"delegators\x18\v \x01(\x03R\n" +
+	"delegators\x12)\n" +
+	"\x10delegated_amount\x18\f \x01(\x03R\x0fdelegatedAmount\x12\x18\n" +
+	"\astakers\x18\r \x01(\x03R\astakers\x12#\n" +
+	"\rstaked_amount\x18\x0e \x01(\x03R\fstakedAmountB\b\n" +
+	"\x06_aliasB\x15\n" +
+	"\x13_registration_levelB\x15\n" +
+	"\x13_deactivation_level2\xf3\x02\n" +
+	"\x10DelegatorService\x12^\n" +
+	"\x0fListDelegations\x12$.delegator.v1.ListDelegationsRequest\x1a%.delegator.v1.ListDelegationsResponse\x12O\n" +
+	"\n" +
+	"ListBakers\x12\x1f.delegator.v1.ListBakersRequest\x1a .delegator.v1.ListBakersResponse\x12I\n" +
+	"\bGetBaker\x12\x1d.delegator.v1.GetBakerRequest\x1a\x1e.delegator.v1.GetBakerResponse\x12c\n" +
+	"\x10WatchDelegations\x12%.delegator.v1.WatchDelegationsRequest\x1a&.delegator.v1.WatchDelegationsResponse0\x01B*Z(delegator/pkg/pb/delegatorv1;delegatorv1b\x06proto3"
+
+var (
+	file_delegator_v1_delegator_proto_rawDescOnce sync.Once
+	file_delegator_v1_delegator_proto_rawDescData []byte
+)
+
+func file_delegator_v1_delegator_proto_rawDescGZIP() []byte {
+	file_delegator_v1_delegator_proto_rawDescOnce.Do(func() {
+		file_delegator_v1_delegator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_delegator_v1_delegator_proto_rawDesc), len(file_delegator_v1_delegator_proto_rawDesc)))
+	})
+	return file_delegator_v1_delegator_proto_rawDescData
+}
+
+var file_delegator_v1_delegator_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_delegator_v1_delegator_proto_goTypes = []any{
+	(*DelegationFilter)(nil),         // 0: delegator.v1.DelegationFilter
+	(*ListDelegationsRequest)(nil),   // 1: delegator.v1.ListDelegationsRequest
+	(*ListDelegationsResponse)(nil),  // 2: delegator.v1.ListDelegationsResponse
+	(*ListBakersRequest)(nil),        // 3: delegator.v1.ListBakersRequest
+	(*ListBakersResponse)(nil),       // 4: delegator.v1.ListBakersResponse
+	(*GetBakerRequest)(nil),          // 5: delegator.v1.GetBakerRequest
+	(*GetBakerResponse)(nil),         // 6: delegator.v1.GetBakerResponse
+	(*WatchDelegationsRequest)(nil),  // 7: delegator.v1.WatchDelegationsRequest
+	(*WatchDelegationsResponse)(nil), // 8: delegator.v1.WatchDelegationsResponse
+	(*Delegation)(nil),               // 9: delegator.v1.Delegation
+	(*FiatValue)(nil),                // 10: delegator.v1.FiatValue
+	(*DelegationMetadata)(nil),       // 11: delegator.v1.DelegationMetadata
+	(*Baker)(nil),                    // 12: delegator.v1.Baker
+	(*timestamppb.Timestamp)(nil),    // 13: google.protobuf.Timestamp
+}
+var file_delegator_v1_delegator_proto_depIdxs = []int32{
+	0,  // 0: delegator.v1.ListDelegationsRequest.filter:type_name -> delegator.v1.DelegationFilter
+	9,  // 1: delegator.v1.ListDelegationsResponse.delegations:type_name -> delegator.v1.Delegation
+	12, // 2: delegator.v1.ListBakersResponse.bakers:type_name -> delegator.v1.Baker
+	12, // 3: delegator.v1.GetBakerResponse.baker:type_name -> delegator.v1.Baker
+	0,  // 4: delegator.v1.WatchDelegationsRequest.filter:type_name -> delegator.v1.DelegationFilter
+	9,  // 5: delegator.v1.WatchDelegationsResponse.delegation:type_name -> delegator.v1.Delegation
+	13, // 6: delegator.v1.Delegation.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 7: delegator.v1.Delegation.fiat_value:type_name -> delegator.v1.FiatValue
+	11, // 8: delegator.v1.Delegation.metadata:type_name -> delegator.v1.DelegationMetadata
+	13, // 9: delegator.v1.Baker.first_seen:type_name -> google.protobuf.Timestamp
+	13, // 10: delegator.v1.Baker.last_seen:type_name -> google.protobuf.Timestamp
+	13, // 11: delegator.v1.Baker.registration_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 12: delegator.v1.Baker.deactivation_timestamp:type_name -> google.protobuf.Timestamp
+	1,  // 13: delegator.v1.DelegatorService.ListDelegations:input_type -> delegator.v1.ListDelegationsRequest
+	3,  // 14: delegator.v1.DelegatorService.ListBakers:input_type -> delegator.v1.ListBakersRequest
+	5,  // 15: delegator.v1.DelegatorService.GetBaker:input_type -> delegator.v1.GetBakerRequest
+	7,  // 16: delegator.v1.DelegatorService.WatchDelegations:input_type -> delegator.v1.WatchDelegationsRequest
+	2,  // 17: delegator.v1.DelegatorService.ListDelegations:output_type -> delegator.v1.ListDelegationsResponse
+	4,  // 18: delegator.v1.DelegatorService.ListBakers:output_type -> delegator.v1.ListBakersResponse
+	6,  // 19: delegator.v1.DelegatorService.GetBaker:output_type -> delegator.v1.GetBakerResponse
+	8,  // 20: delegator.v1.DelegatorService.WatchDelegations:output_type -> delegator.v1.WatchDelegationsResponse
+	17, // [17:21] is the sub-list for method output_type
+	13, // [13:17] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_delegator_v1_delegator_proto_init() }
+func file_delegator_v1_delegator_proto_init() {
+	if File_delegator_v1_delegator_proto != nil {
+		return
+	}
+	file_delegator_v1_delegator_proto_msgTypes[0].OneofWrappers = []any{}
+	file_delegator_v1_delegator_proto_msgTypes[7].OneofWrappers = []any{}
+	file_delegator_v1_delegator_proto_msgTypes[9].OneofWrappers = []any{}
+	file_delegator_v1_delegator_proto_msgTypes[11].OneofWrappers = []any{}
+	file_delegator_v1_delegator_proto_msgTypes[12].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_delegator_v1_delegator_proto_rawDesc), len(file_delegator_v1_delegator_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_delegator_v1_delegator_proto_goTypes,
+		DependencyIndexes: file_delegator_v1_delegator_proto_depIdxs,
+		MessageInfos:      file_delegator_v1_delegator_proto_msgTypes,
+	}.Build()
+	File_delegator_v1_delegator_proto = out.File
+	file_delegator_v1_delegator_proto_goTypes = nil
+	file_delegator_v1_delegator_proto_depIdxs = nil
+}
@@ -0,0 +1,259 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             (unknown)
+// source: delegator/v1/delegator.proto
+
+package delegatorv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DelegatorService_ListDelegations_FullMethodName  = "/delegator.v1.DelegatorService/ListDelegations"
+	DelegatorService_ListBakers_FullMethodName       = "/delegator.v1.DelegatorService/ListBakers"
+	DelegatorService_GetBaker_FullMethodName         = "/delegator.v1.DelegatorService/GetBaker"
+	DelegatorService_WatchDelegations_FullMethodName = "/delegator.v1.DelegatorService/WatchDelegations"
+)
+
+// DelegatorServiceClient is the client API for DelegatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// DelegatorService serves the indexed delegations and bakers, like the /v1 REST
+// routes. Amounts are always in mutez.
+type DelegatorServiceClient interface {
+	// ListDelegations returns a page of the delegations matching the filter, newest
+	// first, like GET /v1/delegations.
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	// ListBakers returns every baker with its current delegators aggregate, like GET /v1/bakers.
+	ListBakers(ctx context.Context, in *ListBakersRequest, opts ...grpc.CallOption) (*ListBakersResponse, error)
+	// GetBaker returns a baker, NOT_FOUND when it was never delegated to, like GET /v1/bakers/{address}.
+	GetBaker(ctx context.Context, in *GetBakerRequest, opts ...grpc.CallOption) (*GetBakerResponse, error)
+	// WatchDelegations streams the applied delegations as they are indexed, like
+	// GET /v1/delegations/stream. The stream ends with ABORTED when the client
+	// falls behind, it resumes with the operation id of the last delegation it got.
+	WatchDelegations(ctx context.Context, in *WatchDelegationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDelegationsResponse], error)
+}
+
+type delegatorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDelegatorServiceClient(cc grpc.ClientConnInterface) DelegatorServiceClient {
+	return &delegatorServiceClient{cc}
+}
+
+func (c *delegatorServiceClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, DelegatorService_ListDelegations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *delegatorServiceClient) ListBakers(ctx context.Context, in *ListBakersRequest, opts ...grpc.CallOption) (*ListBakersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBakersResponse)
+	err := c.cc.Invoke(ctx, DelegatorService_ListBakers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *delegatorServiceClient) GetBaker(ctx context.Context, in *GetBakerRequest, opts ...grpc.CallOption) (*GetBakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBakerResponse)
+	err := c.cc.Invoke(ctx, DelegatorService_GetBaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *delegatorServiceClient) WatchDelegations(ctx context.Context, in *WatchDelegationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchDelegationsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DelegatorService_ServiceDesc.Streams[0], DelegatorService_WatchDelegations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchDelegationsRequest, WatchDelegationsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DelegatorService_WatchDelegationsClient = grpc.ServerStreamingClient[WatchDelegationsResponse]
+
+// DelegatorServiceServer is the server API for DelegatorService service.
+// All implementations must embed UnimplementedDelegatorServiceServer
+// for forward compatibility.
+//
+// DelegatorService serves the indexed delegations and bakers, like the /v1 REST
+// routes. Amounts are always in mutez.
+type DelegatorServiceServer interface {
+	// ListDelegations returns a page of the delegations matching the filter, newest
+	// first, like GET /v1/delegations.
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	// ListBakers returns every baker with its current delegators aggregate, like GET /v1/bakers.
+	ListBakers(context.Context, *ListBakersRequest) (*ListBakersResponse, error)
+	// GetBaker returns a baker, NOT_FOUND when it was never delegated to, like GET /v1/bakers/{address}.
+	GetBaker(context.Context, *GetBakerRequest) (*GetBakerResponse, error)
+	// WatchDelegations streams the applied delegations as they are indexed, like
+	// GET /v1/delegations/stream. The stream ends with ABORTED when the client
+	// falls behind, it resumes with the operation id of the last delegation it got.
+	WatchDelegations(*WatchDelegationsRequest, grpc.ServerStreamingServer[WatchDelegationsResponse]) error
+	mustEmbedUnimplementedDelegatorServiceServer()
+}
+
+// UnimplementedDelegatorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDelegatorServiceServer struct{}
+
+func (UnimplementedDelegatorServiceServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedDelegatorServiceServer) ListBakers(context.Context, *ListBakersRequest) (*ListBakersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBakers not implemented")
+}
+func (UnimplementedDelegatorServiceServer) GetBaker(context.Context, *GetBakerRequest) (*GetBakerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBaker not implemented")
+}
+func (UnimplementedDelegatorServiceServer) WatchDelegations(*WatchDelegationsRequest, grpc.ServerStreamingServer[WatchDelegationsResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchDelegations not implemented")
+}
+func (UnimplementedDelegatorServiceServer) mustEmbedUnimplementedDelegatorServiceServer() {}
+func (UnimplementedDelegatorServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeDelegatorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DelegatorServiceServer will
+// result in compilation errors.
+type UnsafeDelegatorServiceServer interface {
+	mustEmbedUnimplementedDelegatorServiceServer()
+}
+
+func RegisterDelegatorServiceServer(s grpc.ServiceRegistrar, srv DelegatorServiceServer) {
+	// If the following call panics, it indicates UnimplementedDelegatorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DelegatorService_ServiceDesc, srv)
+}
+
+func _DelegatorService_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DelegatorServiceServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DelegatorService_ListDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DelegatorServiceServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DelegatorService_ListBakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DelegatorServiceServer).ListBakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DelegatorService_ListBakers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DelegatorServiceServer).ListBakers(ctx, req.(*ListBakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DelegatorService_GetBaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DelegatorServiceServer).GetBaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DelegatorService_GetBaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DelegatorServiceServer).GetBaker(ctx, req.(*GetBakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DelegatorService_WatchDelegations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDelegationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DelegatorServiceServer).WatchDelegations(m, &grpc.GenericServerStream[WatchDelegationsRequest, WatchDelegationsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DelegatorService_WatchDelegationsServer = grpc.ServerStreamingServer[WatchDelegationsResponse]
+
+// DelegatorService_ServiceDesc is the grpc.ServiceDesc for DelegatorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DelegatorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "delegator.v1.DelegatorService",
+	HandlerType: (*DelegatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDelegations",
+			Handler:    _DelegatorService_ListDelegations_Handler,
+		},
+		{
+			MethodName: "ListBakers",
+			Handler:    _DelegatorService_ListBakers_Handler,
+		},
+		{
+			MethodName: "GetBaker",
+			Handler:    _DelegatorService_GetBaker_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDelegations",
+			Handler:       _DelegatorService_WatchDelegations_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "delegator/v1/delegator.proto",
+}